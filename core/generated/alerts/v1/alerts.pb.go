@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: alerts/v1/alerts.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Rule struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enable bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	// memory_percent|restarts|unhealthy|volume_size
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// empty to evaluate on all hosts
+	Host string `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	// container or volume name, supports globs, empty matches all
+	Target string `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`
+	// memory_percent: percent of the memory limit
+	// restarts: number of restarts within the duration
+	// volume_size: size in GB
+	Threshold float64 `protobuf:"fixed64,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// how long the condition must hold before firing,
+	// for restart rules this is the window restarts are counted in
+	DurationInSeconds int64  `protobuf:"varint,8,opt,name=durationInSeconds,proto3" json:"durationInSeconds,omitempty"`
+	SilencedUntil     string `protobuf:"bytes,9,opt,name=silencedUntil,proto3" json:"silencedUntil,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rule) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Rule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Rule) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Rule) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Rule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Rule) GetDurationInSeconds() int64 {
+	if x != nil {
+		return x.DurationInSeconds
+	}
+	return 0
+}
+
+func (x *Rule) GetSilencedUntil() string {
+	if x != nil {
+		return x.SilencedUntil
+	}
+	return ""
+}
+
+type DeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRuleRequest) Reset() {
+	*x = DeleteRuleRequest{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRuleRequest) ProtoMessage() {}
+
+func (x *DeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteRuleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SilenceRuleRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DurationInSeconds int64                  `protobuf:"varint,2,opt,name=durationInSeconds,proto3" json:"durationInSeconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SilenceRuleRequest) Reset() {
+	*x = SilenceRuleRequest{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SilenceRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SilenceRuleRequest) ProtoMessage() {}
+
+func (x *SilenceRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SilenceRuleRequest.ProtoReflect.Descriptor instead.
+func (*SilenceRuleRequest) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SilenceRuleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SilenceRuleRequest) GetDurationInSeconds() int64 {
+	if x != nil {
+		return x.DurationInSeconds
+	}
+	return 0
+}
+
+type ListAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsResponse) ProtoMessage() {}
+
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type Alert struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	RuleId   uint64                 `protobuf:"varint,1,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	RuleName string                 `protobuf:"bytes,2,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	Host     string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Target   string                 `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// pending|firing
+	State         string  `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Value         float64 `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Since         string  `protobuf:"bytes,7,opt,name=since,proto3" json:"since,omitempty"`
+	FiredAt       string  `protobuf:"bytes,8,opt,name=firedAt,proto3" json:"firedAt,omitempty"`
+	Silenced      bool    `protobuf:"varint,9,opt,name=silenced,proto3" json:"silenced,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Alert) GetRuleId() uint64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *Alert) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *Alert) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Alert) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Alert) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Alert) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Alert) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *Alert) GetFiredAt() string {
+	if x != nil {
+		return x.FiredAt
+	}
+	return ""
+}
+
+func (x *Alert) GetSilenced() bool {
+	if x != nil {
+		return x.Silenced
+	}
+	return false
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_alerts_v1_alerts_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_alerts_v1_alerts_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_alerts_v1_alerts_proto_rawDescGZIP(), []int{6}
+}
+
+var File_alerts_v1_alerts_proto protoreflect.FileDescriptor
+
+const file_alerts_v1_alerts_proto_rawDesc = "" +
+	"\n" +
+	"\x16alerts/v1/alerts.proto\x12\talerts.v1\":\n" +
+	"\x11ListRulesResponse\x12%\n" +
+	"\x05rules\x18\x01 \x03(\v2\x0f.alerts.v1.RuleR\x05rules\"\xf4\x01\n" +
+	"\x04Rule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06enable\x18\x03 \x01(\bR\x06enable\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04host\x18\x05 \x01(\tR\x04host\x12\x16\n" +
+	"\x06target\x18\x06 \x01(\tR\x06target\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x01R\tthreshold\x12,\n" +
+	"\x11durationInSeconds\x18\b \x01(\x03R\x11durationInSeconds\x12$\n" +
+	"\rsilencedUntil\x18\t \x01(\tR\rsilencedUntil\"#\n" +
+	"\x11DeleteRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"R\n" +
+	"\x12SilenceRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12,\n" +
+	"\x11durationInSeconds\x18\x02 \x01(\x03R\x11durationInSeconds\">\n" +
+	"\x12ListAlertsResponse\x12(\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x10.alerts.v1.AlertR\x06alerts\"\xdf\x01\n" +
+	"\x05Alert\x12\x16\n" +
+	"\x06ruleId\x18\x01 \x01(\x04R\x06ruleId\x12\x1a\n" +
+	"\bruleName\x18\x02 \x01(\tR\bruleName\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05since\x18\a \x01(\tR\x05since\x12\x18\n" +
+	"\afiredAt\x18\b \x01(\tR\afiredAt\x12\x1a\n" +
+	"\bsilenced\x18\t \x01(\bR\bsilenced\"\a\n" +
+	"\x05Empty2\xc7\x02\n" +
+	"\fAlertService\x12@\n" +
+	"\tListRules\x12\x10.alerts.v1.Empty\x1a\x1c.alerts.v1.ListRulesResponse\"\x03\x90\x02\x01\x12/\n" +
+	"\bSaveRule\x12\x0f.alerts.v1.Rule\x1a\x10.alerts.v1.Empty\"\x00\x12>\n" +
+	"\n" +
+	"DeleteRule\x12\x1c.alerts.v1.DeleteRuleRequest\x1a\x10.alerts.v1.Empty\"\x00\x12@\n" +
+	"\vSilenceRule\x12\x1d.alerts.v1.SilenceRuleRequest\x1a\x10.alerts.v1.Empty\"\x00\x12B\n" +
+	"\n" +
+	"ListAlerts\x12\x10.alerts.v1.Empty\x1a\x1d.alerts.v1.ListAlertsResponse\"\x03\x90\x02\x01B\x8f\x01\n" +
+	"\rcom.alerts.v1B\vAlertsProtoP\x01Z,github.com/RA341/dockman/generated/alerts/v1\xa2\x02\x03AXX\xaa\x02\tAlerts.V1\xca\x02\tAlerts\\V1\xe2\x02\x15Alerts\\V1\\GPBMetadata\xea\x02\n" +
+	"Alerts::V1b\x06proto3"
+
+var (
+	file_alerts_v1_alerts_proto_rawDescOnce sync.Once
+	file_alerts_v1_alerts_proto_rawDescData []byte
+)
+
+func file_alerts_v1_alerts_proto_rawDescGZIP() []byte {
+	file_alerts_v1_alerts_proto_rawDescOnce.Do(func() {
+		file_alerts_v1_alerts_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_alerts_v1_alerts_proto_rawDesc), len(file_alerts_v1_alerts_proto_rawDesc)))
+	})
+	return file_alerts_v1_alerts_proto_rawDescData
+}
+
+var file_alerts_v1_alerts_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_alerts_v1_alerts_proto_goTypes = []any{
+	(*ListRulesResponse)(nil),  // 0: alerts.v1.ListRulesResponse
+	(*Rule)(nil),               // 1: alerts.v1.Rule
+	(*DeleteRuleRequest)(nil),  // 2: alerts.v1.DeleteRuleRequest
+	(*SilenceRuleRequest)(nil), // 3: alerts.v1.SilenceRuleRequest
+	(*ListAlertsResponse)(nil), // 4: alerts.v1.ListAlertsResponse
+	(*Alert)(nil),              // 5: alerts.v1.Alert
+	(*Empty)(nil),              // 6: alerts.v1.Empty
+}
+var file_alerts_v1_alerts_proto_depIdxs = []int32{
+	1, // 0: alerts.v1.ListRulesResponse.rules:type_name -> alerts.v1.Rule
+	5, // 1: alerts.v1.ListAlertsResponse.alerts:type_name -> alerts.v1.Alert
+	6, // 2: alerts.v1.AlertService.ListRules:input_type -> alerts.v1.Empty
+	1, // 3: alerts.v1.AlertService.SaveRule:input_type -> alerts.v1.Rule
+	2, // 4: alerts.v1.AlertService.DeleteRule:input_type -> alerts.v1.DeleteRuleRequest
+	3, // 5: alerts.v1.AlertService.SilenceRule:input_type -> alerts.v1.SilenceRuleRequest
+	6, // 6: alerts.v1.AlertService.ListAlerts:input_type -> alerts.v1.Empty
+	0, // 7: alerts.v1.AlertService.ListRules:output_type -> alerts.v1.ListRulesResponse
+	6, // 8: alerts.v1.AlertService.SaveRule:output_type -> alerts.v1.Empty
+	6, // 9: alerts.v1.AlertService.DeleteRule:output_type -> alerts.v1.Empty
+	6, // 10: alerts.v1.AlertService.SilenceRule:output_type -> alerts.v1.Empty
+	4, // 11: alerts.v1.AlertService.ListAlerts:output_type -> alerts.v1.ListAlertsResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_alerts_v1_alerts_proto_init() }
+func file_alerts_v1_alerts_proto_init() {
+	if File_alerts_v1_alerts_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alerts_v1_alerts_proto_rawDesc), len(file_alerts_v1_alerts_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_alerts_v1_alerts_proto_goTypes,
+		DependencyIndexes: file_alerts_v1_alerts_proto_depIdxs,
+		MessageInfos:      file_alerts_v1_alerts_proto_msgTypes,
+	}.Build()
+	File_alerts_v1_alerts_proto = out.File
+	file_alerts_v1_alerts_proto_goTypes = nil
+	file_alerts_v1_alerts_proto_depIdxs = nil
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: alerts/v1/alerts.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/alerts/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AlertServiceName is the fully-qualified name of the AlertService service.
+	AlertServiceName = "alerts.v1.AlertService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AlertServiceListRulesProcedure is the fully-qualified name of the AlertService's ListRules RPC.
+	AlertServiceListRulesProcedure = "/alerts.v1.AlertService/ListRules"
+	// AlertServiceSaveRuleProcedure is the fully-qualified name of the AlertService's SaveRule RPC.
+	AlertServiceSaveRuleProcedure = "/alerts.v1.AlertService/SaveRule"
+	// AlertServiceDeleteRuleProcedure is the fully-qualified name of the AlertService's DeleteRule RPC.
+	AlertServiceDeleteRuleProcedure = "/alerts.v1.AlertService/DeleteRule"
+	// AlertServiceSilenceRuleProcedure is the fully-qualified name of the AlertService's SilenceRule
+	// RPC.
+	AlertServiceSilenceRuleProcedure = "/alerts.v1.AlertService/SilenceRule"
+	// AlertServiceListAlertsProcedure is the fully-qualified name of the AlertService's ListAlerts RPC.
+	AlertServiceListAlertsProcedure = "/alerts.v1.AlertService/ListAlerts"
+)
+
+// AlertServiceClient is a client for the alerts.v1.AlertService service.
+type AlertServiceClient interface {
+	ListRules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListRulesResponse], error)
+	SaveRule(context.Context, *connect.Request[v1.Rule]) (*connect.Response[v1.Empty], error)
+	DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.Empty], error)
+	// mutes notifications for a rule, set durationInSeconds to 0 to unsilence
+	SilenceRule(context.Context, *connect.Request[v1.SilenceRuleRequest]) (*connect.Response[v1.Empty], error)
+	// currently pending or firing alerts
+	ListAlerts(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListAlertsResponse], error)
+}
+
+// NewAlertServiceClient constructs a client for the alerts.v1.AlertService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAlertServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AlertServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	alertServiceMethods := v1.File_alerts_v1_alerts_proto.Services().ByName("AlertService").Methods()
+	return &alertServiceClient{
+		listRules: connect.NewClient[v1.Empty, v1.ListRulesResponse](
+			httpClient,
+			baseURL+AlertServiceListRulesProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("ListRules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		saveRule: connect.NewClient[v1.Rule, v1.Empty](
+			httpClient,
+			baseURL+AlertServiceSaveRuleProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("SaveRule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRule: connect.NewClient[v1.DeleteRuleRequest, v1.Empty](
+			httpClient,
+			baseURL+AlertServiceDeleteRuleProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("DeleteRule")),
+			connect.WithClientOptions(opts...),
+		),
+		silenceRule: connect.NewClient[v1.SilenceRuleRequest, v1.Empty](
+			httpClient,
+			baseURL+AlertServiceSilenceRuleProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("SilenceRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlerts: connect.NewClient[v1.Empty, v1.ListAlertsResponse](
+			httpClient,
+			baseURL+AlertServiceListAlertsProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("ListAlerts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// alertServiceClient implements AlertServiceClient.
+type alertServiceClient struct {
+	listRules   *connect.Client[v1.Empty, v1.ListRulesResponse]
+	saveRule    *connect.Client[v1.Rule, v1.Empty]
+	deleteRule  *connect.Client[v1.DeleteRuleRequest, v1.Empty]
+	silenceRule *connect.Client[v1.SilenceRuleRequest, v1.Empty]
+	listAlerts  *connect.Client[v1.Empty, v1.ListAlertsResponse]
+}
+
+// ListRules calls alerts.v1.AlertService.ListRules.
+func (c *alertServiceClient) ListRules(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListRulesResponse], error) {
+	return c.listRules.CallUnary(ctx, req)
+}
+
+// SaveRule calls alerts.v1.AlertService.SaveRule.
+func (c *alertServiceClient) SaveRule(ctx context.Context, req *connect.Request[v1.Rule]) (*connect.Response[v1.Empty], error) {
+	return c.saveRule.CallUnary(ctx, req)
+}
+
+// DeleteRule calls alerts.v1.AlertService.DeleteRule.
+func (c *alertServiceClient) DeleteRule(ctx context.Context, req *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteRule.CallUnary(ctx, req)
+}
+
+// SilenceRule calls alerts.v1.AlertService.SilenceRule.
+func (c *alertServiceClient) SilenceRule(ctx context.Context, req *connect.Request[v1.SilenceRuleRequest]) (*connect.Response[v1.Empty], error) {
+	return c.silenceRule.CallUnary(ctx, req)
+}
+
+// ListAlerts calls alerts.v1.AlertService.ListAlerts.
+func (c *alertServiceClient) ListAlerts(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListAlertsResponse], error) {
+	return c.listAlerts.CallUnary(ctx, req)
+}
+
+// AlertServiceHandler is an implementation of the alerts.v1.AlertService service.
+type AlertServiceHandler interface {
+	ListRules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListRulesResponse], error)
+	SaveRule(context.Context, *connect.Request[v1.Rule]) (*connect.Response[v1.Empty], error)
+	DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.Empty], error)
+	// mutes notifications for a rule, set durationInSeconds to 0 to unsilence
+	SilenceRule(context.Context, *connect.Request[v1.SilenceRuleRequest]) (*connect.Response[v1.Empty], error)
+	// currently pending or firing alerts
+	ListAlerts(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListAlertsResponse], error)
+}
+
+// NewAlertServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAlertServiceHandler(svc AlertServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	alertServiceMethods := v1.File_alerts_v1_alerts_proto.Services().ByName("AlertService").Methods()
+	alertServiceListRulesHandler := connect.NewUnaryHandler(
+		AlertServiceListRulesProcedure,
+		svc.ListRules,
+		connect.WithSchema(alertServiceMethods.ByName("ListRules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceSaveRuleHandler := connect.NewUnaryHandler(
+		AlertServiceSaveRuleProcedure,
+		svc.SaveRule,
+		connect.WithSchema(alertServiceMethods.ByName("SaveRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceDeleteRuleHandler := connect.NewUnaryHandler(
+		AlertServiceDeleteRuleProcedure,
+		svc.DeleteRule,
+		connect.WithSchema(alertServiceMethods.ByName("DeleteRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceSilenceRuleHandler := connect.NewUnaryHandler(
+		AlertServiceSilenceRuleProcedure,
+		svc.SilenceRule,
+		connect.WithSchema(alertServiceMethods.ByName("SilenceRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceListAlertsHandler := connect.NewUnaryHandler(
+		AlertServiceListAlertsProcedure,
+		svc.ListAlerts,
+		connect.WithSchema(alertServiceMethods.ByName("ListAlerts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/alerts.v1.AlertService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AlertServiceListRulesProcedure:
+			alertServiceListRulesHandler.ServeHTTP(w, r)
+		case AlertServiceSaveRuleProcedure:
+			alertServiceSaveRuleHandler.ServeHTTP(w, r)
+		case AlertServiceDeleteRuleProcedure:
+			alertServiceDeleteRuleHandler.ServeHTTP(w, r)
+		case AlertServiceSilenceRuleProcedure:
+			alertServiceSilenceRuleHandler.ServeHTTP(w, r)
+		case AlertServiceListAlertsProcedure:
+			alertServiceListAlertsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAlertServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAlertServiceHandler struct{}
+
+func (UnimplementedAlertServiceHandler) ListRules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("alerts.v1.AlertService.ListRules is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) SaveRule(context.Context, *connect.Request[v1.Rule]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("alerts.v1.AlertService.SaveRule is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) DeleteRule(context.Context, *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("alerts.v1.AlertService.DeleteRule is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) SilenceRule(context.Context, *connect.Request[v1.SilenceRuleRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("alerts.v1.AlertService.SilenceRule is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) ListAlerts(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("alerts.v1.AlertService.ListAlerts is not implemented"))
+}
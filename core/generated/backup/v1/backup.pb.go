@@ -0,0 +1,1229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: backup/v1/backup.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_backup_v1_backup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{0}
+}
+
+// an empty machine is the local backup directory,
+// otherwise dir on a connected ssh machine
+type Location struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Machine string                 `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
+	// defaults to dockman-backups in the ssh user's home
+	Dir           string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_backup_v1_backup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Location) GetMachine() string {
+	if x != nil {
+		return x.Machine
+	}
+	return ""
+}
+
+func (x *Location) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type Archive struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Volume        string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Created       string                 `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	Location      *Location              `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Archive) Reset() {
+	*x = Archive{}
+	mi := &file_backup_v1_backup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Archive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Archive) ProtoMessage() {}
+
+func (x *Archive) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Archive.ProtoReflect.Descriptor instead.
+func (*Archive) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Archive) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Archive) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *Archive) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Archive) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Archive) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type VolumeBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// docker host the volume lives on, empty for the active host
+	Host          string    `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Volume        string    `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Location      *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeBackupRequest) Reset() {
+	*x = VolumeBackupRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeBackupRequest) ProtoMessage() {}
+
+func (x *VolumeBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeBackupRequest.ProtoReflect.Descriptor instead.
+func (*VolumeBackupRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VolumeBackupRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *VolumeBackupRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *VolumeBackupRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type VolumeRestoreRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Host     string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Location *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Archive  string                 `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	// defaults to the volume the archive was taken from
+	Volume string `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	// stop containers using the volume and start them again after the restore
+	StopContainers bool `protobuf:"varint,5,opt,name=stopContainers,proto3" json:"stopContainers,omitempty"`
+	// remove existing volume contents before extracting
+	Clear         bool `protobuf:"varint,6,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeRestoreRequest) Reset() {
+	*x = VolumeRestoreRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeRestoreRequest) ProtoMessage() {}
+
+func (x *VolumeRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeRestoreRequest.ProtoReflect.Descriptor instead.
+func (*VolumeRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VolumeRestoreRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *VolumeRestoreRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *VolumeRestoreRequest) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+func (x *VolumeRestoreRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *VolumeRestoreRequest) GetStopContainers() bool {
+	if x != nil {
+		return x.StopContainers
+	}
+	return false
+}
+
+func (x *VolumeRestoreRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+type ListArchivesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archives      []*Archive             `protobuf:"bytes,1,rep,name=archives,proto3" json:"archives,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListArchivesResponse) Reset() {
+	*x = ListArchivesResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListArchivesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivesResponse) ProtoMessage() {}
+
+func (x *ListArchivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivesResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivesResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListArchivesResponse) GetArchives() []*Archive {
+	if x != nil {
+		return x.Archives
+	}
+	return nil
+}
+
+type DeleteArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteArchiveRequest) Reset() {
+	*x = DeleteArchiveRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteArchiveRequest) ProtoMessage() {}
+
+func (x *DeleteArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteArchiveRequest.ProtoReflect.Descriptor instead.
+func (*DeleteArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteArchiveRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *DeleteArchiveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Schedule struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enable bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	// compose file relative to the compose root
+	Stack string `protobuf:"bytes,3,opt,name=stack,proto3" json:"stack,omitempty"`
+	// empty for the active host, resolved to its name when saved
+	Host              string    `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Location          *Location `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	IntervalInSeconds int64     `protobuf:"varint,6,opt,name=intervalInSeconds,proto3" json:"intervalInSeconds,omitempty"`
+	StopContainers    bool      `protobuf:"varint,7,opt,name=stopContainers,proto3" json:"stopContainers,omitempty"`
+	// retention, archives matching any rule are kept, all 0 keeps everything
+	KeepLast      int32  `protobuf:"varint,8,opt,name=keepLast,proto3" json:"keepLast,omitempty"`
+	KeepDaily     int32  `protobuf:"varint,9,opt,name=keepDaily,proto3" json:"keepDaily,omitempty"`
+	KeepWeekly    int32  `protobuf:"varint,10,opt,name=keepWeekly,proto3" json:"keepWeekly,omitempty"`
+	LastRun       string `protobuf:"bytes,11,opt,name=lastRun,proto3" json:"lastRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_backup_v1_backup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Schedule) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Schedule) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Schedule) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+func (x *Schedule) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Schedule) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Schedule) GetIntervalInSeconds() int64 {
+	if x != nil {
+		return x.IntervalInSeconds
+	}
+	return 0
+}
+
+func (x *Schedule) GetStopContainers() bool {
+	if x != nil {
+		return x.StopContainers
+	}
+	return false
+}
+
+func (x *Schedule) GetKeepLast() int32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *Schedule) GetKeepDaily() int32 {
+	if x != nil {
+		return x.KeepDaily
+	}
+	return 0
+}
+
+func (x *Schedule) GetKeepWeekly() int32 {
+	if x != nil {
+		return x.KeepWeekly
+	}
+	return 0
+}
+
+func (x *Schedule) GetLastRun() string {
+	if x != nil {
+		return x.LastRun
+	}
+	return ""
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type DeleteScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteScheduleRequest) Reset() {
+	*x = DeleteScheduleRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteScheduleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type BackupRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 0 for manual backups
+	ScheduleId uint64    `protobuf:"varint,2,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"`
+	Stack      string    `protobuf:"bytes,3,opt,name=stack,proto3" json:"stack,omitempty"`
+	Host       string    `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Location   *Location `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	// relative to the location dir
+	Archive string `protobuf:"bytes,6,opt,name=archive,proto3" json:"archive,omitempty"`
+	Size    int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	// success|failed
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Error         string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt     string `protobuf:"bytes,10,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	DurationInMs  int64  `protobuf:"varint,11,opt,name=durationInMs,proto3" json:"durationInMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupRecord) Reset() {
+	*x = BackupRecord{}
+	mi := &file_backup_v1_backup_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRecord) ProtoMessage() {}
+
+func (x *BackupRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRecord.ProtoReflect.Descriptor instead.
+func (*BackupRecord) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BackupRecord) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackupRecord) GetScheduleId() uint64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *BackupRecord) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+func (x *BackupRecord) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *BackupRecord) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *BackupRecord) GetArchive() string {
+	if x != nil {
+		return x.Archive
+	}
+	return ""
+}
+
+func (x *BackupRecord) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BackupRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BackupRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BackupRecord) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *BackupRecord) GetDurationInMs() int64 {
+	if x != nil {
+		return x.DurationInMs
+	}
+	return 0
+}
+
+type StackBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Stack string                 `protobuf:"bytes,1,opt,name=stack,proto3" json:"stack,omitempty"`
+	// empty for the active host
+	Host           string    `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Location       *Location `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	StopContainers bool      `protobuf:"varint,4,opt,name=stopContainers,proto3" json:"stopContainers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StackBackupRequest) Reset() {
+	*x = StackBackupRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StackBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackBackupRequest) ProtoMessage() {}
+
+func (x *StackBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackBackupRequest.ProtoReflect.Descriptor instead.
+func (*StackBackupRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StackBackupRequest) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+func (x *StackBackupRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *StackBackupRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *StackBackupRequest) GetStopContainers() bool {
+	if x != nil {
+		return x.StopContainers
+	}
+	return false
+}
+
+type ListStackBackupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty lists all stacks
+	Stack         string `protobuf:"bytes,1,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStackBackupsRequest) Reset() {
+	*x = ListStackBackupsRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStackBackupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStackBackupsRequest) ProtoMessage() {}
+
+func (x *ListStackBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStackBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListStackBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListStackBackupsRequest) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+type ListStackBackupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*BackupRecord        `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStackBackupsResponse) Reset() {
+	*x = ListStackBackupsResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStackBackupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStackBackupsResponse) ProtoMessage() {}
+
+func (x *ListStackBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStackBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListStackBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListStackBackupsResponse) GetRecords() []*BackupRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type StackRestoreRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// stop the stack during the restore and start the running containers again after
+	StopContainers bool `protobuf:"varint,2,opt,name=stopContainers,proto3" json:"stopContainers,omitempty"`
+	// remove existing bind mount and volume contents before extracting
+	Clear         bool `protobuf:"varint,3,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StackRestoreRequest) Reset() {
+	*x = StackRestoreRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StackRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackRestoreRequest) ProtoMessage() {}
+
+func (x *StackRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackRestoreRequest.ProtoReflect.Descriptor instead.
+func (*StackRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *StackRestoreRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StackRestoreRequest) GetStopContainers() bool {
+	if x != nil {
+		return x.StopContainers
+	}
+	return false
+}
+
+func (x *StackRestoreRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+type DeleteStackBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStackBackupRequest) Reset() {
+	*x = DeleteStackBackupRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStackBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStackBackupRequest) ProtoMessage() {}
+
+func (x *DeleteStackBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStackBackupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStackBackupRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteStackBackupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_backup_v1_backup_proto protoreflect.FileDescriptor
+
+const file_backup_v1_backup_proto_rawDesc = "" +
+	"\n" +
+	"\x16backup/v1/backup.proto\x12\tbackup.v1\"\a\n" +
+	"\x05Empty\"6\n" +
+	"\bLocation\x12\x18\n" +
+	"\amachine\x18\x01 \x01(\tR\amachine\x12\x10\n" +
+	"\x03dir\x18\x02 \x01(\tR\x03dir\"\x94\x01\n" +
+	"\aArchive\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\tR\x06volume\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\tR\acreated\x12/\n" +
+	"\blocation\x18\x05 \x01(\v2\x13.backup.v1.LocationR\blocation\"r\n" +
+	"\x13VolumeBackupRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\tR\x06volume\x12/\n" +
+	"\blocation\x18\x03 \x01(\v2\x13.backup.v1.LocationR\blocation\"\xcb\x01\n" +
+	"\x14VolumeRestoreRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12/\n" +
+	"\blocation\x18\x02 \x01(\v2\x13.backup.v1.LocationR\blocation\x12\x18\n" +
+	"\aarchive\x18\x03 \x01(\tR\aarchive\x12\x16\n" +
+	"\x06volume\x18\x04 \x01(\tR\x06volume\x12&\n" +
+	"\x0estopContainers\x18\x05 \x01(\bR\x0estopContainers\x12\x14\n" +
+	"\x05clear\x18\x06 \x01(\bR\x05clear\"F\n" +
+	"\x14ListArchivesResponse\x12.\n" +
+	"\barchives\x18\x01 \x03(\v2\x12.backup.v1.ArchiveR\barchives\"[\n" +
+	"\x14DeleteArchiveRequest\x12/\n" +
+	"\blocation\x18\x01 \x01(\v2\x13.backup.v1.LocationR\blocation\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd7\x02\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06enable\x18\x02 \x01(\bR\x06enable\x12\x14\n" +
+	"\x05stack\x18\x03 \x01(\tR\x05stack\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12/\n" +
+	"\blocation\x18\x05 \x01(\v2\x13.backup.v1.LocationR\blocation\x12,\n" +
+	"\x11intervalInSeconds\x18\x06 \x01(\x03R\x11intervalInSeconds\x12&\n" +
+	"\x0estopContainers\x18\a \x01(\bR\x0estopContainers\x12\x1a\n" +
+	"\bkeepLast\x18\b \x01(\x05R\bkeepLast\x12\x1c\n" +
+	"\tkeepDaily\x18\t \x01(\x05R\tkeepDaily\x12\x1e\n" +
+	"\n" +
+	"keepWeekly\x18\n" +
+	" \x01(\x05R\n" +
+	"keepWeekly\x12\x18\n" +
+	"\alastRun\x18\v \x01(\tR\alastRun\"J\n" +
+	"\x15ListSchedulesResponse\x121\n" +
+	"\tschedules\x18\x01 \x03(\v2\x13.backup.v1.ScheduleR\tschedules\"'\n" +
+	"\x15DeleteScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xb7\x02\n" +
+	"\fBackupRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1e\n" +
+	"\n" +
+	"scheduleId\x18\x02 \x01(\x04R\n" +
+	"scheduleId\x12\x14\n" +
+	"\x05stack\x18\x03 \x01(\tR\x05stack\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12/\n" +
+	"\blocation\x18\x05 \x01(\v2\x13.backup.v1.LocationR\blocation\x12\x18\n" +
+	"\aarchive\x18\x06 \x01(\tR\aarchive\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1c\n" +
+	"\tstartedAt\x18\n" +
+	" \x01(\tR\tstartedAt\x12\"\n" +
+	"\fdurationInMs\x18\v \x01(\x03R\fdurationInMs\"\x97\x01\n" +
+	"\x12StackBackupRequest\x12\x14\n" +
+	"\x05stack\x18\x01 \x01(\tR\x05stack\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12/\n" +
+	"\blocation\x18\x03 \x01(\v2\x13.backup.v1.LocationR\blocation\x12&\n" +
+	"\x0estopContainers\x18\x04 \x01(\bR\x0estopContainers\"/\n" +
+	"\x17ListStackBackupsRequest\x12\x14\n" +
+	"\x05stack\x18\x01 \x01(\tR\x05stack\"M\n" +
+	"\x18ListStackBackupsResponse\x121\n" +
+	"\arecords\x18\x01 \x03(\v2\x17.backup.v1.BackupRecordR\arecords\"c\n" +
+	"\x13StackRestoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12&\n" +
+	"\x0estopContainers\x18\x02 \x01(\bR\x0estopContainers\x12\x14\n" +
+	"\x05clear\x18\x03 \x01(\bR\x05clear\"*\n" +
+	"\x18DeleteStackBackupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id2\xb4\x06\n" +
+	"\rBackupService\x12D\n" +
+	"\fVolumeBackup\x12\x1e.backup.v1.VolumeBackupRequest\x1a\x12.backup.v1.Archive\"\x00\x12D\n" +
+	"\rVolumeRestore\x12\x1f.backup.v1.VolumeRestoreRequest\x1a\x10.backup.v1.Empty\"\x00\x12I\n" +
+	"\fListArchives\x12\x13.backup.v1.Location\x1a\x1f.backup.v1.ListArchivesResponse\"\x03\x90\x02\x01\x12D\n" +
+	"\rDeleteArchive\x12\x1f.backup.v1.DeleteArchiveRequest\x1a\x10.backup.v1.Empty\"\x00\x12H\n" +
+	"\rListSchedules\x12\x10.backup.v1.Empty\x1a .backup.v1.ListSchedulesResponse\"\x03\x90\x02\x01\x127\n" +
+	"\fSaveSchedule\x12\x13.backup.v1.Schedule\x1a\x10.backup.v1.Empty\"\x00\x12F\n" +
+	"\x0eDeleteSchedule\x12 .backup.v1.DeleteScheduleRequest\x1a\x10.backup.v1.Empty\"\x00\x12G\n" +
+	"\vStackBackup\x12\x1d.backup.v1.StackBackupRequest\x1a\x17.backup.v1.BackupRecord\"\x00\x12`\n" +
+	"\x10ListStackBackups\x12\".backup.v1.ListStackBackupsRequest\x1a#.backup.v1.ListStackBackupsResponse\"\x03\x90\x02\x01\x12B\n" +
+	"\fStackRestore\x12\x1e.backup.v1.StackRestoreRequest\x1a\x10.backup.v1.Empty\"\x00\x12L\n" +
+	"\x11DeleteStackBackup\x12#.backup.v1.DeleteStackBackupRequest\x1a\x10.backup.v1.Empty\"\x00B\x8f\x01\n" +
+	"\rcom.backup.v1B\vBackupProtoP\x01Z,github.com/RA341/dockman/generated/backup/v1\xa2\x02\x03BXX\xaa\x02\tBackup.V1\xca\x02\tBackup\\V1\xe2\x02\x15Backup\\V1\\GPBMetadata\xea\x02\n" +
+	"Backup::V1b\x06proto3"
+
+var (
+	file_backup_v1_backup_proto_rawDescOnce sync.Once
+	file_backup_v1_backup_proto_rawDescData []byte
+)
+
+func file_backup_v1_backup_proto_rawDescGZIP() []byte {
+	file_backup_v1_backup_proto_rawDescOnce.Do(func() {
+		file_backup_v1_backup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_backup_v1_backup_proto_rawDesc), len(file_backup_v1_backup_proto_rawDesc)))
+	})
+	return file_backup_v1_backup_proto_rawDescData
+}
+
+var file_backup_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_backup_v1_backup_proto_goTypes = []any{
+	(*Empty)(nil),                    // 0: backup.v1.Empty
+	(*Location)(nil),                 // 1: backup.v1.Location
+	(*Archive)(nil),                  // 2: backup.v1.Archive
+	(*VolumeBackupRequest)(nil),      // 3: backup.v1.VolumeBackupRequest
+	(*VolumeRestoreRequest)(nil),     // 4: backup.v1.VolumeRestoreRequest
+	(*ListArchivesResponse)(nil),     // 5: backup.v1.ListArchivesResponse
+	(*DeleteArchiveRequest)(nil),     // 6: backup.v1.DeleteArchiveRequest
+	(*Schedule)(nil),                 // 7: backup.v1.Schedule
+	(*ListSchedulesResponse)(nil),    // 8: backup.v1.ListSchedulesResponse
+	(*DeleteScheduleRequest)(nil),    // 9: backup.v1.DeleteScheduleRequest
+	(*BackupRecord)(nil),             // 10: backup.v1.BackupRecord
+	(*StackBackupRequest)(nil),       // 11: backup.v1.StackBackupRequest
+	(*ListStackBackupsRequest)(nil),  // 12: backup.v1.ListStackBackupsRequest
+	(*ListStackBackupsResponse)(nil), // 13: backup.v1.ListStackBackupsResponse
+	(*StackRestoreRequest)(nil),      // 14: backup.v1.StackRestoreRequest
+	(*DeleteStackBackupRequest)(nil), // 15: backup.v1.DeleteStackBackupRequest
+}
+var file_backup_v1_backup_proto_depIdxs = []int32{
+	1,  // 0: backup.v1.Archive.location:type_name -> backup.v1.Location
+	1,  // 1: backup.v1.VolumeBackupRequest.location:type_name -> backup.v1.Location
+	1,  // 2: backup.v1.VolumeRestoreRequest.location:type_name -> backup.v1.Location
+	2,  // 3: backup.v1.ListArchivesResponse.archives:type_name -> backup.v1.Archive
+	1,  // 4: backup.v1.DeleteArchiveRequest.location:type_name -> backup.v1.Location
+	1,  // 5: backup.v1.Schedule.location:type_name -> backup.v1.Location
+	7,  // 6: backup.v1.ListSchedulesResponse.schedules:type_name -> backup.v1.Schedule
+	1,  // 7: backup.v1.BackupRecord.location:type_name -> backup.v1.Location
+	1,  // 8: backup.v1.StackBackupRequest.location:type_name -> backup.v1.Location
+	10, // 9: backup.v1.ListStackBackupsResponse.records:type_name -> backup.v1.BackupRecord
+	3,  // 10: backup.v1.BackupService.VolumeBackup:input_type -> backup.v1.VolumeBackupRequest
+	4,  // 11: backup.v1.BackupService.VolumeRestore:input_type -> backup.v1.VolumeRestoreRequest
+	1,  // 12: backup.v1.BackupService.ListArchives:input_type -> backup.v1.Location
+	6,  // 13: backup.v1.BackupService.DeleteArchive:input_type -> backup.v1.DeleteArchiveRequest
+	0,  // 14: backup.v1.BackupService.ListSchedules:input_type -> backup.v1.Empty
+	7,  // 15: backup.v1.BackupService.SaveSchedule:input_type -> backup.v1.Schedule
+	9,  // 16: backup.v1.BackupService.DeleteSchedule:input_type -> backup.v1.DeleteScheduleRequest
+	11, // 17: backup.v1.BackupService.StackBackup:input_type -> backup.v1.StackBackupRequest
+	12, // 18: backup.v1.BackupService.ListStackBackups:input_type -> backup.v1.ListStackBackupsRequest
+	14, // 19: backup.v1.BackupService.StackRestore:input_type -> backup.v1.StackRestoreRequest
+	15, // 20: backup.v1.BackupService.DeleteStackBackup:input_type -> backup.v1.DeleteStackBackupRequest
+	2,  // 21: backup.v1.BackupService.VolumeBackup:output_type -> backup.v1.Archive
+	0,  // 22: backup.v1.BackupService.VolumeRestore:output_type -> backup.v1.Empty
+	5,  // 23: backup.v1.BackupService.ListArchives:output_type -> backup.v1.ListArchivesResponse
+	0,  // 24: backup.v1.BackupService.DeleteArchive:output_type -> backup.v1.Empty
+	8,  // 25: backup.v1.BackupService.ListSchedules:output_type -> backup.v1.ListSchedulesResponse
+	0,  // 26: backup.v1.BackupService.SaveSchedule:output_type -> backup.v1.Empty
+	0,  // 27: backup.v1.BackupService.DeleteSchedule:output_type -> backup.v1.Empty
+	10, // 28: backup.v1.BackupService.StackBackup:output_type -> backup.v1.BackupRecord
+	13, // 29: backup.v1.BackupService.ListStackBackups:output_type -> backup.v1.ListStackBackupsResponse
+	0,  // 30: backup.v1.BackupService.StackRestore:output_type -> backup.v1.Empty
+	0,  // 31: backup.v1.BackupService.DeleteStackBackup:output_type -> backup.v1.Empty
+	21, // [21:32] is the sub-list for method output_type
+	10, // [10:21] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_backup_v1_backup_proto_init() }
+func file_backup_v1_backup_proto_init() {
+	if File_backup_v1_backup_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backup_v1_backup_proto_rawDesc), len(file_backup_v1_backup_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_backup_v1_backup_proto_goTypes,
+		DependencyIndexes: file_backup_v1_backup_proto_depIdxs,
+		MessageInfos:      file_backup_v1_backup_proto_msgTypes,
+	}.Build()
+	File_backup_v1_backup_proto = out.File
+	file_backup_v1_backup_proto_goTypes = nil
+	file_backup_v1_backup_proto_depIdxs = nil
+}
@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: backup/v1/backup.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/backup/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BackupServiceName is the fully-qualified name of the BackupService service.
+	BackupServiceName = "backup.v1.BackupService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BackupServiceVolumeBackupProcedure is the fully-qualified name of the BackupService's
+	// VolumeBackup RPC.
+	BackupServiceVolumeBackupProcedure = "/backup.v1.BackupService/VolumeBackup"
+	// BackupServiceVolumeRestoreProcedure is the fully-qualified name of the BackupService's
+	// VolumeRestore RPC.
+	BackupServiceVolumeRestoreProcedure = "/backup.v1.BackupService/VolumeRestore"
+	// BackupServiceListArchivesProcedure is the fully-qualified name of the BackupService's
+	// ListArchives RPC.
+	BackupServiceListArchivesProcedure = "/backup.v1.BackupService/ListArchives"
+	// BackupServiceDeleteArchiveProcedure is the fully-qualified name of the BackupService's
+	// DeleteArchive RPC.
+	BackupServiceDeleteArchiveProcedure = "/backup.v1.BackupService/DeleteArchive"
+	// BackupServiceListSchedulesProcedure is the fully-qualified name of the BackupService's
+	// ListSchedules RPC.
+	BackupServiceListSchedulesProcedure = "/backup.v1.BackupService/ListSchedules"
+	// BackupServiceSaveScheduleProcedure is the fully-qualified name of the BackupService's
+	// SaveSchedule RPC.
+	BackupServiceSaveScheduleProcedure = "/backup.v1.BackupService/SaveSchedule"
+	// BackupServiceDeleteScheduleProcedure is the fully-qualified name of the BackupService's
+	// DeleteSchedule RPC.
+	BackupServiceDeleteScheduleProcedure = "/backup.v1.BackupService/DeleteSchedule"
+	// BackupServiceStackBackupProcedure is the fully-qualified name of the BackupService's StackBackup
+	// RPC.
+	BackupServiceStackBackupProcedure = "/backup.v1.BackupService/StackBackup"
+	// BackupServiceListStackBackupsProcedure is the fully-qualified name of the BackupService's
+	// ListStackBackups RPC.
+	BackupServiceListStackBackupsProcedure = "/backup.v1.BackupService/ListStackBackups"
+	// BackupServiceStackRestoreProcedure is the fully-qualified name of the BackupService's
+	// StackRestore RPC.
+	BackupServiceStackRestoreProcedure = "/backup.v1.BackupService/StackRestore"
+	// BackupServiceDeleteStackBackupProcedure is the fully-qualified name of the BackupService's
+	// DeleteStackBackup RPC.
+	BackupServiceDeleteStackBackupProcedure = "/backup.v1.BackupService/DeleteStackBackup"
+)
+
+// BackupServiceClient is a client for the backup.v1.BackupService service.
+type BackupServiceClient interface {
+	// streams a volume into a new tar.zst archive
+	VolumeBackup(context.Context, *connect.Request[v1.VolumeBackupRequest]) (*connect.Response[v1.Archive], error)
+	// extracts an archive into a new or existing volume
+	VolumeRestore(context.Context, *connect.Request[v1.VolumeRestoreRequest]) (*connect.Response[v1.Empty], error)
+	ListArchives(context.Context, *connect.Request[v1.Location]) (*connect.Response[v1.ListArchivesResponse], error)
+	DeleteArchive(context.Context, *connect.Request[v1.DeleteArchiveRequest]) (*connect.Response[v1.Empty], error)
+	ListSchedules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListSchedulesResponse], error)
+	SaveSchedule(context.Context, *connect.Request[v1.Schedule]) (*connect.Response[v1.Empty], error)
+	// archives and history of the schedule are kept
+	DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.Empty], error)
+	// archives the compose folder, bind mounts under the compose root and named volumes of a stack
+	StackBackup(context.Context, *connect.Request[v1.StackBackupRequest]) (*connect.Response[v1.BackupRecord], error)
+	// backup history newest first
+	ListStackBackups(context.Context, *connect.Request[v1.ListStackBackupsRequest]) (*connect.Response[v1.ListStackBackupsResponse], error)
+	StackRestore(context.Context, *connect.Request[v1.StackRestoreRequest]) (*connect.Response[v1.Empty], error)
+	// removes the archive and its history entry
+	DeleteStackBackup(context.Context, *connect.Request[v1.DeleteStackBackupRequest]) (*connect.Response[v1.Empty], error)
+}
+
+// NewBackupServiceClient constructs a client for the backup.v1.BackupService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBackupServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BackupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	backupServiceMethods := v1.File_backup_v1_backup_proto.Services().ByName("BackupService").Methods()
+	return &backupServiceClient{
+		volumeBackup: connect.NewClient[v1.VolumeBackupRequest, v1.Archive](
+			httpClient,
+			baseURL+BackupServiceVolumeBackupProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("VolumeBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		volumeRestore: connect.NewClient[v1.VolumeRestoreRequest, v1.Empty](
+			httpClient,
+			baseURL+BackupServiceVolumeRestoreProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("VolumeRestore")),
+			connect.WithClientOptions(opts...),
+		),
+		listArchives: connect.NewClient[v1.Location, v1.ListArchivesResponse](
+			httpClient,
+			baseURL+BackupServiceListArchivesProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ListArchives")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteArchive: connect.NewClient[v1.DeleteArchiveRequest, v1.Empty](
+			httpClient,
+			baseURL+BackupServiceDeleteArchiveProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("DeleteArchive")),
+			connect.WithClientOptions(opts...),
+		),
+		listSchedules: connect.NewClient[v1.Empty, v1.ListSchedulesResponse](
+			httpClient,
+			baseURL+BackupServiceListSchedulesProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ListSchedules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		saveSchedule: connect.NewClient[v1.Schedule, v1.Empty](
+			httpClient,
+			baseURL+BackupServiceSaveScheduleProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("SaveSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSchedule: connect.NewClient[v1.DeleteScheduleRequest, v1.Empty](
+			httpClient,
+			baseURL+BackupServiceDeleteScheduleProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("DeleteSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		stackBackup: connect.NewClient[v1.StackBackupRequest, v1.BackupRecord](
+			httpClient,
+			baseURL+BackupServiceStackBackupProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("StackBackup")),
+			connect.WithClientOptions(opts...),
+		),
+		listStackBackups: connect.NewClient[v1.ListStackBackupsRequest, v1.ListStackBackupsResponse](
+			httpClient,
+			baseURL+BackupServiceListStackBackupsProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("ListStackBackups")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		stackRestore: connect.NewClient[v1.StackRestoreRequest, v1.Empty](
+			httpClient,
+			baseURL+BackupServiceStackRestoreProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("StackRestore")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteStackBackup: connect.NewClient[v1.DeleteStackBackupRequest, v1.Empty](
+			httpClient,
+			baseURL+BackupServiceDeleteStackBackupProcedure,
+			connect.WithSchema(backupServiceMethods.ByName("DeleteStackBackup")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// backupServiceClient implements BackupServiceClient.
+type backupServiceClient struct {
+	volumeBackup      *connect.Client[v1.VolumeBackupRequest, v1.Archive]
+	volumeRestore     *connect.Client[v1.VolumeRestoreRequest, v1.Empty]
+	listArchives      *connect.Client[v1.Location, v1.ListArchivesResponse]
+	deleteArchive     *connect.Client[v1.DeleteArchiveRequest, v1.Empty]
+	listSchedules     *connect.Client[v1.Empty, v1.ListSchedulesResponse]
+	saveSchedule      *connect.Client[v1.Schedule, v1.Empty]
+	deleteSchedule    *connect.Client[v1.DeleteScheduleRequest, v1.Empty]
+	stackBackup       *connect.Client[v1.StackBackupRequest, v1.BackupRecord]
+	listStackBackups  *connect.Client[v1.ListStackBackupsRequest, v1.ListStackBackupsResponse]
+	stackRestore      *connect.Client[v1.StackRestoreRequest, v1.Empty]
+	deleteStackBackup *connect.Client[v1.DeleteStackBackupRequest, v1.Empty]
+}
+
+// VolumeBackup calls backup.v1.BackupService.VolumeBackup.
+func (c *backupServiceClient) VolumeBackup(ctx context.Context, req *connect.Request[v1.VolumeBackupRequest]) (*connect.Response[v1.Archive], error) {
+	return c.volumeBackup.CallUnary(ctx, req)
+}
+
+// VolumeRestore calls backup.v1.BackupService.VolumeRestore.
+func (c *backupServiceClient) VolumeRestore(ctx context.Context, req *connect.Request[v1.VolumeRestoreRequest]) (*connect.Response[v1.Empty], error) {
+	return c.volumeRestore.CallUnary(ctx, req)
+}
+
+// ListArchives calls backup.v1.BackupService.ListArchives.
+func (c *backupServiceClient) ListArchives(ctx context.Context, req *connect.Request[v1.Location]) (*connect.Response[v1.ListArchivesResponse], error) {
+	return c.listArchives.CallUnary(ctx, req)
+}
+
+// DeleteArchive calls backup.v1.BackupService.DeleteArchive.
+func (c *backupServiceClient) DeleteArchive(ctx context.Context, req *connect.Request[v1.DeleteArchiveRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteArchive.CallUnary(ctx, req)
+}
+
+// ListSchedules calls backup.v1.BackupService.ListSchedules.
+func (c *backupServiceClient) ListSchedules(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	return c.listSchedules.CallUnary(ctx, req)
+}
+
+// SaveSchedule calls backup.v1.BackupService.SaveSchedule.
+func (c *backupServiceClient) SaveSchedule(ctx context.Context, req *connect.Request[v1.Schedule]) (*connect.Response[v1.Empty], error) {
+	return c.saveSchedule.CallUnary(ctx, req)
+}
+
+// DeleteSchedule calls backup.v1.BackupService.DeleteSchedule.
+func (c *backupServiceClient) DeleteSchedule(ctx context.Context, req *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteSchedule.CallUnary(ctx, req)
+}
+
+// StackBackup calls backup.v1.BackupService.StackBackup.
+func (c *backupServiceClient) StackBackup(ctx context.Context, req *connect.Request[v1.StackBackupRequest]) (*connect.Response[v1.BackupRecord], error) {
+	return c.stackBackup.CallUnary(ctx, req)
+}
+
+// ListStackBackups calls backup.v1.BackupService.ListStackBackups.
+func (c *backupServiceClient) ListStackBackups(ctx context.Context, req *connect.Request[v1.ListStackBackupsRequest]) (*connect.Response[v1.ListStackBackupsResponse], error) {
+	return c.listStackBackups.CallUnary(ctx, req)
+}
+
+// StackRestore calls backup.v1.BackupService.StackRestore.
+func (c *backupServiceClient) StackRestore(ctx context.Context, req *connect.Request[v1.StackRestoreRequest]) (*connect.Response[v1.Empty], error) {
+	return c.stackRestore.CallUnary(ctx, req)
+}
+
+// DeleteStackBackup calls backup.v1.BackupService.DeleteStackBackup.
+func (c *backupServiceClient) DeleteStackBackup(ctx context.Context, req *connect.Request[v1.DeleteStackBackupRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deleteStackBackup.CallUnary(ctx, req)
+}
+
+// BackupServiceHandler is an implementation of the backup.v1.BackupService service.
+type BackupServiceHandler interface {
+	// streams a volume into a new tar.zst archive
+	VolumeBackup(context.Context, *connect.Request[v1.VolumeBackupRequest]) (*connect.Response[v1.Archive], error)
+	// extracts an archive into a new or existing volume
+	VolumeRestore(context.Context, *connect.Request[v1.VolumeRestoreRequest]) (*connect.Response[v1.Empty], error)
+	ListArchives(context.Context, *connect.Request[v1.Location]) (*connect.Response[v1.ListArchivesResponse], error)
+	DeleteArchive(context.Context, *connect.Request[v1.DeleteArchiveRequest]) (*connect.Response[v1.Empty], error)
+	ListSchedules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListSchedulesResponse], error)
+	SaveSchedule(context.Context, *connect.Request[v1.Schedule]) (*connect.Response[v1.Empty], error)
+	// archives and history of the schedule are kept
+	DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.Empty], error)
+	// archives the compose folder, bind mounts under the compose root and named volumes of a stack
+	StackBackup(context.Context, *connect.Request[v1.StackBackupRequest]) (*connect.Response[v1.BackupRecord], error)
+	// backup history newest first
+	ListStackBackups(context.Context, *connect.Request[v1.ListStackBackupsRequest]) (*connect.Response[v1.ListStackBackupsResponse], error)
+	StackRestore(context.Context, *connect.Request[v1.StackRestoreRequest]) (*connect.Response[v1.Empty], error)
+	// removes the archive and its history entry
+	DeleteStackBackup(context.Context, *connect.Request[v1.DeleteStackBackupRequest]) (*connect.Response[v1.Empty], error)
+}
+
+// NewBackupServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBackupServiceHandler(svc BackupServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	backupServiceMethods := v1.File_backup_v1_backup_proto.Services().ByName("BackupService").Methods()
+	backupServiceVolumeBackupHandler := connect.NewUnaryHandler(
+		BackupServiceVolumeBackupProcedure,
+		svc.VolumeBackup,
+		connect.WithSchema(backupServiceMethods.ByName("VolumeBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceVolumeRestoreHandler := connect.NewUnaryHandler(
+		BackupServiceVolumeRestoreProcedure,
+		svc.VolumeRestore,
+		connect.WithSchema(backupServiceMethods.ByName("VolumeRestore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceListArchivesHandler := connect.NewUnaryHandler(
+		BackupServiceListArchivesProcedure,
+		svc.ListArchives,
+		connect.WithSchema(backupServiceMethods.ByName("ListArchives")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceDeleteArchiveHandler := connect.NewUnaryHandler(
+		BackupServiceDeleteArchiveProcedure,
+		svc.DeleteArchive,
+		connect.WithSchema(backupServiceMethods.ByName("DeleteArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceListSchedulesHandler := connect.NewUnaryHandler(
+		BackupServiceListSchedulesProcedure,
+		svc.ListSchedules,
+		connect.WithSchema(backupServiceMethods.ByName("ListSchedules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceSaveScheduleHandler := connect.NewUnaryHandler(
+		BackupServiceSaveScheduleProcedure,
+		svc.SaveSchedule,
+		connect.WithSchema(backupServiceMethods.ByName("SaveSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceDeleteScheduleHandler := connect.NewUnaryHandler(
+		BackupServiceDeleteScheduleProcedure,
+		svc.DeleteSchedule,
+		connect.WithSchema(backupServiceMethods.ByName("DeleteSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceStackBackupHandler := connect.NewUnaryHandler(
+		BackupServiceStackBackupProcedure,
+		svc.StackBackup,
+		connect.WithSchema(backupServiceMethods.ByName("StackBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceListStackBackupsHandler := connect.NewUnaryHandler(
+		BackupServiceListStackBackupsProcedure,
+		svc.ListStackBackups,
+		connect.WithSchema(backupServiceMethods.ByName("ListStackBackups")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceStackRestoreHandler := connect.NewUnaryHandler(
+		BackupServiceStackRestoreProcedure,
+		svc.StackRestore,
+		connect.WithSchema(backupServiceMethods.ByName("StackRestore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	backupServiceDeleteStackBackupHandler := connect.NewUnaryHandler(
+		BackupServiceDeleteStackBackupProcedure,
+		svc.DeleteStackBackup,
+		connect.WithSchema(backupServiceMethods.ByName("DeleteStackBackup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/backup.v1.BackupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BackupServiceVolumeBackupProcedure:
+			backupServiceVolumeBackupHandler.ServeHTTP(w, r)
+		case BackupServiceVolumeRestoreProcedure:
+			backupServiceVolumeRestoreHandler.ServeHTTP(w, r)
+		case BackupServiceListArchivesProcedure:
+			backupServiceListArchivesHandler.ServeHTTP(w, r)
+		case BackupServiceDeleteArchiveProcedure:
+			backupServiceDeleteArchiveHandler.ServeHTTP(w, r)
+		case BackupServiceListSchedulesProcedure:
+			backupServiceListSchedulesHandler.ServeHTTP(w, r)
+		case BackupServiceSaveScheduleProcedure:
+			backupServiceSaveScheduleHandler.ServeHTTP(w, r)
+		case BackupServiceDeleteScheduleProcedure:
+			backupServiceDeleteScheduleHandler.ServeHTTP(w, r)
+		case BackupServiceStackBackupProcedure:
+			backupServiceStackBackupHandler.ServeHTTP(w, r)
+		case BackupServiceListStackBackupsProcedure:
+			backupServiceListStackBackupsHandler.ServeHTTP(w, r)
+		case BackupServiceStackRestoreProcedure:
+			backupServiceStackRestoreHandler.ServeHTTP(w, r)
+		case BackupServiceDeleteStackBackupProcedure:
+			backupServiceDeleteStackBackupHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBackupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBackupServiceHandler struct{}
+
+func (UnimplementedBackupServiceHandler) VolumeBackup(context.Context, *connect.Request[v1.VolumeBackupRequest]) (*connect.Response[v1.Archive], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.VolumeBackup is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) VolumeRestore(context.Context, *connect.Request[v1.VolumeRestoreRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.VolumeRestore is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) ListArchives(context.Context, *connect.Request[v1.Location]) (*connect.Response[v1.ListArchivesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.ListArchives is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) DeleteArchive(context.Context, *connect.Request[v1.DeleteArchiveRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.DeleteArchive is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) ListSchedules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.ListSchedules is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) SaveSchedule(context.Context, *connect.Request[v1.Schedule]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.SaveSchedule is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) DeleteSchedule(context.Context, *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.DeleteSchedule is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) StackBackup(context.Context, *connect.Request[v1.StackBackupRequest]) (*connect.Response[v1.BackupRecord], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.StackBackup is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) ListStackBackups(context.Context, *connect.Request[v1.ListStackBackupsRequest]) (*connect.Response[v1.ListStackBackupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.ListStackBackups is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) StackRestore(context.Context, *connect.Request[v1.StackRestoreRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.StackRestore is not implemented"))
+}
+
+func (UnimplementedBackupServiceHandler) DeleteStackBackup(context.Context, *connect.Request[v1.DeleteStackBackupRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("backup.v1.BackupService.DeleteStackBackup is not implemented"))
+}
@@ -0,0 +1,763 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: cleanup/v1/cleanup.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{0}
+}
+
+type Policy struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Enable bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	// empty for the active host, resolved to its name when saved
+	Host              string `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	IntervalInSeconds int64  `protobuf:"varint,5,opt,name=intervalInSeconds,proto3" json:"intervalInSeconds,omitempty"`
+	// stopped containers
+	Containers bool `protobuf:"varint,6,opt,name=containers,proto3" json:"containers,omitempty"`
+	Images     bool `protobuf:"varint,7,opt,name=images,proto3" json:"images,omitempty"`
+	// also tagged images without a container, otherwise only dangling
+	AllImages bool `protobuf:"varint,8,opt,name=allImages,proto3" json:"allImages,omitempty"`
+	Volumes   bool `protobuf:"varint,9,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	// also named volumes, otherwise only anonymous
+	AllVolumes bool `protobuf:"varint,10,opt,name=allVolumes,proto3" json:"allVolumes,omitempty"`
+	Networks   bool `protobuf:"varint,11,opt,name=networks,proto3" json:"networks,omitempty"`
+	BuildCache bool `protobuf:"varint,12,opt,name=buildCache,proto3" json:"buildCache,omitempty"`
+	// keep the most recently used build cache up to this many bytes, 0 removes all unused cache
+	BuildCacheKeep int64 `protobuf:"varint,13,opt,name=buildCacheKeep,proto3" json:"buildCacheKeep,omitempty"`
+	// only prune objects older than this, 0 prunes regardless of age, not supported for volumes
+	OlderThanInSeconds int64 `protobuf:"varint,14,opt,name=olderThanInSeconds,proto3" json:"olderThanInSeconds,omitempty"`
+	// key or key=value, prefix with ! to skip matching objects, not supported for the build cache
+	Labels []string `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty"`
+	// send a notification after each scheduled run
+	Notify        bool   `protobuf:"varint,16,opt,name=notify,proto3" json:"notify,omitempty"`
+	LastRun       string `protobuf:"bytes,17,opt,name=lastRun,proto3" json:"lastRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Policy) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Policy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Policy) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Policy) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Policy) GetIntervalInSeconds() int64 {
+	if x != nil {
+		return x.IntervalInSeconds
+	}
+	return 0
+}
+
+func (x *Policy) GetContainers() bool {
+	if x != nil {
+		return x.Containers
+	}
+	return false
+}
+
+func (x *Policy) GetImages() bool {
+	if x != nil {
+		return x.Images
+	}
+	return false
+}
+
+func (x *Policy) GetAllImages() bool {
+	if x != nil {
+		return x.AllImages
+	}
+	return false
+}
+
+func (x *Policy) GetVolumes() bool {
+	if x != nil {
+		return x.Volumes
+	}
+	return false
+}
+
+func (x *Policy) GetAllVolumes() bool {
+	if x != nil {
+		return x.AllVolumes
+	}
+	return false
+}
+
+func (x *Policy) GetNetworks() bool {
+	if x != nil {
+		return x.Networks
+	}
+	return false
+}
+
+func (x *Policy) GetBuildCache() bool {
+	if x != nil {
+		return x.BuildCache
+	}
+	return false
+}
+
+func (x *Policy) GetBuildCacheKeep() int64 {
+	if x != nil {
+		return x.BuildCacheKeep
+	}
+	return 0
+}
+
+func (x *Policy) GetOlderThanInSeconds() int64 {
+	if x != nil {
+		return x.OlderThanInSeconds
+	}
+	return 0
+}
+
+func (x *Policy) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Policy) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *Policy) GetLastRun() string {
+	if x != nil {
+		return x.LastRun
+	}
+	return ""
+}
+
+type ListPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*Policy              `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesResponse) Reset() {
+	*x = ListPoliciesResponse{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesResponse) ProtoMessage() {}
+
+func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type DeletePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePolicyRequest) Reset() {
+	*x = DeletePolicyRequest{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyRequest) ProtoMessage() {}
+
+func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeletePolicyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RunPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunPolicyRequest) Reset() {
+	*x = RunPolicyRequest{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunPolicyRequest) ProtoMessage() {}
+
+func (x *RunPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunPolicyRequest.ProtoReflect.Descriptor instead.
+func (*RunPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RunPolicyRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Report struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PolicyId uint64                 `protobuf:"varint,2,opt,name=policyId,proto3" json:"policyId,omitempty"`
+	Policy   string                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	Host     string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Manual   bool                   `protobuf:"varint,5,opt,name=manual,proto3" json:"manual,omitempty"`
+	// success|partial|failed
+	Status            string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Error             string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ContainersDeleted int32  `protobuf:"varint,8,opt,name=containersDeleted,proto3" json:"containersDeleted,omitempty"`
+	ImagesDeleted     int32  `protobuf:"varint,9,opt,name=imagesDeleted,proto3" json:"imagesDeleted,omitempty"`
+	VolumesDeleted    int32  `protobuf:"varint,10,opt,name=volumesDeleted,proto3" json:"volumesDeleted,omitempty"`
+	NetworksDeleted   int32  `protobuf:"varint,11,opt,name=networksDeleted,proto3" json:"networksDeleted,omitempty"`
+	CachesDeleted     int32  `protobuf:"varint,12,opt,name=cachesDeleted,proto3" json:"cachesDeleted,omitempty"`
+	SpaceReclaimed    uint64 `protobuf:"varint,13,opt,name=spaceReclaimed,proto3" json:"spaceReclaimed,omitempty"`
+	StartedAt         string `protobuf:"bytes,14,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	DurationInMs      int64  `protobuf:"varint,15,opt,name=durationInMs,proto3" json:"durationInMs,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Report) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Report) GetPolicyId() uint64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *Report) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *Report) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Report) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *Report) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Report) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Report) GetContainersDeleted() int32 {
+	if x != nil {
+		return x.ContainersDeleted
+	}
+	return 0
+}
+
+func (x *Report) GetImagesDeleted() int32 {
+	if x != nil {
+		return x.ImagesDeleted
+	}
+	return 0
+}
+
+func (x *Report) GetVolumesDeleted() int32 {
+	if x != nil {
+		return x.VolumesDeleted
+	}
+	return 0
+}
+
+func (x *Report) GetNetworksDeleted() int32 {
+	if x != nil {
+		return x.NetworksDeleted
+	}
+	return 0
+}
+
+func (x *Report) GetCachesDeleted() int32 {
+	if x != nil {
+		return x.CachesDeleted
+	}
+	return 0
+}
+
+func (x *Report) GetSpaceReclaimed() uint64 {
+	if x != nil {
+		return x.SpaceReclaimed
+	}
+	return 0
+}
+
+func (x *Report) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Report) GetDurationInMs() int64 {
+	if x != nil {
+		return x.DurationInMs
+	}
+	return 0
+}
+
+type ListReportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 lists the reports of all policies
+	PolicyId uint64 `protobuf:"varint,1,opt,name=policyId,proto3" json:"policyId,omitempty"`
+	// defaults to 100
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListReportsRequest) GetPolicyId() uint64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*Report              `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cleanup_v1_cleanup_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_cleanup_v1_cleanup_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListReportsResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+var File_cleanup_v1_cleanup_proto protoreflect.FileDescriptor
+
+const file_cleanup_v1_cleanup_proto_rawDesc = "" +
+	"\n" +
+	"\x18cleanup/v1/cleanup.proto\x12\n" +
+	"cleanup.v1\"\a\n" +
+	"\x05Empty\"\xf4\x03\n" +
+	"\x06Policy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06enable\x18\x03 \x01(\bR\x06enable\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12,\n" +
+	"\x11intervalInSeconds\x18\x05 \x01(\x03R\x11intervalInSeconds\x12\x1e\n" +
+	"\n" +
+	"containers\x18\x06 \x01(\bR\n" +
+	"containers\x12\x16\n" +
+	"\x06images\x18\a \x01(\bR\x06images\x12\x1c\n" +
+	"\tallImages\x18\b \x01(\bR\tallImages\x12\x18\n" +
+	"\avolumes\x18\t \x01(\bR\avolumes\x12\x1e\n" +
+	"\n" +
+	"allVolumes\x18\n" +
+	" \x01(\bR\n" +
+	"allVolumes\x12\x1a\n" +
+	"\bnetworks\x18\v \x01(\bR\bnetworks\x12\x1e\n" +
+	"\n" +
+	"buildCache\x18\f \x01(\bR\n" +
+	"buildCache\x12&\n" +
+	"\x0ebuildCacheKeep\x18\r \x01(\x03R\x0ebuildCacheKeep\x12.\n" +
+	"\x12olderThanInSeconds\x18\x0e \x01(\x03R\x12olderThanInSeconds\x12\x16\n" +
+	"\x06labels\x18\x0f \x03(\tR\x06labels\x12\x16\n" +
+	"\x06notify\x18\x10 \x01(\bR\x06notify\x12\x18\n" +
+	"\alastRun\x18\x11 \x01(\tR\alastRun\"F\n" +
+	"\x14ListPoliciesResponse\x12.\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x12.cleanup.v1.PolicyR\bpolicies\"%\n" +
+	"\x13DeletePolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\"\n" +
+	"\x10RunPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\xdc\x03\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\bpolicyId\x18\x02 \x01(\x04R\bpolicyId\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12\x16\n" +
+	"\x06manual\x18\x05 \x01(\bR\x06manual\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12,\n" +
+	"\x11containersDeleted\x18\b \x01(\x05R\x11containersDeleted\x12$\n" +
+	"\rimagesDeleted\x18\t \x01(\x05R\rimagesDeleted\x12&\n" +
+	"\x0evolumesDeleted\x18\n" +
+	" \x01(\x05R\x0evolumesDeleted\x12(\n" +
+	"\x0fnetworksDeleted\x18\v \x01(\x05R\x0fnetworksDeleted\x12$\n" +
+	"\rcachesDeleted\x18\f \x01(\x05R\rcachesDeleted\x12&\n" +
+	"\x0espaceReclaimed\x18\r \x01(\x04R\x0espaceReclaimed\x12\x1c\n" +
+	"\tstartedAt\x18\x0e \x01(\tR\tstartedAt\x12\"\n" +
+	"\fdurationInMs\x18\x0f \x01(\x03R\fdurationInMs\"F\n" +
+	"\x12ListReportsRequest\x12\x1a\n" +
+	"\bpolicyId\x18\x01 \x01(\x04R\bpolicyId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"C\n" +
+	"\x13ListReportsResponse\x12,\n" +
+	"\areports\x18\x01 \x03(\v2\x12.cleanup.v1.ReportR\areports2\xed\x02\n" +
+	"\x0eCleanupService\x12H\n" +
+	"\fListPolicies\x12\x11.cleanup.v1.Empty\x1a .cleanup.v1.ListPoliciesResponse\"\x03\x90\x02\x01\x125\n" +
+	"\n" +
+	"SavePolicy\x12\x12.cleanup.v1.Policy\x1a\x11.cleanup.v1.Empty\"\x00\x12D\n" +
+	"\fDeletePolicy\x12\x1f.cleanup.v1.DeletePolicyRequest\x1a\x11.cleanup.v1.Empty\"\x00\x12?\n" +
+	"\tRunPolicy\x12\x1c.cleanup.v1.RunPolicyRequest\x1a\x12.cleanup.v1.Report\"\x00\x12S\n" +
+	"\vListReports\x12\x1e.cleanup.v1.ListReportsRequest\x1a\x1f.cleanup.v1.ListReportsResponse\"\x03\x90\x02\x01B\x96\x01\n" +
+	"\x0ecom.cleanup.v1B\fCleanupProtoP\x01Z-github.com/RA341/dockman/generated/cleanup/v1\xa2\x02\x03CXX\xaa\x02\n" +
+	"Cleanup.V1\xca\x02\n" +
+	"Cleanup\\V1\xe2\x02\x16Cleanup\\V1\\GPBMetadata\xea\x02\vCleanup::V1b\x06proto3"
+
+var (
+	file_cleanup_v1_cleanup_proto_rawDescOnce sync.Once
+	file_cleanup_v1_cleanup_proto_rawDescData []byte
+)
+
+func file_cleanup_v1_cleanup_proto_rawDescGZIP() []byte {
+	file_cleanup_v1_cleanup_proto_rawDescOnce.Do(func() {
+		file_cleanup_v1_cleanup_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cleanup_v1_cleanup_proto_rawDesc), len(file_cleanup_v1_cleanup_proto_rawDesc)))
+	})
+	return file_cleanup_v1_cleanup_proto_rawDescData
+}
+
+var file_cleanup_v1_cleanup_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_cleanup_v1_cleanup_proto_goTypes = []any{
+	(*Empty)(nil),                // 0: cleanup.v1.Empty
+	(*Policy)(nil),               // 1: cleanup.v1.Policy
+	(*ListPoliciesResponse)(nil), // 2: cleanup.v1.ListPoliciesResponse
+	(*DeletePolicyRequest)(nil),  // 3: cleanup.v1.DeletePolicyRequest
+	(*RunPolicyRequest)(nil),     // 4: cleanup.v1.RunPolicyRequest
+	(*Report)(nil),               // 5: cleanup.v1.Report
+	(*ListReportsRequest)(nil),   // 6: cleanup.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),  // 7: cleanup.v1.ListReportsResponse
+}
+var file_cleanup_v1_cleanup_proto_depIdxs = []int32{
+	1, // 0: cleanup.v1.ListPoliciesResponse.policies:type_name -> cleanup.v1.Policy
+	5, // 1: cleanup.v1.ListReportsResponse.reports:type_name -> cleanup.v1.Report
+	0, // 2: cleanup.v1.CleanupService.ListPolicies:input_type -> cleanup.v1.Empty
+	1, // 3: cleanup.v1.CleanupService.SavePolicy:input_type -> cleanup.v1.Policy
+	3, // 4: cleanup.v1.CleanupService.DeletePolicy:input_type -> cleanup.v1.DeletePolicyRequest
+	4, // 5: cleanup.v1.CleanupService.RunPolicy:input_type -> cleanup.v1.RunPolicyRequest
+	6, // 6: cleanup.v1.CleanupService.ListReports:input_type -> cleanup.v1.ListReportsRequest
+	2, // 7: cleanup.v1.CleanupService.ListPolicies:output_type -> cleanup.v1.ListPoliciesResponse
+	0, // 8: cleanup.v1.CleanupService.SavePolicy:output_type -> cleanup.v1.Empty
+	0, // 9: cleanup.v1.CleanupService.DeletePolicy:output_type -> cleanup.v1.Empty
+	5, // 10: cleanup.v1.CleanupService.RunPolicy:output_type -> cleanup.v1.Report
+	7, // 11: cleanup.v1.CleanupService.ListReports:output_type -> cleanup.v1.ListReportsResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cleanup_v1_cleanup_proto_init() }
+func file_cleanup_v1_cleanup_proto_init() {
+	if File_cleanup_v1_cleanup_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cleanup_v1_cleanup_proto_rawDesc), len(file_cleanup_v1_cleanup_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cleanup_v1_cleanup_proto_goTypes,
+		DependencyIndexes: file_cleanup_v1_cleanup_proto_depIdxs,
+		MessageInfos:      file_cleanup_v1_cleanup_proto_msgTypes,
+	}.Build()
+	File_cleanup_v1_cleanup_proto = out.File
+	file_cleanup_v1_cleanup_proto_goTypes = nil
+	file_cleanup_v1_cleanup_proto_depIdxs = nil
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cleanup/v1/cleanup.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/cleanup/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CleanupServiceName is the fully-qualified name of the CleanupService service.
+	CleanupServiceName = "cleanup.v1.CleanupService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CleanupServiceListPoliciesProcedure is the fully-qualified name of the CleanupService's
+	// ListPolicies RPC.
+	CleanupServiceListPoliciesProcedure = "/cleanup.v1.CleanupService/ListPolicies"
+	// CleanupServiceSavePolicyProcedure is the fully-qualified name of the CleanupService's SavePolicy
+	// RPC.
+	CleanupServiceSavePolicyProcedure = "/cleanup.v1.CleanupService/SavePolicy"
+	// CleanupServiceDeletePolicyProcedure is the fully-qualified name of the CleanupService's
+	// DeletePolicy RPC.
+	CleanupServiceDeletePolicyProcedure = "/cleanup.v1.CleanupService/DeletePolicy"
+	// CleanupServiceRunPolicyProcedure is the fully-qualified name of the CleanupService's RunPolicy
+	// RPC.
+	CleanupServiceRunPolicyProcedure = "/cleanup.v1.CleanupService/RunPolicy"
+	// CleanupServiceListReportsProcedure is the fully-qualified name of the CleanupService's
+	// ListReports RPC.
+	CleanupServiceListReportsProcedure = "/cleanup.v1.CleanupService/ListReports"
+)
+
+// CleanupServiceClient is a client for the cleanup.v1.CleanupService service.
+type CleanupServiceClient interface {
+	ListPolicies(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListPoliciesResponse], error)
+	SavePolicy(context.Context, *connect.Request[v1.Policy]) (*connect.Response[v1.Empty], error)
+	// reports of the policy are kept
+	DeletePolicy(context.Context, *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.Empty], error)
+	// runs a policy now without changing its schedule
+	RunPolicy(context.Context, *connect.Request[v1.RunPolicyRequest]) (*connect.Response[v1.Report], error)
+	// reports newest first
+	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+}
+
+// NewCleanupServiceClient constructs a client for the cleanup.v1.CleanupService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCleanupServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CleanupServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	cleanupServiceMethods := v1.File_cleanup_v1_cleanup_proto.Services().ByName("CleanupService").Methods()
+	return &cleanupServiceClient{
+		listPolicies: connect.NewClient[v1.Empty, v1.ListPoliciesResponse](
+			httpClient,
+			baseURL+CleanupServiceListPoliciesProcedure,
+			connect.WithSchema(cleanupServiceMethods.ByName("ListPolicies")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		savePolicy: connect.NewClient[v1.Policy, v1.Empty](
+			httpClient,
+			baseURL+CleanupServiceSavePolicyProcedure,
+			connect.WithSchema(cleanupServiceMethods.ByName("SavePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePolicy: connect.NewClient[v1.DeletePolicyRequest, v1.Empty](
+			httpClient,
+			baseURL+CleanupServiceDeletePolicyProcedure,
+			connect.WithSchema(cleanupServiceMethods.ByName("DeletePolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		runPolicy: connect.NewClient[v1.RunPolicyRequest, v1.Report](
+			httpClient,
+			baseURL+CleanupServiceRunPolicyProcedure,
+			connect.WithSchema(cleanupServiceMethods.ByName("RunPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listReports: connect.NewClient[v1.ListReportsRequest, v1.ListReportsResponse](
+			httpClient,
+			baseURL+CleanupServiceListReportsProcedure,
+			connect.WithSchema(cleanupServiceMethods.ByName("ListReports")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// cleanupServiceClient implements CleanupServiceClient.
+type cleanupServiceClient struct {
+	listPolicies *connect.Client[v1.Empty, v1.ListPoliciesResponse]
+	savePolicy   *connect.Client[v1.Policy, v1.Empty]
+	deletePolicy *connect.Client[v1.DeletePolicyRequest, v1.Empty]
+	runPolicy    *connect.Client[v1.RunPolicyRequest, v1.Report]
+	listReports  *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
+}
+
+// ListPolicies calls cleanup.v1.CleanupService.ListPolicies.
+func (c *cleanupServiceClient) ListPolicies(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListPoliciesResponse], error) {
+	return c.listPolicies.CallUnary(ctx, req)
+}
+
+// SavePolicy calls cleanup.v1.CleanupService.SavePolicy.
+func (c *cleanupServiceClient) SavePolicy(ctx context.Context, req *connect.Request[v1.Policy]) (*connect.Response[v1.Empty], error) {
+	return c.savePolicy.CallUnary(ctx, req)
+}
+
+// DeletePolicy calls cleanup.v1.CleanupService.DeletePolicy.
+func (c *cleanupServiceClient) DeletePolicy(ctx context.Context, req *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.Empty], error) {
+	return c.deletePolicy.CallUnary(ctx, req)
+}
+
+// RunPolicy calls cleanup.v1.CleanupService.RunPolicy.
+func (c *cleanupServiceClient) RunPolicy(ctx context.Context, req *connect.Request[v1.RunPolicyRequest]) (*connect.Response[v1.Report], error) {
+	return c.runPolicy.CallUnary(ctx, req)
+}
+
+// ListReports calls cleanup.v1.CleanupService.ListReports.
+func (c *cleanupServiceClient) ListReports(ctx context.Context, req *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	return c.listReports.CallUnary(ctx, req)
+}
+
+// CleanupServiceHandler is an implementation of the cleanup.v1.CleanupService service.
+type CleanupServiceHandler interface {
+	ListPolicies(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListPoliciesResponse], error)
+	SavePolicy(context.Context, *connect.Request[v1.Policy]) (*connect.Response[v1.Empty], error)
+	// reports of the policy are kept
+	DeletePolicy(context.Context, *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.Empty], error)
+	// runs a policy now without changing its schedule
+	RunPolicy(context.Context, *connect.Request[v1.RunPolicyRequest]) (*connect.Response[v1.Report], error)
+	// reports newest first
+	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+}
+
+// NewCleanupServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCleanupServiceHandler(svc CleanupServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	cleanupServiceMethods := v1.File_cleanup_v1_cleanup_proto.Services().ByName("CleanupService").Methods()
+	cleanupServiceListPoliciesHandler := connect.NewUnaryHandler(
+		CleanupServiceListPoliciesProcedure,
+		svc.ListPolicies,
+		connect.WithSchema(cleanupServiceMethods.ByName("ListPolicies")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	cleanupServiceSavePolicyHandler := connect.NewUnaryHandler(
+		CleanupServiceSavePolicyProcedure,
+		svc.SavePolicy,
+		connect.WithSchema(cleanupServiceMethods.ByName("SavePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cleanupServiceDeletePolicyHandler := connect.NewUnaryHandler(
+		CleanupServiceDeletePolicyProcedure,
+		svc.DeletePolicy,
+		connect.WithSchema(cleanupServiceMethods.ByName("DeletePolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cleanupServiceRunPolicyHandler := connect.NewUnaryHandler(
+		CleanupServiceRunPolicyProcedure,
+		svc.RunPolicy,
+		connect.WithSchema(cleanupServiceMethods.ByName("RunPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cleanupServiceListReportsHandler := connect.NewUnaryHandler(
+		CleanupServiceListReportsProcedure,
+		svc.ListReports,
+		connect.WithSchema(cleanupServiceMethods.ByName("ListReports")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cleanup.v1.CleanupService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CleanupServiceListPoliciesProcedure:
+			cleanupServiceListPoliciesHandler.ServeHTTP(w, r)
+		case CleanupServiceSavePolicyProcedure:
+			cleanupServiceSavePolicyHandler.ServeHTTP(w, r)
+		case CleanupServiceDeletePolicyProcedure:
+			cleanupServiceDeletePolicyHandler.ServeHTTP(w, r)
+		case CleanupServiceRunPolicyProcedure:
+			cleanupServiceRunPolicyHandler.ServeHTTP(w, r)
+		case CleanupServiceListReportsProcedure:
+			cleanupServiceListReportsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCleanupServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCleanupServiceHandler struct{}
+
+func (UnimplementedCleanupServiceHandler) ListPolicies(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cleanup.v1.CleanupService.ListPolicies is not implemented"))
+}
+
+func (UnimplementedCleanupServiceHandler) SavePolicy(context.Context, *connect.Request[v1.Policy]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cleanup.v1.CleanupService.SavePolicy is not implemented"))
+}
+
+func (UnimplementedCleanupServiceHandler) DeletePolicy(context.Context, *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cleanup.v1.CleanupService.DeletePolicy is not implemented"))
+}
+
+func (UnimplementedCleanupServiceHandler) RunPolicy(context.Context, *connect.Request[v1.RunPolicyRequest]) (*connect.Response[v1.Report], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cleanup.v1.CleanupService.RunPolicy is not implemented"))
+}
+
+func (UnimplementedCleanupServiceHandler) ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cleanup.v1.CleanupService.ListReports is not implemented"))
+}
@@ -231,9 +231,9 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"NotifyOnly\x18\x02 \x01(\bR\n" +
 	"NotifyOnly\x12,\n" +
 	"\x11IntervalInSeconds\x18\x03 \x01(\x03R\x11IntervalInSeconds\"\a\n" +
-	"\x05Empty2\x8b\x01\n" +
-	"\rConfigService\x12:\n" +
-	"\rGetUserConfig\x12\x10.config.v1.Empty\x1a\x15.config.v1.UserConfig\"\x00\x12>\n" +
+	"\x05Empty2\x8e\x01\n" +
+	"\rConfigService\x12=\n" +
+	"\rGetUserConfig\x12\x10.config.v1.Empty\x1a\x15.config.v1.UserConfig\"\x03\x90\x02\x01\x12>\n" +
 	"\rSetUserConfig\x12\x19.config.v1.SetUserRequest\x1a\x10.config.v1.Empty\"\x00B\x8f\x01\n" +
 	"\rcom.config.v1B\vConfigProtoP\x01Z,github.com/RA341/dockman/generated/config/v1\xa2\x02\x03CXX\xaa\x02\tConfig.V1\xca\x02\tConfig\\V1\xe2\x02\x15Config\\V1\\GPBMetadata\xea\x02\n" +
 	"Config::V1b\x06proto3"
@@ -62,6 +62,7 @@ func NewConfigServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+ConfigServiceGetUserConfigProcedure,
 			connect.WithSchema(configServiceMethods.ByName("GetUserConfig")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		setUserConfig: connect.NewClient[v1.SetUserRequest, v1.Empty](
@@ -106,6 +107,7 @@ func NewConfigServiceHandler(svc ConfigServiceHandler, opts ...connect.HandlerOp
 		ConfigServiceGetUserConfigProcedure,
 		svc.GetUserConfig,
 		connect.WithSchema(configServiceMethods.ByName("GetUserConfig")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	configServiceSetUserConfigHandler := connect.NewUnaryHandler(
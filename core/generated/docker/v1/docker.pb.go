@@ -128,27 +128,30 @@ func (ORDER) EnumDescriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{1}
 }
 
-type ComposeValidateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Errs          []string               `protobuf:"bytes,1,rep,name=errs,proto3" json:"errs,omitempty"`
+type EventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// container|image|network|volume, empty for all
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	// only send events for this compose project, empty for all
+	StackName     string `protobuf:"bytes,2,opt,name=stackName,proto3" json:"stackName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComposeValidateResponse) Reset() {
-	*x = ComposeValidateResponse{}
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComposeValidateResponse) String() string {
+func (x *EventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComposeValidateResponse) ProtoMessage() {}
+func (*EventsRequest) ProtoMessage() {}
 
-func (x *ComposeValidateResponse) ProtoReflect() protoreflect.Message {
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -160,41 +163,57 @@ func (x *ComposeValidateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComposeValidateResponse.ProtoReflect.Descriptor instead.
-func (*ComposeValidateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ComposeValidateResponse) GetErrs() []string {
+func (x *EventsRequest) GetTypes() []string {
 	if x != nil {
-		return x.Errs
+		return x.Types
 	}
 	return nil
 }
 
-// forwards commands from user to a running session
-type ContainerExecCmdInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserCmd       string                 `protobuf:"bytes,1,opt,name=userCmd,proto3" json:"userCmd,omitempty"`
-	ContainerID   string                 `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+func (x *EventsRequest) GetStackName() string {
+	if x != nil {
+		return x.StackName
+	}
+	return ""
+}
+
+type DockerEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// container|image|network|volume
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// create|start|die|destroy|pull|connect etc
+	Action  string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ActorID string `protobuf:"bytes,3,opt,name=actorID,proto3" json:"actorID,omitempty"`
+	// name of the container/image/network/volume
+	Name          string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Attributes    map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StackName     string            `protobuf:"bytes,6,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	ServiceName   string            `protobuf:"bytes,7,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	Time          string            `protobuf:"bytes,8,opt,name=time,proto3" json:"time,omitempty"`
+	Host          string            `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerExecCmdInput) Reset() {
-	*x = ContainerExecCmdInput{}
+func (x *DockerEvent) Reset() {
+	*x = DockerEvent{}
 	mi := &file_docker_v1_docker_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerExecCmdInput) String() string {
+func (x *DockerEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerExecCmdInput) ProtoMessage() {}
+func (*DockerEvent) ProtoMessage() {}
 
-func (x *ContainerExecCmdInput) ProtoReflect() protoreflect.Message {
+func (x *DockerEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -206,48 +225,95 @@ func (x *ContainerExecCmdInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerExecCmdInput.ProtoReflect.Descriptor instead.
-func (*ContainerExecCmdInput) Descriptor() ([]byte, []int) {
+// Deprecated: Use DockerEvent.ProtoReflect.Descriptor instead.
+func (*DockerEvent) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ContainerExecCmdInput) GetUserCmd() string {
+func (x *DockerEvent) GetType() string {
 	if x != nil {
-		return x.UserCmd
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ContainerExecCmdInput) GetContainerID() string {
+func (x *DockerEvent) GetAction() string {
 	if x != nil {
-		return x.ContainerID
+		return x.Action
 	}
 	return ""
 }
 
-type ContainerExecRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ContainerID string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	// if not provided uses '/bin/sh' as default
-	ExecCmd       []string `protobuf:"bytes,2,rep,name=execCmd,proto3" json:"execCmd,omitempty"`
+func (x *DockerEvent) GetActorID() string {
+	if x != nil {
+		return x.ActorID
+	}
+	return ""
+}
+
+func (x *DockerEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DockerEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *DockerEvent) GetStackName() string {
+	if x != nil {
+		return x.StackName
+	}
+	return ""
+}
+
+func (x *DockerEvent) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *DockerEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *DockerEvent) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ComposeOverviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stacks        []*StackStatus         `protobuf:"bytes,1,rep,name=stacks,proto3" json:"stacks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerExecRequest) Reset() {
-	*x = ContainerExecRequest{}
+func (x *ComposeOverviewResponse) Reset() {
+	*x = ComposeOverviewResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerExecRequest) String() string {
+func (x *ComposeOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerExecRequest) ProtoMessage() {}
+func (*ComposeOverviewResponse) ProtoMessage() {}
 
-func (x *ContainerExecRequest) ProtoReflect() protoreflect.Message {
+func (x *ComposeOverviewResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -259,57 +325,49 @@ func (x *ContainerExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerExecRequest.ProtoReflect.Descriptor instead.
-func (*ContainerExecRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComposeOverviewResponse.ProtoReflect.Descriptor instead.
+func (*ComposeOverviewResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ContainerExecRequest) GetContainerID() string {
-	if x != nil {
-		return x.ContainerID
-	}
-	return ""
-}
-
-func (x *ContainerExecRequest) GetExecCmd() []string {
+func (x *ComposeOverviewResponse) GetStacks() []*StackStatus {
 	if x != nil {
-		return x.ExecCmd
+		return x.Stacks
 	}
 	return nil
 }
 
-// Image-related messages
-type Image struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Containers    int64                  `protobuf:"varint,1,opt,name=containers,proto3" json:"containers,omitempty"`
-	Created       int64                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
-	Manifests     []*ManifestSummary     `protobuf:"bytes,7,rep,name=manifests,proto3" json:"manifests,omitempty"`
-	RepoDigests   []string               `protobuf:"bytes,8,rep,name=repo_digests,json=repoDigests,proto3" json:"repo_digests,omitempty"`
-	RepoTags      []string               `protobuf:"bytes,9,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
-	SharedSize    int64                  `protobuf:"varint,10,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
-	Size          int64                  `protobuf:"varint,11,opt,name=size,proto3" json:"size,omitempty"`
-	UpdateRef     string                 `protobuf:"bytes,12,opt,name=updateRef,proto3" json:"updateRef,omitempty"`
+type StackStatus struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Filename  string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	StackName string                 `protobuf:"bytes,2,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	// running|partial|stopped|not_deployed|invalid
+	State            string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ExpectedServices int32  `protobuf:"varint,4,opt,name=expectedServices,proto3" json:"expectedServices,omitempty"`
+	RunningServices  int32  `protobuf:"varint,5,opt,name=runningServices,proto3" json:"runningServices,omitempty"`
+	// services whose container runs a different image than the one currently tagged
+	DriftedServices   []string `protobuf:"bytes,6,rep,name=driftedServices,proto3" json:"driftedServices,omitempty"`
+	UnhealthyServices []string `protobuf:"bytes,7,rep,name=unhealthyServices,proto3" json:"unhealthyServices,omitempty"`
+	// set when the file could not be loaded
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Image) Reset() {
-	*x = Image{}
+func (x *StackStatus) Reset() {
+	*x = StackStatus{}
 	mi := &file_docker_v1_docker_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Image) String() string {
+func (x *StackStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Image) ProtoMessage() {}
+func (*StackStatus) ProtoMessage() {}
 
-func (x *Image) ProtoReflect() protoreflect.Message {
+func (x *StackStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -321,111 +379,90 @@ func (x *Image) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Image.ProtoReflect.Descriptor instead.
-func (*Image) Descriptor() ([]byte, []int) {
+// Deprecated: Use StackStatus.ProtoReflect.Descriptor instead.
+func (*StackStatus) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Image) GetContainers() int64 {
-	if x != nil {
-		return x.Containers
-	}
-	return 0
-}
-
-func (x *Image) GetCreated() int64 {
-	if x != nil {
-		return x.Created
-	}
-	return 0
-}
-
-func (x *Image) GetId() string {
+func (x *StackStatus) GetFilename() string {
 	if x != nil {
-		return x.Id
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *Image) GetLabels() map[string]string {
+func (x *StackStatus) GetStackName() string {
 	if x != nil {
-		return x.Labels
+		return x.StackName
 	}
-	return nil
+	return ""
 }
 
-func (x *Image) GetParentId() string {
+func (x *StackStatus) GetState() string {
 	if x != nil {
-		return x.ParentId
+		return x.State
 	}
 	return ""
 }
 
-func (x *Image) GetManifests() []*ManifestSummary {
+func (x *StackStatus) GetExpectedServices() int32 {
 	if x != nil {
-		return x.Manifests
+		return x.ExpectedServices
 	}
-	return nil
+	return 0
 }
 
-func (x *Image) GetRepoDigests() []string {
+func (x *StackStatus) GetRunningServices() int32 {
 	if x != nil {
-		return x.RepoDigests
+		return x.RunningServices
 	}
-	return nil
+	return 0
 }
 
-func (x *Image) GetRepoTags() []string {
+func (x *StackStatus) GetDriftedServices() []string {
 	if x != nil {
-		return x.RepoTags
+		return x.DriftedServices
 	}
 	return nil
 }
 
-func (x *Image) GetSharedSize() int64 {
-	if x != nil {
-		return x.SharedSize
-	}
-	return 0
-}
-
-func (x *Image) GetSize() int64 {
+func (x *StackStatus) GetUnhealthyServices() []string {
 	if x != nil {
-		return x.Size
+		return x.UnhealthyServices
 	}
-	return 0
+	return nil
 }
 
-func (x *Image) GetUpdateRef() string {
+func (x *StackStatus) GetError() string {
 	if x != nil {
-		return x.UpdateRef
+		return x.Error
 	}
 	return ""
 }
 
-type ManifestSummary struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Digest        string                 `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
-	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+type ComposeDriftResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// true if any service is not in sync
+	Drifted       bool            `protobuf:"varint,1,opt,name=drifted,proto3" json:"drifted,omitempty"`
+	Services      []*ServiceDrift `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ManifestSummary) Reset() {
-	*x = ManifestSummary{}
+func (x *ComposeDriftResponse) Reset() {
+	*x = ComposeDriftResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ManifestSummary) String() string {
+func (x *ComposeDriftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ManifestSummary) ProtoMessage() {}
+func (*ComposeDriftResponse) ProtoMessage() {}
 
-func (x *ManifestSummary) ProtoReflect() protoreflect.Message {
+func (x *ComposeDriftResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -437,52 +474,52 @@ func (x *ManifestSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ManifestSummary.ProtoReflect.Descriptor instead.
-func (*ManifestSummary) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComposeDriftResponse.ProtoReflect.Descriptor instead.
+func (*ComposeDriftResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ManifestSummary) GetDigest() string {
-	if x != nil {
-		return x.Digest
-	}
-	return ""
-}
-
-func (x *ManifestSummary) GetMediaType() string {
+func (x *ComposeDriftResponse) GetDrifted() bool {
 	if x != nil {
-		return x.MediaType
+		return x.Drifted
 	}
-	return ""
+	return false
 }
 
-func (x *ManifestSummary) GetSize() int64 {
+func (x *ComposeDriftResponse) GetServices() []*ServiceDrift {
 	if x != nil {
-		return x.Size
+		return x.Services
 	}
-	return 0
+	return nil
 }
 
-type ListImagesRequest struct {
+type ServiceDrift struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	ContainerName string                 `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	// in_sync|drifted|missing|orphan
+	State         string       `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ExpectedHash  string       `protobuf:"bytes,4,opt,name=expectedHash,proto3" json:"expectedHash,omitempty"`
+	ActualHash    string       `protobuf:"bytes,5,opt,name=actualHash,proto3" json:"actualHash,omitempty"`
+	Diffs         []*FieldDiff `protobuf:"bytes,6,rep,name=diffs,proto3" json:"diffs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListImagesRequest) Reset() {
-	*x = ListImagesRequest{}
+func (x *ServiceDrift) Reset() {
+	*x = ServiceDrift{}
 	mi := &file_docker_v1_docker_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListImagesRequest) String() string {
+func (x *ServiceDrift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListImagesRequest) ProtoMessage() {}
+func (*ServiceDrift) ProtoMessage() {}
 
-func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
+func (x *ServiceDrift) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,35 +531,76 @@ func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
-func (*ListImagesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceDrift.ProtoReflect.Descriptor instead.
+func (*ServiceDrift) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{5}
 }
 
-type ListImagesResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	TotalDiskUsage     int64                  `protobuf:"varint,1,opt,name=totalDiskUsage,proto3" json:"totalDiskUsage,omitempty"`
-	UnusedImageCount   int64                  `protobuf:"varint,2,opt,name=unusedImageCount,proto3" json:"unusedImageCount,omitempty"`
-	UntaggedImageCount int64                  `protobuf:"varint,3,opt,name=untaggedImageCount,proto3" json:"untaggedImageCount,omitempty"`
-	Images             []*Image               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ServiceDrift) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
 }
 
-func (x *ListImagesResponse) Reset() {
-	*x = ListImagesResponse{}
+func (x *ServiceDrift) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ServiceDrift) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ServiceDrift) GetExpectedHash() string {
+	if x != nil {
+		return x.ExpectedHash
+	}
+	return ""
+}
+
+func (x *ServiceDrift) GetActualHash() string {
+	if x != nil {
+		return x.ActualHash
+	}
+	return ""
+}
+
+func (x *ServiceDrift) GetDiffs() []*FieldDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type FieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Expected      string                 `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Actual        string                 `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
 	mi := &file_docker_v1_docker_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListImagesResponse) String() string {
+func (x *FieldDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListImagesResponse) ProtoMessage() {}
+func (*FieldDiff) ProtoMessage() {}
 
-func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,60 +612,58 @@ func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
-func (*ListImagesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListImagesResponse) GetTotalDiskUsage() int64 {
+func (x *FieldDiff) GetField() string {
 	if x != nil {
-		return x.TotalDiskUsage
+		return x.Field
 	}
-	return 0
+	return ""
 }
 
-func (x *ListImagesResponse) GetUnusedImageCount() int64 {
+func (x *FieldDiff) GetExpected() string {
 	if x != nil {
-		return x.UnusedImageCount
+		return x.Expected
 	}
-	return 0
-}
-
-func (x *ListImagesResponse) GetUntaggedImageCount() int64 {
-	if x != nil {
-		return x.UntaggedImageCount
-	}
-	return 0
+	return ""
 }
 
-func (x *ListImagesResponse) GetImages() []*Image {
+func (x *FieldDiff) GetActual() string {
 	if x != nil {
-		return x.Images
+		return x.Actual
 	}
-	return nil
+	return ""
 }
 
-type RemoveImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ImageIds      []string               `protobuf:"bytes,1,rep,name=imageIds,proto3" json:"imageIds,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ComposePlanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// false if applying the plan would not change anything
+	HasChanges     bool                `protobuf:"varint,1,opt,name=hasChanges,proto3" json:"hasChanges,omitempty"`
+	Containers     []*PlannedContainer `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	PullImages     []string            `protobuf:"bytes,3,rep,name=pullImages,proto3" json:"pullImages,omitempty"`
+	CreateNetworks []string            `protobuf:"bytes,4,rep,name=createNetworks,proto3" json:"createNetworks,omitempty"`
+	CreateVolumes  []string            `protobuf:"bytes,5,rep,name=createVolumes,proto3" json:"createVolumes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RemoveImageRequest) Reset() {
-	*x = RemoveImageRequest{}
+func (x *ComposePlanResponse) Reset() {
+	*x = ComposePlanResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveImageRequest) String() string {
+func (x *ComposePlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveImageRequest) ProtoMessage() {}
+func (*ComposePlanResponse) ProtoMessage() {}
 
-func (x *RemoveImageRequest) ProtoReflect() protoreflect.Message {
+func (x *ComposePlanResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,38 +675,72 @@ func (x *RemoveImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveImageRequest.ProtoReflect.Descriptor instead.
-func (*RemoveImageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComposePlanResponse.ProtoReflect.Descriptor instead.
+func (*ComposePlanResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RemoveImageRequest) GetImageIds() []string {
+func (x *ComposePlanResponse) GetHasChanges() bool {
 	if x != nil {
-		return x.ImageIds
+		return x.HasChanges
+	}
+	return false
+}
+
+func (x *ComposePlanResponse) GetContainers() []*PlannedContainer {
+	if x != nil {
+		return x.Containers
 	}
 	return nil
 }
 
-type RemoveImageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ComposePlanResponse) GetPullImages() []string {
+	if x != nil {
+		return x.PullImages
+	}
+	return nil
+}
+
+func (x *ComposePlanResponse) GetCreateNetworks() []string {
+	if x != nil {
+		return x.CreateNetworks
+	}
+	return nil
+}
+
+func (x *ComposePlanResponse) GetCreateVolumes() []string {
+	if x != nil {
+		return x.CreateVolumes
+	}
+	return nil
+}
+
+type PlannedContainer struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Service string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	// empty for containers that will be created
+	ContainerName string `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	// create|recreate|remove|start|unchanged
+	Action        string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveImageResponse) Reset() {
-	*x = RemoveImageResponse{}
+func (x *PlannedContainer) Reset() {
+	*x = PlannedContainer{}
 	mi := &file_docker_v1_docker_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveImageResponse) String() string {
+func (x *PlannedContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveImageResponse) ProtoMessage() {}
+func (*PlannedContainer) ProtoMessage() {}
 
-func (x *RemoveImageResponse) ProtoReflect() protoreflect.Message {
+func (x *PlannedContainer) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -642,33 +752,61 @@ func (x *RemoveImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveImageResponse.ProtoReflect.Descriptor instead.
-func (*RemoveImageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlannedContainer.ProtoReflect.Descriptor instead.
+func (*PlannedContainer) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{8}
 }
 
-type ImagePruneResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SpaceReclaimed uint64                 `protobuf:"varint,1,opt,name=SpaceReclaimed,proto3" json:"SpaceReclaimed,omitempty"`
-	Deleted        []*ImagesDeleted       `protobuf:"bytes,2,rep,name=deleted,proto3" json:"deleted,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PlannedContainer) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
 }
 
-func (x *ImagePruneResponse) Reset() {
-	*x = ImagePruneResponse{}
+func (x *PlannedContainer) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *PlannedContainer) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PlannedContainer) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ComposeConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Yaml          string                 `protobuf:"bytes,1,opt,name=yaml,proto3" json:"yaml,omitempty"`
+	Variables     []*ConfigVariable      `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComposeConfigResponse) Reset() {
+	*x = ComposeConfigResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImagePruneResponse) String() string {
+func (x *ComposeConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImagePruneResponse) ProtoMessage() {}
+func (*ComposeConfigResponse) ProtoMessage() {}
 
-func (x *ImagePruneResponse) ProtoReflect() protoreflect.Message {
+func (x *ComposeConfigResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -680,46 +818,51 @@ func (x *ImagePruneResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImagePruneResponse.ProtoReflect.Descriptor instead.
-func (*ImagePruneResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComposeConfigResponse.ProtoReflect.Descriptor instead.
+func (*ComposeConfigResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ImagePruneResponse) GetSpaceReclaimed() uint64 {
+func (x *ComposeConfigResponse) GetYaml() string {
 	if x != nil {
-		return x.SpaceReclaimed
+		return x.Yaml
 	}
-	return 0
+	return ""
 }
 
-func (x *ImagePruneResponse) GetDeleted() []*ImagesDeleted {
+func (x *ComposeConfigResponse) GetVariables() []*ConfigVariable {
 	if x != nil {
-		return x.Deleted
+		return x.Variables
 	}
 	return nil
 }
 
-type ImagePruneRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PruneAll      bool                   `protobuf:"varint,1,opt,name=pruneAll,proto3" json:"pruneAll,omitempty"`
+type ConfigVariable struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// root_env|folder_env|os_env|default|unset
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Default       string `protobuf:"bytes,4,opt,name=default,proto3" json:"default,omitempty"`
+	Required      bool   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImagePruneRequest) Reset() {
-	*x = ImagePruneRequest{}
+func (x *ConfigVariable) Reset() {
+	*x = ConfigVariable{}
 	mi := &file_docker_v1_docker_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImagePruneRequest) String() string {
+func (x *ConfigVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImagePruneRequest) ProtoMessage() {}
+func (*ConfigVariable) ProtoMessage() {}
 
-func (x *ImagePruneRequest) ProtoReflect() protoreflect.Message {
+func (x *ConfigVariable) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -731,40 +874,72 @@ func (x *ImagePruneRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImagePruneRequest.ProtoReflect.Descriptor instead.
-func (*ImagePruneRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfigVariable.ProtoReflect.Descriptor instead.
+func (*ConfigVariable) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ImagePruneRequest) GetPruneAll() bool {
+func (x *ConfigVariable) GetName() string {
 	if x != nil {
-		return x.PruneAll
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigVariable) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ConfigVariable) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *ConfigVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
 	}
 	return false
 }
 
-type ImagesDeleted struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deleted       string                 `protobuf:"bytes,1,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
-	Untagged      string                 `protobuf:"bytes,2,opt,name=Untagged,proto3" json:"Untagged,omitempty"`
+type StackGraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Nodes []*GraphNode           `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*GraphEdge           `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// compose files in start order, stop order is the reverse
+	StartOrder []string `protobuf:"bytes,3,rep,name=startOrder,proto3" json:"startOrder,omitempty"`
+	// set if the stacks depend on each other in a cycle
+	OrderError    string `protobuf:"bytes,4,opt,name=orderError,proto3" json:"orderError,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImagesDeleted) Reset() {
-	*x = ImagesDeleted{}
+func (x *StackGraphResponse) Reset() {
+	*x = StackGraphResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImagesDeleted) String() string {
+func (x *StackGraphResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImagesDeleted) ProtoMessage() {}
+func (*StackGraphResponse) ProtoMessage() {}
 
-func (x *ImagesDeleted) ProtoReflect() protoreflect.Message {
+func (x *StackGraphResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -776,54 +951,66 @@ func (x *ImagesDeleted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImagesDeleted.ProtoReflect.Descriptor instead.
-func (*ImagesDeleted) Descriptor() ([]byte, []int) {
+// Deprecated: Use StackGraphResponse.ProtoReflect.Descriptor instead.
+func (*StackGraphResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ImagesDeleted) GetDeleted() string {
+func (x *StackGraphResponse) GetNodes() []*GraphNode {
 	if x != nil {
-		return x.Deleted
+		return x.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (x *ImagesDeleted) GetUntagged() string {
+func (x *StackGraphResponse) GetEdges() []*GraphEdge {
 	if x != nil {
-		return x.Untagged
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *StackGraphResponse) GetStartOrder() []string {
+	if x != nil {
+		return x.StartOrder
+	}
+	return nil
+}
+
+func (x *StackGraphResponse) GetOrderError() string {
+	if x != nil {
+		return x.OrderError
 	}
 	return ""
 }
 
-// Volume-related messages
-type Volume struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ContainerID        string                 `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
-	CreatedAt          string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	MountPoint         string                 `protobuf:"bytes,4,opt,name=mountPoint,proto3" json:"mountPoint,omitempty"`
-	Size               int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	Labels             string                 `protobuf:"bytes,6,opt,name=labels,proto3" json:"labels,omitempty"`
-	ComposePath        string                 `protobuf:"bytes,7,opt,name=composePath,proto3" json:"composePath,omitempty"`
-	ComposeProjectName string                 `protobuf:"bytes,8,opt,name=composeProjectName,proto3" json:"composeProjectName,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GraphNode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// compose file for stacks, file:service for services
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// stack|service|external
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// set if the stack could not be loaded
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Volume) Reset() {
-	*x = Volume{}
+func (x *GraphNode) Reset() {
+	*x = GraphNode{}
 	mi := &file_docker_v1_docker_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Volume) String() string {
+func (x *GraphNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Volume) ProtoMessage() {}
+func (*GraphNode) ProtoMessage() {}
 
-func (x *Volume) ProtoReflect() protoreflect.Message {
+func (x *GraphNode) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,88 +1022,132 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
-func (*Volume) Descriptor() ([]byte, []int) {
+// Deprecated: Use GraphNode.ProtoReflect.Descriptor instead.
+func (*GraphNode) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Volume) GetName() string {
+func (x *GraphNode) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Volume) GetContainerID() string {
+func (x *GraphNode) GetKind() string {
 	if x != nil {
-		return x.ContainerID
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Volume) GetCreatedAt() string {
+func (x *GraphNode) GetLabel() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Volume) GetMountPoint() string {
+func (x *GraphNode) GetError() string {
 	if x != nil {
-		return x.MountPoint
+		return x.Error
 	}
 	return ""
 }
 
-func (x *Volume) GetSize() int64 {
+type GraphEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// depends_on|contains|network|volume|shared_path
+	Kind          string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Label         string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphEdge) Reset() {
+	*x = GraphEdge{}
+	mi := &file_docker_v1_docker_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphEdge) ProtoMessage() {}
+
+func (x *GraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[13]
 	if x != nil {
-		return x.Size
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Volume) GetLabels() string {
+// Deprecated: Use GraphEdge.ProtoReflect.Descriptor instead.
+func (*GraphEdge) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GraphEdge) GetFrom() string {
 	if x != nil {
-		return x.Labels
+		return x.From
 	}
 	return ""
 }
 
-func (x *Volume) GetComposePath() string {
+func (x *GraphEdge) GetTo() string {
 	if x != nil {
-		return x.ComposePath
+		return x.To
 	}
 	return ""
 }
 
-func (x *Volume) GetComposeProjectName() string {
+func (x *GraphEdge) GetKind() string {
 	if x != nil {
-		return x.ComposeProjectName
+		return x.Kind
 	}
 	return ""
 }
 
-type ListVolumesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GraphEdge) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type ComposeValidateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// messages of all findings with error severity
+	Errs          []string             `protobuf:"bytes,1,rep,name=errs,proto3" json:"errs,omitempty"`
+	Findings      []*ValidationFinding `protobuf:"bytes,2,rep,name=findings,proto3" json:"findings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVolumesRequest) Reset() {
-	*x = ListVolumesRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[13]
+func (x *ComposeValidateResponse) Reset() {
+	*x = ComposeValidateResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVolumesRequest) String() string {
+func (x *ComposeValidateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVolumesRequest) ProtoMessage() {}
+func (*ComposeValidateResponse) ProtoMessage() {}
 
-func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[13]
+func (x *ComposeValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -927,75 +1158,53 @@ func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
-func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{13}
-}
-
-type ListVolumesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListVolumesResponse) Reset() {
-	*x = ListVolumesResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListVolumesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ComposeValidateResponse.ProtoReflect.Descriptor instead.
+func (*ComposeValidateResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{14}
 }
 
-func (*ListVolumesResponse) ProtoMessage() {}
-
-func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[14]
+func (x *ComposeValidateResponse) GetErrs() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Errs
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
-func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{14}
+	return nil
 }
 
-func (x *ListVolumesResponse) GetVolumes() []*Volume {
+func (x *ComposeValidateResponse) GetFindings() []*ValidationFinding {
 	if x != nil {
-		return x.Volumes
+		return x.Findings
 	}
 	return nil
 }
 
-type CreateVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ValidationFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// error|warning
+	Severity string `protobuf:"bytes,1,opt,name=severity,proto3" json:"severity,omitempty"`
+	// load|ports|bind_mounts|networks|volumes|container_name|env_files|images
+	Check string `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// empty if the finding concerns the whole project,
+	// comma separated if it concerns multiple services
+	Service       string `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeRequest) Reset() {
-	*x = CreateVolumeRequest{}
+func (x *ValidationFinding) Reset() {
+	*x = ValidationFinding{}
 	mi := &file_docker_v1_docker_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeRequest) String() string {
+func (x *ValidationFinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeRequest) ProtoMessage() {}
+func (*ValidationFinding) ProtoMessage() {}
 
-func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidationFinding) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,31 +1216,62 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidationFinding.ProtoReflect.Descriptor instead.
+func (*ValidationFinding) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{15}
 }
 
-type CreateVolumeResponse struct {
+func (x *ValidationFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ValidationFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// forwards commands from user to a running session
+type ContainerExecCmdInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCmd       string                 `protobuf:"bytes,1,opt,name=userCmd,proto3" json:"userCmd,omitempty"`
+	ContainerID   string                 `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeResponse) Reset() {
-	*x = CreateVolumeResponse{}
+func (x *ContainerExecCmdInput) Reset() {
+	*x = ContainerExecCmdInput{}
 	mi := &file_docker_v1_docker_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeResponse) String() string {
+func (x *ContainerExecCmdInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeResponse) ProtoMessage() {}
+func (*ContainerExecCmdInput) ProtoMessage() {}
 
-func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *ContainerExecCmdInput) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,34 +1283,48 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerExecCmdInput.ProtoReflect.Descriptor instead.
+func (*ContainerExecCmdInput) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{16}
 }
 
-type DeleteVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VolumeIds     []string               `protobuf:"bytes,1,rep,name=volumeIds,proto3" json:"volumeIds,omitempty"`
-	Anon          bool                   `protobuf:"varint,2,opt,name=anon,proto3" json:"anon,omitempty"`
-	Unused        bool                   `protobuf:"varint,3,opt,name=unused,proto3" json:"unused,omitempty"`
+func (x *ContainerExecCmdInput) GetUserCmd() string {
+	if x != nil {
+		return x.UserCmd
+	}
+	return ""
+}
+
+func (x *ContainerExecCmdInput) GetContainerID() string {
+	if x != nil {
+		return x.ContainerID
+	}
+	return ""
+}
+
+type ContainerExecRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContainerID string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	// if not provided uses '/bin/sh' as default
+	ExecCmd       []string `protobuf:"bytes,2,rep,name=execCmd,proto3" json:"execCmd,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVolumeRequest) Reset() {
-	*x = DeleteVolumeRequest{}
+func (x *ContainerExecRequest) Reset() {
+	*x = ContainerExecRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVolumeRequest) String() string {
+func (x *ContainerExecRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeRequest) ProtoMessage() {}
+func (*ContainerExecRequest) ProtoMessage() {}
 
-func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *ContainerExecRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,52 +1336,57 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerExecRequest.ProtoReflect.Descriptor instead.
+func (*ContainerExecRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteVolumeRequest) GetVolumeIds() []string {
-	if x != nil {
-		return x.VolumeIds
-	}
-	return nil
-}
-
-func (x *DeleteVolumeRequest) GetAnon() bool {
+func (x *ContainerExecRequest) GetContainerID() string {
 	if x != nil {
-		return x.Anon
+		return x.ContainerID
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteVolumeRequest) GetUnused() bool {
+func (x *ContainerExecRequest) GetExecCmd() []string {
 	if x != nil {
-		return x.Unused
+		return x.ExecCmd
 	}
-	return false
+	return nil
 }
 
-type DeleteVolumeResponse struct {
+// Image-related messages
+type Image struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Containers    int64                  `protobuf:"varint,1,opt,name=containers,proto3" json:"containers,omitempty"`
+	Created       int64                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ParentId      string                 `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Manifests     []*ManifestSummary     `protobuf:"bytes,7,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	RepoDigests   []string               `protobuf:"bytes,8,rep,name=repo_digests,json=repoDigests,proto3" json:"repo_digests,omitempty"`
+	RepoTags      []string               `protobuf:"bytes,9,rep,name=repo_tags,json=repoTags,proto3" json:"repo_tags,omitempty"`
+	SharedSize    int64                  `protobuf:"varint,10,opt,name=shared_size,json=sharedSize,proto3" json:"shared_size,omitempty"`
+	Size          int64                  `protobuf:"varint,11,opt,name=size,proto3" json:"size,omitempty"`
+	UpdateRef     string                 `protobuf:"bytes,12,opt,name=updateRef,proto3" json:"updateRef,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteVolumeResponse) Reset() {
-	*x = DeleteVolumeResponse{}
+func (x *Image) Reset() {
+	*x = Image{}
 	mi := &file_docker_v1_docker_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteVolumeResponse) String() string {
+func (x *Image) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteVolumeResponse) ProtoMessage() {}
+func (*Image) ProtoMessage() {}
 
-func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *Image) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1139,164 +1398,168 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{18}
 }
 
-// Network-related messages
-type Network struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Subnet         string                 `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
-	Scope          string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
-	Driver         string                 `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
-	EnableIpv4     bool                   `protobuf:"varint,6,opt,name=enable_ipv4,json=enableIpv4,proto3" json:"enable_ipv4,omitempty"`
-	EnableIpv6     bool                   `protobuf:"varint,7,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
-	Internal       bool                   `protobuf:"varint,9,opt,name=internal,proto3" json:"internal,omitempty"`
-	Attachable     bool                   `protobuf:"varint,10,opt,name=attachable,proto3" json:"attachable,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	ComposeProject string                 `protobuf:"bytes,12,opt,name=composeProject,proto3" json:"composeProject,omitempty"`
-	ContainerIds   []string               `protobuf:"bytes,13,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *Network) Reset() {
-	*x = Network{}
-	mi := &file_docker_v1_docker_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Network) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Network) ProtoMessage() {}
-
-func (x *Network) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[19]
+func (x *Image) GetContainers() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Containers
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Network.ProtoReflect.Descriptor instead.
-func (*Network) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{19}
+	return 0
 }
 
-func (x *Network) GetName() string {
+func (x *Image) GetCreated() int64 {
 	if x != nil {
-		return x.Name
+		return x.Created
 	}
-	return ""
+	return 0
 }
 
-func (x *Network) GetId() string {
+func (x *Image) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Network) GetSubnet() string {
+func (x *Image) GetLabels() map[string]string {
 	if x != nil {
-		return x.Subnet
+		return x.Labels
 	}
-	return ""
+	return nil
 }
 
-func (x *Network) GetScope() string {
+func (x *Image) GetParentId() string {
 	if x != nil {
-		return x.Scope
+		return x.ParentId
 	}
 	return ""
 }
 
-func (x *Network) GetDriver() string {
+func (x *Image) GetManifests() []*ManifestSummary {
 	if x != nil {
-		return x.Driver
+		return x.Manifests
 	}
-	return ""
+	return nil
 }
 
-func (x *Network) GetEnableIpv4() bool {
+func (x *Image) GetRepoDigests() []string {
 	if x != nil {
-		return x.EnableIpv4
+		return x.RepoDigests
 	}
-	return false
+	return nil
 }
 
-func (x *Network) GetEnableIpv6() bool {
+func (x *Image) GetRepoTags() []string {
 	if x != nil {
-		return x.EnableIpv6
+		return x.RepoTags
 	}
-	return false
+	return nil
 }
 
-func (x *Network) GetInternal() bool {
+func (x *Image) GetSharedSize() int64 {
 	if x != nil {
-		return x.Internal
+		return x.SharedSize
 	}
-	return false
+	return 0
 }
 
-func (x *Network) GetAttachable() bool {
+func (x *Image) GetSize() int64 {
 	if x != nil {
-		return x.Attachable
+		return x.Size
 	}
-	return false
+	return 0
 }
 
-func (x *Network) GetCreatedAt() string {
+func (x *Image) GetUpdateRef() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdateRef
 	}
 	return ""
 }
 
-func (x *Network) GetComposeProject() string {
+type ManifestSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digest        string                 `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestSummary) Reset() {
+	*x = ManifestSummary{}
+	mi := &file_docker_v1_docker_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestSummary) ProtoMessage() {}
+
+func (x *ManifestSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[19]
 	if x != nil {
-		return x.ComposeProject
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestSummary.ProtoReflect.Descriptor instead.
+func (*ManifestSummary) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ManifestSummary) GetDigest() string {
+	if x != nil {
+		return x.Digest
 	}
 	return ""
 }
 
-func (x *Network) GetContainerIds() []string {
+func (x *ManifestSummary) GetMediaType() string {
 	if x != nil {
-		return x.ContainerIds
+		return x.MediaType
 	}
-	return nil
+	return ""
 }
 
-type ListNetworksRequest struct {
+func (x *ManifestSummary) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListImagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNetworksRequest) Reset() {
-	*x = ListNetworksRequest{}
+func (x *ListImagesRequest) Reset() {
+	*x = ListImagesRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworksRequest) String() string {
+func (x *ListImagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworksRequest) ProtoMessage() {}
+func (*ListImagesRequest) ProtoMessage() {}
 
-func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
+func (x *ListImagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1308,32 +1571,35 @@ func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
-func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListImagesRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{20}
 }
 
-type ListNetworksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Networks      []*Network             `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListImagesResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalDiskUsage     int64                  `protobuf:"varint,1,opt,name=totalDiskUsage,proto3" json:"totalDiskUsage,omitempty"`
+	UnusedImageCount   int64                  `protobuf:"varint,2,opt,name=unusedImageCount,proto3" json:"unusedImageCount,omitempty"`
+	UntaggedImageCount int64                  `protobuf:"varint,3,opt,name=untaggedImageCount,proto3" json:"untaggedImageCount,omitempty"`
+	Images             []*Image               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListNetworksResponse) Reset() {
-	*x = ListNetworksResponse{}
+func (x *ListImagesResponse) Reset() {
+	*x = ListImagesResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNetworksResponse) String() string {
+func (x *ListImagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNetworksResponse) ProtoMessage() {}
+func (*ListImagesResponse) ProtoMessage() {}
 
-func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
+func (x *ListImagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1345,38 +1611,60 @@ func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
-func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListImagesResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListNetworksResponse) GetNetworks() []*Network {
+func (x *ListImagesResponse) GetTotalDiskUsage() int64 {
 	if x != nil {
-		return x.Networks
+		return x.TotalDiskUsage
+	}
+	return 0
+}
+
+func (x *ListImagesResponse) GetUnusedImageCount() int64 {
+	if x != nil {
+		return x.UnusedImageCount
+	}
+	return 0
+}
+
+func (x *ListImagesResponse) GetUntaggedImageCount() int64 {
+	if x != nil {
+		return x.UntaggedImageCount
+	}
+	return 0
+}
+
+func (x *ListImagesResponse) GetImages() []*Image {
+	if x != nil {
+		return x.Images
 	}
 	return nil
 }
 
-type CreateNetworkRequest struct {
+type RemoveImageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ImageIds      []string               `protobuf:"bytes,1,rep,name=imageIds,proto3" json:"imageIds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNetworkRequest) Reset() {
-	*x = CreateNetworkRequest{}
+func (x *RemoveImageRequest) Reset() {
+	*x = RemoveImageRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNetworkRequest) String() string {
+func (x *RemoveImageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNetworkRequest) ProtoMessage() {}
+func (*RemoveImageRequest) ProtoMessage() {}
 
-func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveImageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1388,31 +1676,38 @@ func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNetworkRequest.ProtoReflect.Descriptor instead.
-func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveImageRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{22}
 }
 
-type CreateNetworkResponse struct {
+func (x *RemoveImageRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type RemoveImageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNetworkResponse) Reset() {
-	*x = CreateNetworkResponse{}
+func (x *RemoveImageResponse) Reset() {
+	*x = RemoveImageResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNetworkResponse) String() string {
+func (x *RemoveImageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNetworkResponse) ProtoMessage() {}
+func (*RemoveImageResponse) ProtoMessage() {}
 
-func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveImageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1424,33 +1719,33 @@ func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNetworkResponse.ProtoReflect.Descriptor instead.
-func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveImageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveImageResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{23}
 }
 
-type DeleteNetworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkIds    []string               `protobuf:"bytes,1,rep,name=networkIds,proto3" json:"networkIds,omitempty"`
-	Prune         bool                   `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ImagePruneResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SpaceReclaimed uint64                 `protobuf:"varint,1,opt,name=SpaceReclaimed,proto3" json:"SpaceReclaimed,omitempty"`
+	Deleted        []*ImagesDeleted       `protobuf:"bytes,2,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteNetworkRequest) Reset() {
-	*x = DeleteNetworkRequest{}
+func (x *ImagePruneResponse) Reset() {
+	*x = ImagePruneResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNetworkRequest) String() string {
+func (x *ImagePruneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNetworkRequest) ProtoMessage() {}
+func (*ImagePruneResponse) ProtoMessage() {}
 
-func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
+func (x *ImagePruneResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,45 +1757,46 @@ func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNetworkRequest.ProtoReflect.Descriptor instead.
-func (*DeleteNetworkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImagePruneResponse.ProtoReflect.Descriptor instead.
+func (*ImagePruneResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteNetworkRequest) GetNetworkIds() []string {
+func (x *ImagePruneResponse) GetSpaceReclaimed() uint64 {
 	if x != nil {
-		return x.NetworkIds
+		return x.SpaceReclaimed
 	}
-	return nil
+	return 0
 }
 
-func (x *DeleteNetworkRequest) GetPrune() bool {
+func (x *ImagePruneResponse) GetDeleted() []*ImagesDeleted {
 	if x != nil {
-		return x.Prune
+		return x.Deleted
 	}
-	return false
+	return nil
 }
 
-type DeleteNetworkResponse struct {
+type ImagePruneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	PruneAll      bool                   `protobuf:"varint,1,opt,name=pruneAll,proto3" json:"pruneAll,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteNetworkResponse) Reset() {
-	*x = DeleteNetworkResponse{}
+func (x *ImagePruneRequest) Reset() {
+	*x = ImagePruneRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteNetworkResponse) String() string {
+func (x *ImagePruneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteNetworkResponse) ProtoMessage() {}
+func (*ImagePruneRequest) ProtoMessage() {}
 
-func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
+func (x *ImagePruneRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1512,32 +1808,42 @@ func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteNetworkResponse.ProtoReflect.Descriptor instead.
-func (*DeleteNetworkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImagePruneRequest.ProtoReflect.Descriptor instead.
+func (*ImagePruneRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{25}
 }
 
-type ContainerLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerID   string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+func (x *ImagePruneRequest) GetPruneAll() bool {
+	if x != nil {
+		return x.PruneAll
+	}
+	return false
+}
+
+type DiskUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for the active host
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// largest items listed per category, defaults to 10
+	TopN          int32 `protobuf:"varint,2,opt,name=topN,proto3" json:"topN,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerLogsRequest) Reset() {
-	*x = ContainerLogsRequest{}
+func (x *DiskUsageRequest) Reset() {
+	*x = DiskUsageRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerLogsRequest) String() string {
+func (x *DiskUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerLogsRequest) ProtoMessage() {}
+func (*DiskUsageRequest) ProtoMessage() {}
 
-func (x *ContainerLogsRequest) ProtoReflect() protoreflect.Message {
+func (x *DiskUsageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1549,39 +1855,49 @@ func (x *ContainerLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerLogsRequest.ProtoReflect.Descriptor instead.
-func (*ContainerLogsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskUsageRequest.ProtoReflect.Descriptor instead.
+func (*DiskUsageRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ContainerLogsRequest) GetContainerID() string {
+func (x *DiskUsageRequest) GetHost() string {
 	if x != nil {
-		return x.ContainerID
+		return x.Host
 	}
 	return ""
 }
 
-type LogsMessage struct {
+func (x *DiskUsageRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+type DiskUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Images        *UsageCategory         `protobuf:"bytes,1,opt,name=images,proto3" json:"images,omitempty"`
+	Containers    *UsageCategory         `protobuf:"bytes,2,opt,name=containers,proto3" json:"containers,omitempty"`
+	Volumes       *UsageCategory         `protobuf:"bytes,3,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	BuildCache    *UsageCategory         `protobuf:"bytes,4,opt,name=buildCache,proto3" json:"buildCache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogsMessage) Reset() {
-	*x = LogsMessage{}
+func (x *DiskUsageResponse) Reset() {
+	*x = DiskUsageResponse{}
 	mi := &file_docker_v1_docker_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogsMessage) String() string {
+func (x *DiskUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsMessage) ProtoMessage() {}
+func (*DiskUsageResponse) ProtoMessage() {}
 
-func (x *LogsMessage) ProtoReflect() protoreflect.Message {
+func (x *DiskUsageResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,40 +1909,66 @@ func (x *LogsMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsMessage.ProtoReflect.Descriptor instead.
-func (*LogsMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiskUsageResponse.ProtoReflect.Descriptor instead.
+func (*DiskUsageResponse) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *LogsMessage) GetMessage() string {
+func (x *DiskUsageResponse) GetImages() *UsageCategory {
 	if x != nil {
-		return x.Message
+		return x.Images
 	}
-	return ""
+	return nil
 }
 
-type StatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
-	Containers    []*ContainerStats      `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+func (x *DiskUsageResponse) GetContainers() *UsageCategory {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *DiskUsageResponse) GetVolumes() *UsageCategory {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *DiskUsageResponse) GetBuildCache() *UsageCategory {
+	if x != nil {
+		return x.BuildCache
+	}
+	return nil
+}
+
+type UsageCategory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Count int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// in use by a container or build, running for containers
+	Active      int64 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Total       int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Reclaimable int64 `protobuf:"varint,4,opt,name=reclaimable,proto3" json:"reclaimable,omitempty"`
+	// largest first
+	Top           []*UsageItem `protobuf:"bytes,5,rep,name=top,proto3" json:"top,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsResponse) Reset() {
-	*x = StatsResponse{}
+func (x *UsageCategory) Reset() {
+	*x = UsageCategory{}
 	mi := &file_docker_v1_docker_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsResponse) String() string {
+func (x *UsageCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse) ProtoMessage() {}
+func (*UsageCategory) ProtoMessage() {}
 
-func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+func (x *UsageCategory) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1638,48 +1980,70 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
-func (*StatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsageCategory.ProtoReflect.Descriptor instead.
+func (*UsageCategory) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *StatsResponse) GetSystem() *SystemInfo {
+func (x *UsageCategory) GetCount() int64 {
 	if x != nil {
-		return x.System
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-func (x *StatsResponse) GetContainers() []*ContainerStats {
+func (x *UsageCategory) GetActive() int64 {
 	if x != nil {
-		return x.Containers
+		return x.Active
+	}
+	return 0
+}
+
+func (x *UsageCategory) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *UsageCategory) GetReclaimable() int64 {
+	if x != nil {
+		return x.Reclaimable
+	}
+	return 0
+}
+
+func (x *UsageCategory) GetTop() []*UsageItem {
+	if x != nil {
+		return x.Top
 	}
 	return nil
 }
 
-type StatsRequest struct {
+type UsageItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	File          *ComposeFile           `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	SortBy        SORT_FIELD             `protobuf:"varint,2,opt,name=sortBy,proto3,enum=docker.v1.SORT_FIELD" json:"sortBy,omitempty"`
-	Order         ORDER                  `protobuf:"varint,3,opt,name=order,proto3,enum=docker.v1.ORDER" json:"order,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Reclaimable   bool                   `protobuf:"varint,4,opt,name=reclaimable,proto3" json:"reclaimable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsRequest) Reset() {
-	*x = StatsRequest{}
+func (x *UsageItem) Reset() {
+	*x = UsageItem{}
 	mi := &file_docker_v1_docker_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsRequest) String() string {
+func (x *UsageItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsRequest) ProtoMessage() {}
+func (*UsageItem) ProtoMessage() {}
 
-func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+func (x *UsageItem) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,54 +2055,74 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
-func (*StatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UsageItem.ProtoReflect.Descriptor instead.
+func (*UsageItem) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *StatsRequest) GetFile() *ComposeFile {
+func (x *UsageItem) GetId() string {
 	if x != nil {
-		return x.File
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *StatsRequest) GetSortBy() SORT_FIELD {
+func (x *UsageItem) GetName() string {
 	if x != nil {
-		return x.SortBy
+		return x.Name
 	}
-	return SORT_FIELD_NAME
+	return ""
 }
 
-func (x *StatsRequest) GetOrder() ORDER {
+func (x *UsageItem) GetSize() int64 {
 	if x != nil {
-		return x.Order
+		return x.Size
 	}
-	return ORDER_DSC
+	return 0
 }
 
-type SystemInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CPU           float64                `protobuf:"fixed64,1,opt,name=CPU,proto3" json:"CPU,omitempty"` // represents float64
-	MemInBytes    uint64                 `protobuf:"varint,2,opt,name=memInBytes,proto3" json:"memInBytes,omitempty"`
+func (x *UsageItem) GetReclaimable() bool {
+	if x != nil {
+		return x.Reclaimable
+	}
+	return false
+}
+
+type PruneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for the active host
+	Host       string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Containers bool   `protobuf:"varint,2,opt,name=containers,proto3" json:"containers,omitempty"`
+	Images     bool   `protobuf:"varint,3,opt,name=images,proto3" json:"images,omitempty"`
+	// also tagged images without a container, otherwise only dangling
+	AllImages bool `protobuf:"varint,4,opt,name=allImages,proto3" json:"allImages,omitempty"`
+	Volumes   bool `protobuf:"varint,5,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	// also named volumes, otherwise only anonymous
+	AllVolumes bool `protobuf:"varint,6,opt,name=allVolumes,proto3" json:"allVolumes,omitempty"`
+	Networks   bool `protobuf:"varint,7,opt,name=networks,proto3" json:"networks,omitempty"`
+	BuildCache bool `protobuf:"varint,8,opt,name=buildCache,proto3" json:"buildCache,omitempty"`
+	// timestamp or duration eg: 24h, volumes are skipped if set
+	Until string `protobuf:"bytes,9,opt,name=until,proto3" json:"until,omitempty"`
+	// key or key=value, prefix with ! to exclude, build cache is skipped if set
+	Labels        []string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SystemInfo) Reset() {
-	*x = SystemInfo{}
+func (x *PruneRequest) Reset() {
+	*x = PruneRequest{}
 	mi := &file_docker_v1_docker_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SystemInfo) String() string {
+func (x *PruneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SystemInfo) ProtoMessage() {}
+func (*PruneRequest) ProtoMessage() {}
 
-func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+func (x *PruneRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_docker_v1_docker_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1750,102 +2134,112 @@ func (x *SystemInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
-func (*SystemInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use PruneRequest.ProtoReflect.Descriptor instead.
+func (*PruneRequest) Descriptor() ([]byte, []int) {
 	return file_docker_v1_docker_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SystemInfo) GetCPU() float64 {
+func (x *PruneRequest) GetHost() string {
 	if x != nil {
-		return x.CPU
+		return x.Host
 	}
-	return 0
+	return ""
 }
 
-func (x *SystemInfo) GetMemInBytes() uint64 {
+func (x *PruneRequest) GetContainers() bool {
 	if x != nil {
-		return x.MemInBytes
+		return x.Containers
 	}
-	return 0
+	return false
 }
 
-type ListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*ContainerList       `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PruneRequest) GetImages() bool {
+	if x != nil {
+		return x.Images
+	}
+	return false
 }
 
-func (x *ListResponse) Reset() {
-	*x = ListResponse{}
-	mi := &file_docker_v1_docker_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *PruneRequest) GetAllImages() bool {
+	if x != nil {
+		return x.AllImages
+	}
+	return false
 }
 
-func (x *ListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PruneRequest) GetVolumes() bool {
+	if x != nil {
+		return x.Volumes
+	}
+	return false
 }
 
-func (*ListResponse) ProtoMessage() {}
+func (x *PruneRequest) GetAllVolumes() bool {
+	if x != nil {
+		return x.AllVolumes
+	}
+	return false
+}
 
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[31]
+func (x *PruneRequest) GetNetworks() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Networks
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{31}
+func (x *PruneRequest) GetBuildCache() bool {
+	if x != nil {
+		return x.BuildCache
+	}
+	return false
 }
 
-func (x *ListResponse) GetList() []*ContainerList {
+func (x *PruneRequest) GetUntil() string {
 	if x != nil {
-		return x.List
+		return x.Until
+	}
+	return ""
+}
+
+func (x *PruneRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
 
-type ContainerList struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ImageID   string                 `protobuf:"bytes,2,opt,name=imageID,proto3" json:"imageID,omitempty"`
-	ImageName string                 `protobuf:"bytes,3,opt,name=imageName,proto3" json:"imageName,omitempty"`
-	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Name      string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Created   string                 `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
-	Ports     []*Port                `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty"`
-	// name to use in selecting service in docker compose
-	ServiceName     string `protobuf:"bytes,8,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	ServicePath     string `protobuf:"bytes,9,opt,name=servicePath,proto3" json:"servicePath,omitempty"`
-	StackName       string `protobuf:"bytes,10,opt,name=stackName,proto3" json:"stackName,omitempty"`
-	UpdateAvailable string `protobuf:"bytes,11,opt,name=updateAvailable,proto3" json:"updateAvailable,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type PruneResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ContainersDeleted []string               `protobuf:"bytes,1,rep,name=containersDeleted,proto3" json:"containersDeleted,omitempty"`
+	ImagesDeleted     []string               `protobuf:"bytes,2,rep,name=imagesDeleted,proto3" json:"imagesDeleted,omitempty"`
+	VolumesDeleted    []string               `protobuf:"bytes,3,rep,name=volumesDeleted,proto3" json:"volumesDeleted,omitempty"`
+	NetworksDeleted   []string               `protobuf:"bytes,4,rep,name=networksDeleted,proto3" json:"networksDeleted,omitempty"`
+	CachesDeleted     []string               `protobuf:"bytes,5,rep,name=cachesDeleted,proto3" json:"cachesDeleted,omitempty"`
+	SpaceReclaimed    uint64                 `protobuf:"varint,6,opt,name=spaceReclaimed,proto3" json:"spaceReclaimed,omitempty"`
+	// object types skipped because a filter does not apply to them
+	Skipped []string `protobuf:"bytes,7,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	// object types that failed, the others are still pruned
+	Errors        []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerList) Reset() {
-	*x = ContainerList{}
-	mi := &file_docker_v1_docker_proto_msgTypes[32]
+func (x *PruneResponse) Reset() {
+	*x = PruneResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerList) String() string {
+func (x *PruneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerList) ProtoMessage() {}
+func (*PruneResponse) ProtoMessage() {}
 
-func (x *ContainerList) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[32]
+func (x *PruneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,128 +2250,3205 @@ func (x *ContainerList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerList.ProtoReflect.Descriptor instead.
-func (*ContainerList) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PruneResponse.ProtoReflect.Descriptor instead.
+func (*PruneResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ContainerList) GetId() string {
+func (x *PruneResponse) GetContainersDeleted() []string {
 	if x != nil {
-		return x.Id
+		return x.ContainersDeleted
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerList) GetImageID() string {
+func (x *PruneResponse) GetImagesDeleted() []string {
 	if x != nil {
-		return x.ImageID
+		return x.ImagesDeleted
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerList) GetImageName() string {
+func (x *PruneResponse) GetVolumesDeleted() []string {
 	if x != nil {
-		return x.ImageName
+		return x.VolumesDeleted
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerList) GetStatus() string {
+func (x *PruneResponse) GetNetworksDeleted() []string {
 	if x != nil {
-		return x.Status
+		return x.NetworksDeleted
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerList) GetName() string {
+func (x *PruneResponse) GetCachesDeleted() []string {
 	if x != nil {
-		return x.Name
+		return x.CachesDeleted
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerList) GetCreated() string {
+func (x *PruneResponse) GetSpaceReclaimed() uint64 {
 	if x != nil {
-		return x.Created
+		return x.SpaceReclaimed
 	}
-	return ""
+	return 0
 }
 
-func (x *ContainerList) GetPorts() []*Port {
+func (x *PruneResponse) GetSkipped() []string {
 	if x != nil {
-		return x.Ports
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *PruneResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *ContainerList) GetServiceName() string {
-	if x != nil {
-		return x.ServiceName
-	}
-	return ""
+type ImagesDeleted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deleted       string                 `protobuf:"bytes,1,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
+	Untagged      string                 `protobuf:"bytes,2,opt,name=Untagged,proto3" json:"Untagged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImagesDeleted) Reset() {
+	*x = ImagesDeleted{}
+	mi := &file_docker_v1_docker_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImagesDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagesDeleted) ProtoMessage() {}
+
+func (x *ImagesDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagesDeleted.ProtoReflect.Descriptor instead.
+func (*ImagesDeleted) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImagesDeleted) GetDeleted() string {
+	if x != nil {
+		return x.Deleted
+	}
+	return ""
+}
+
+func (x *ImagesDeleted) GetUntagged() string {
+	if x != nil {
+		return x.Untagged
+	}
+	return ""
+}
+
+// Volume-related messages
+type Volume struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ContainerID        string                 `protobuf:"bytes,2,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	MountPoint         string                 `protobuf:"bytes,4,opt,name=mountPoint,proto3" json:"mountPoint,omitempty"`
+	Size               int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Labels             string                 `protobuf:"bytes,6,opt,name=labels,proto3" json:"labels,omitempty"`
+	ComposePath        string                 `protobuf:"bytes,7,opt,name=composePath,proto3" json:"composePath,omitempty"`
+	ComposeProjectName string                 `protobuf:"bytes,8,opt,name=composeProjectName,proto3" json:"composeProjectName,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	mi := &file_docker_v1_docker_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Volume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Volume) GetContainerID() string {
+	if x != nil {
+		return x.ContainerID
+	}
+	return ""
+}
+
+func (x *Volume) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Volume) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *Volume) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Volume) GetLabels() string {
+	if x != nil {
+		return x.Labels
+	}
+	return ""
+}
+
+func (x *Volume) GetComposePath() string {
+	if x != nil {
+		return x.ComposePath
+	}
+	return ""
+}
+
+func (x *Volume) GetComposeProjectName() string {
+	if x != nil {
+		return x.ComposeProjectName
+	}
+	return ""
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{34}
+}
+
+type VolumeListFilesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Volume string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	// empty for the volume root
+	Dir           string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeListFilesRequest) Reset() {
+	*x = VolumeListFilesRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeListFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeListFilesRequest) ProtoMessage() {}
+
+func (x *VolumeListFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeListFilesRequest.ProtoReflect.Descriptor instead.
+func (*VolumeListFilesRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VolumeListFilesRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *VolumeListFilesRequest) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type VolumeListFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*FileEntry           `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeListFilesResponse) Reset() {
+	*x = VolumeListFilesResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeListFilesResponse) ProtoMessage() {}
+
+func (x *VolumeListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeListFilesResponse.ProtoReflect.Descriptor instead.
+func (*VolumeListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VolumeListFilesResponse) GetFiles() []*FileEntry {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type FileEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path  string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size  int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	IsDir bool                   `protobuf:"varint,4,opt,name=isDir,proto3" json:"isDir,omitempty"`
+	// permission and type string e.g. drwxr-xr-x
+	Mode    string `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	ModTime string `protobuf:"bytes,6,opt,name=modTime,proto3" json:"modTime,omitempty"`
+	Uid     int32  `protobuf:"varint,7,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid     int32  `protobuf:"varint,8,opt,name=gid,proto3" json:"gid,omitempty"`
+	// only set for symlinks in containers
+	LinkTarget    string `protobuf:"bytes,9,opt,name=linkTarget,proto3" json:"linkTarget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileEntry) Reset() {
+	*x = FileEntry{}
+	mi := &file_docker_v1_docker_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileEntry) ProtoMessage() {}
+
+func (x *FileEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileEntry.ProtoReflect.Descriptor instead.
+func (*FileEntry) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FileEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *FileEntry) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *FileEntry) GetModTime() string {
+	if x != nil {
+		return x.ModTime
+	}
+	return ""
+}
+
+func (x *FileEntry) GetUid() int32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *FileEntry) GetGid() int32 {
+	if x != nil {
+		return x.Gid
+	}
+	return 0
+}
+
+func (x *FileEntry) GetLinkTarget() string {
+	if x != nil {
+		return x.LinkTarget
+	}
+	return ""
+}
+
+type VolumeFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeFileRequest) Reset() {
+	*x = VolumeFileRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeFileRequest) ProtoMessage() {}
+
+func (x *VolumeFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeFileRequest.ProtoReflect.Descriptor instead.
+func (*VolumeFileRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VolumeFileRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *VolumeFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// files are limited to 10 MiB
+type VolumeFileContents struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Contents      []byte                 `protobuf:"bytes,2,opt,name=contents,proto3" json:"contents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeFileContents) Reset() {
+	*x = VolumeFileContents{}
+	mi := &file_docker_v1_docker_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeFileContents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeFileContents) ProtoMessage() {}
+
+func (x *VolumeFileContents) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeFileContents.ProtoReflect.Descriptor instead.
+func (*VolumeFileContents) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VolumeFileContents) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VolumeFileContents) GetContents() []byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+type VolumeWriteFileRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Volume   string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Path     string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Contents []byte                 `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	// octal permissions, 0 defaults to 0644
+	Mode          uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeWriteFileRequest) Reset() {
+	*x = VolumeWriteFileRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeWriteFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeWriteFileRequest) ProtoMessage() {}
+
+func (x *VolumeWriteFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeWriteFileRequest.ProtoReflect.Descriptor instead.
+func (*VolumeWriteFileRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VolumeWriteFileRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *VolumeWriteFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VolumeWriteFileRequest) GetContents() []byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *VolumeWriteFileRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type VolumeDeleteFileRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Volume string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Path   string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// required to delete directories
+	Recursive     bool `protobuf:"varint,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeDeleteFileRequest) Reset() {
+	*x = VolumeDeleteFileRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeDeleteFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeDeleteFileRequest) ProtoMessage() {}
+
+func (x *VolumeDeleteFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeDeleteFileRequest.ProtoReflect.Descriptor instead.
+func (*VolumeDeleteFileRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VolumeDeleteFileRequest) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *VolumeDeleteFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VolumeDeleteFileRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+type ListVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type CreateVolumeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// generated by docker if empty
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// defaults to local
+	Driver string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	// e.g. for an nfs share with the local driver
+	// type=nfs o=addr=10.0.0.2,rw device=:/exports/data
+	DriverOpts    map[string]string `protobuf:"bytes,3,rep,name=driverOpts,proto3" json:"driverOpts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeRequest) ProtoMessage() {}
+
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriverOpts() map[string]string {
+	if x != nil {
+		return x.DriverOpts
+	}
+	return nil
+}
+
+func (x *CreateVolumeRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeIds     []string               `protobuf:"bytes,1,rep,name=volumeIds,proto3" json:"volumeIds,omitempty"`
+	Anon          bool                   `protobuf:"varint,2,opt,name=anon,proto3" json:"anon,omitempty"`
+	Unused        bool                   `protobuf:"varint,3,opt,name=unused,proto3" json:"unused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeRequest) Reset() {
+	*x = DeleteVolumeRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeRequest) ProtoMessage() {}
+
+func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DeleteVolumeRequest) GetVolumeIds() []string {
+	if x != nil {
+		return x.VolumeIds
+	}
+	return nil
+}
+
+func (x *DeleteVolumeRequest) GetAnon() bool {
+	if x != nil {
+		return x.Anon
+	}
+	return false
+}
+
+func (x *DeleteVolumeRequest) GetUnused() bool {
+	if x != nil {
+		return x.Unused
+	}
+	return false
+}
+
+type DeleteVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeResponse) Reset() {
+	*x = DeleteVolumeResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeResponse) ProtoMessage() {}
+
+func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{46}
+}
+
+// Network-related messages
+type Network struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Subnet         string                 `protobuf:"bytes,3,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Scope          string                 `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	Driver         string                 `protobuf:"bytes,5,opt,name=driver,proto3" json:"driver,omitempty"`
+	EnableIpv4     bool                   `protobuf:"varint,6,opt,name=enable_ipv4,json=enableIpv4,proto3" json:"enable_ipv4,omitempty"`
+	EnableIpv6     bool                   `protobuf:"varint,7,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
+	Internal       bool                   `protobuf:"varint,9,opt,name=internal,proto3" json:"internal,omitempty"`
+	Attachable     bool                   `protobuf:"varint,10,opt,name=attachable,proto3" json:"attachable,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ComposeProject string                 `protobuf:"bytes,12,opt,name=composeProject,proto3" json:"composeProject,omitempty"`
+	ContainerIds   []string               `protobuf:"bytes,13,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	mi := &file_docker_v1_docker_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Network) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Network) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Network) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *Network) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Network) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Network) GetEnableIpv4() bool {
+	if x != nil {
+		return x.EnableIpv4
+	}
+	return false
+}
+
+func (x *Network) GetEnableIpv6() bool {
+	if x != nil {
+		return x.EnableIpv6
+	}
+	return false
+}
+
+func (x *Network) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *Network) GetAttachable() bool {
+	if x != nil {
+		return x.Attachable
+	}
+	return false
+}
+
+func (x *Network) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Network) GetComposeProject() string {
+	if x != nil {
+		return x.ComposeProject
+	}
+	return ""
+}
+
+func (x *Network) GetContainerIds() []string {
+	if x != nil {
+		return x.ContainerIds
+	}
+	return nil
+}
+
+type ListNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksRequest) Reset() {
+	*x = ListNetworksRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksRequest) ProtoMessage() {}
+
+func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{48}
+}
+
+type ListNetworksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      []*Network             `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksResponse) Reset() {
+	*x = ListNetworksResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksResponse) ProtoMessage() {}
+
+func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListNetworksResponse) GetNetworks() []*Network {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type CreateNetworkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// bridge|macvlan|ipvlan|overlay, defaults to bridge
+	Driver string `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	// driver specific options e.g. parent=eth0 for macvlan
+	Options map[string]string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// ipv4 ipam, docker picks a subnet if empty
+	Subnet        string            `protobuf:"bytes,4,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Gateway       string            `protobuf:"bytes,5,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	IpRange       string            `protobuf:"bytes,6,opt,name=ipRange,proto3" json:"ipRange,omitempty"`
+	Internal      bool              `protobuf:"varint,7,opt,name=internal,proto3" json:"internal,omitempty"`
+	Attachable    bool              `protobuf:"varint,8,opt,name=attachable,proto3" json:"attachable,omitempty"`
+	EnableIpv6    bool              `protobuf:"varint,9,opt,name=enableIpv6,proto3" json:"enableIpv6,omitempty"`
+	Ipv6Subnet    string            `protobuf:"bytes,10,opt,name=ipv6Subnet,proto3" json:"ipv6Subnet,omitempty"`
+	Ipv6Gateway   string            `protobuf:"bytes,11,opt,name=ipv6Gateway,proto3" json:"ipv6Gateway,omitempty"`
+	Labels        map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNetworkRequest) Reset() {
+	*x = CreateNetworkRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNetworkRequest) ProtoMessage() {}
+
+func (x *CreateNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNetworkRequest.ProtoReflect.Descriptor instead.
+func (*CreateNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateNetworkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateNetworkRequest) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetIpRange() string {
+	if x != nil {
+		return x.IpRange
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetAttachable() bool {
+	if x != nil {
+		return x.Attachable
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetEnableIpv6() bool {
+	if x != nil {
+		return x.EnableIpv6
+	}
+	return false
+}
+
+func (x *CreateNetworkRequest) GetIpv6Subnet() string {
+	if x != nil {
+		return x.Ipv6Subnet
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetIpv6Gateway() string {
+	if x != nil {
+		return x.Ipv6Gateway
+	}
+	return ""
+}
+
+func (x *CreateNetworkRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type CreateNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNetworkResponse) Reset() {
+	*x = CreateNetworkResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNetworkResponse) ProtoMessage() {}
+
+func (x *CreateNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNetworkResponse.ProtoReflect.Descriptor instead.
+func (*CreateNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateNetworkResponse) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+type DeleteNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkIds    []string               `protobuf:"bytes,1,rep,name=networkIds,proto3" json:"networkIds,omitempty"`
+	Prune         bool                   `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNetworkRequest) Reset() {
+	*x = DeleteNetworkRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNetworkRequest) ProtoMessage() {}
+
+func (x *DeleteNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNetworkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteNetworkRequest) GetNetworkIds() []string {
+	if x != nil {
+		return x.NetworkIds
+	}
+	return nil
+}
+
+func (x *DeleteNetworkRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+type DeleteNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNetworkResponse) Reset() {
+	*x = DeleteNetworkResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNetworkResponse) ProtoMessage() {}
+
+func (x *DeleteNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNetworkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{53}
+}
+
+type NetworkTopologyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      []*NetworkNode         `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkTopologyResponse) Reset() {
+	*x = NetworkTopologyResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkTopologyResponse) ProtoMessage() {}
+
+func (x *NetworkTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkTopologyResponse.ProtoReflect.Descriptor instead.
+func (*NetworkTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NetworkTopologyResponse) GetNetworks() []*NetworkNode {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type NetworkNode struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Network   *Network               `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Endpoints []*NetworkEndpoint     `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// no container, running or stopped, is attached
+	Unused bool `protobuf:"varint,3,opt,name=unused,proto3" json:"unused,omitempty"`
+	// bridge, host and none are created by docker and cannot be removed
+	Builtin       bool `protobuf:"varint,4,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkNode) Reset() {
+	*x = NetworkNode{}
+	mi := &file_docker_v1_docker_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkNode) ProtoMessage() {}
+
+func (x *NetworkNode) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkNode.ProtoReflect.Descriptor instead.
+func (*NetworkNode) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NetworkNode) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+func (x *NetworkNode) GetEndpoints() []*NetworkEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *NetworkNode) GetUnused() bool {
+	if x != nil {
+		return x.Unused
+	}
+	return false
+}
+
+func (x *NetworkNode) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+// addresses are empty for stopped containers unless they are static
+type NetworkEndpoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId    string                 `protobuf:"bytes,1,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	ContainerName  string                 `protobuf:"bytes,2,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	State          string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Ipv4Address    string                 `protobuf:"bytes,4,opt,name=ipv4Address,proto3" json:"ipv4Address,omitempty"`
+	Ipv6Address    string                 `protobuf:"bytes,5,opt,name=ipv6Address,proto3" json:"ipv6Address,omitempty"`
+	MacAddress     string                 `protobuf:"bytes,6,opt,name=macAddress,proto3" json:"macAddress,omitempty"`
+	Aliases        []string               `protobuf:"bytes,7,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	ComposeProject string                 `protobuf:"bytes,8,opt,name=composeProject,proto3" json:"composeProject,omitempty"`
+	ComposeService string                 `protobuf:"bytes,9,opt,name=composeService,proto3" json:"composeService,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NetworkEndpoint) Reset() {
+	*x = NetworkEndpoint{}
+	mi := &file_docker_v1_docker_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkEndpoint) ProtoMessage() {}
+
+func (x *NetworkEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkEndpoint.ProtoReflect.Descriptor instead.
+func (*NetworkEndpoint) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *NetworkEndpoint) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetIpv4Address() string {
+	if x != nil {
+		return x.Ipv4Address
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetIpv6Address() string {
+	if x != nil {
+		return x.Ipv6Address
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *NetworkEndpoint) GetComposeProject() string {
+	if x != nil {
+		return x.ComposeProject
+	}
+	return ""
+}
+
+func (x *NetworkEndpoint) GetComposeService() string {
+	if x != nil {
+		return x.ComposeService
+	}
+	return ""
+}
+
+type NetworkConnectRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId   string                 `protobuf:"bytes,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	ContainerId string                 `protobuf:"bytes,2,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	Aliases     []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// optional static addresses, require a network with a user defined subnet
+	Ipv4Address   string `protobuf:"bytes,4,opt,name=ipv4Address,proto3" json:"ipv4Address,omitempty"`
+	Ipv6Address   string `protobuf:"bytes,5,opt,name=ipv6Address,proto3" json:"ipv6Address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkConnectRequest) Reset() {
+	*x = NetworkConnectRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConnectRequest) ProtoMessage() {}
+
+func (x *NetworkConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConnectRequest.ProtoReflect.Descriptor instead.
+func (*NetworkConnectRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *NetworkConnectRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *NetworkConnectRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NetworkConnectRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *NetworkConnectRequest) GetIpv4Address() string {
+	if x != nil {
+		return x.Ipv4Address
+	}
+	return ""
+}
+
+func (x *NetworkConnectRequest) GetIpv6Address() string {
+	if x != nil {
+		return x.Ipv6Address
+	}
+	return ""
+}
+
+type NetworkDisconnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,2,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	Force         bool                   `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkDisconnectRequest) Reset() {
+	*x = NetworkDisconnectRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkDisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDisconnectRequest) ProtoMessage() {}
+
+func (x *NetworkDisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDisconnectRequest.ProtoReflect.Descriptor instead.
+func (*NetworkDisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *NetworkDisconnectRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *NetworkDisconnectRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NetworkDisconnectRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ContainerLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerID   string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerLogsRequest) Reset() {
+	*x = ContainerLogsRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerLogsRequest) ProtoMessage() {}
+
+func (x *ContainerLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerLogsRequest.ProtoReflect.Descriptor instead.
+func (*ContainerLogsRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ContainerLogsRequest) GetContainerID() string {
+	if x != nil {
+		return x.ContainerID
+	}
+	return ""
+}
+
+type LogsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogsMessage) Reset() {
+	*x = LogsMessage{}
+	mi := &file_docker_v1_docker_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsMessage) ProtoMessage() {}
+
+func (x *LogsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsMessage.ProtoReflect.Descriptor instead.
+func (*LogsMessage) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LogsMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImageBuildRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// build context relative to the compose root
+	Dir string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// relative to dir, defaults to Dockerfile
+	Dockerfile string            `protobuf:"bytes,2,opt,name=dockerfile,proto3" json:"dockerfile,omitempty"`
+	Tags       []string          `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	BuildArgs  map[string]string `protobuf:"bytes,4,rep,name=buildArgs,proto3" json:"buildArgs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// build stage to stop at
+	Target        string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Pull          bool   `protobuf:"varint,6,opt,name=pull,proto3" json:"pull,omitempty"`
+	NoCache       bool   `protobuf:"varint,7,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageBuildRequest) Reset() {
+	*x = ImageBuildRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageBuildRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageBuildRequest) ProtoMessage() {}
+
+func (x *ImageBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageBuildRequest.ProtoReflect.Descriptor instead.
+func (*ImageBuildRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ImageBuildRequest) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *ImageBuildRequest) GetDockerfile() string {
+	if x != nil {
+		return x.Dockerfile
+	}
+	return ""
+}
+
+func (x *ImageBuildRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImageBuildRequest) GetBuildArgs() map[string]string {
+	if x != nil {
+		return x.BuildArgs
+	}
+	return nil
+}
+
+func (x *ImageBuildRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ImageBuildRequest) GetPull() bool {
+	if x != nil {
+		return x.Pull
+	}
+	return false
+}
+
+func (x *ImageBuildRequest) GetNoCache() bool {
+	if x != nil {
+		return x.NoCache
+	}
+	return false
+}
+
+type ImagePushRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Image string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// leave empty to use the credentials of docker login on the dockman host
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImagePushRequest) Reset() {
+	*x = ImagePushRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImagePushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImagePushRequest) ProtoMessage() {}
+
+func (x *ImagePushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImagePushRequest.ProtoReflect.Descriptor instead.
+func (*ImagePushRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ImagePushRequest) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ImagePushRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ImagePushRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ImageSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []string               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageSaveRequest) Reset() {
+	*x = ImageSaveRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageSaveRequest) ProtoMessage() {}
+
+func (x *ImageSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageSaveRequest.ProtoReflect.Descriptor instead.
+func (*ImageSaveRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ImageSaveRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type ImageTransferRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Images []string               `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	// empty for the active host
+	SourceHost    string `protobuf:"bytes,2,opt,name=sourceHost,proto3" json:"sourceHost,omitempty"`
+	TargetHost    string `protobuf:"bytes,3,opt,name=targetHost,proto3" json:"targetHost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageTransferRequest) Reset() {
+	*x = ImageTransferRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageTransferRequest) ProtoMessage() {}
+
+func (x *ImageTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageTransferRequest.ProtoReflect.Descriptor instead.
+func (*ImageTransferRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ImageTransferRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ImageTransferRequest) GetSourceHost() string {
+	if x != nil {
+		return x.SourceHost
+	}
+	return ""
+}
+
+func (x *ImageTransferRequest) GetTargetHost() string {
+	if x != nil {
+		return x.TargetHost
+	}
+	return ""
+}
+
+// a single json progress message of the daemon
+type ImageProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// layer id for pull and push progress
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// build output
+	Stream string `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	// rendered progress bar
+	Progress string `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Current  int64  `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	Total    int64  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	// set on the final message if the build or push failed
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// auxiliary data as json, holds the image id after a build and the digest after a push
+	Aux           string `protobuf:"bytes,8,opt,name=aux,proto3" json:"aux,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImageProgress) Reset() {
+	*x = ImageProgress{}
+	mi := &file_docker_v1_docker_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageProgress) ProtoMessage() {}
+
+func (x *ImageProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageProgress.ProtoReflect.Descriptor instead.
+func (*ImageProgress) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ImageProgress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImageProgress) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ImageProgress) GetProgress() string {
+	if x != nil {
+		return x.Progress
+	}
+	return ""
+}
+
+func (x *ImageProgress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *ImageProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImageProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImageProgress) GetAux() string {
+	if x != nil {
+		return x.Aux
+	}
+	return ""
+}
+
+type ContainerListFilesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContainerID string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	// empty for the container root
+	Dir           string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerListFilesRequest) Reset() {
+	*x = ContainerListFilesRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerListFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerListFilesRequest) ProtoMessage() {}
+
+func (x *ContainerListFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerListFilesRequest.ProtoReflect.Descriptor instead.
+func (*ContainerListFilesRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ContainerListFilesRequest) GetContainerID() string {
+	if x != nil {
+		return x.ContainerID
+	}
+	return ""
+}
+
+func (x *ContainerListFilesRequest) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type ContainerListFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Files []*FileEntry           `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// set when the directory was too large to list completely through the archive api
+	Truncated     bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerListFilesResponse) Reset() {
+	*x = ContainerListFilesResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerListFilesResponse) ProtoMessage() {}
+
+func (x *ContainerListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ContainerListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ContainerListFilesResponse) GetFiles() []*FileEntry {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ContainerListFilesResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ContainerFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerID   string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerFileRequest) Reset() {
+	*x = ContainerFileRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerFileRequest) ProtoMessage() {}
+
+func (x *ContainerFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerFileRequest.ProtoReflect.Descriptor instead.
+func (*ContainerFileRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ContainerFileRequest) GetContainerID() string {
+	if x != nil {
+		return x.ContainerID
+	}
+	return ""
+}
+
+func (x *ContainerFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ArchiveChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// suggested file name for the archive, only set on the first chunk
+	Filename      string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data          []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveChunk) Reset() {
+	*x = ArchiveChunk{}
+	mi := &file_docker_v1_docker_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveChunk) ProtoMessage() {}
+
+func (x *ArchiveChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveChunk.ProtoReflect.Descriptor instead.
+func (*ArchiveChunk) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ArchiveChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ArchiveChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ContainerUploadRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContainerID string                 `protobuf:"bytes,1,opt,name=containerID,proto3" json:"containerID,omitempty"`
+	// the parent directory must exist
+	Path     string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Contents []byte `protobuf:"bytes,3,opt,name=contents,proto3" json:"contents,omitempty"`
+	// octal permissions, 0 defaults to 0644
+	Mode          uint32 `protobuf:"varint,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerUploadRequest) Reset() {
+	*x = ContainerUploadRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerUploadRequest) ProtoMessage() {}
+
+func (x *ContainerUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerUploadRequest.ProtoReflect.Descriptor instead.
+func (*ContainerUploadRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ContainerUploadRequest) GetContainerID() string {
+	if x != nil {
+		return x.ContainerID
+	}
+	return ""
+}
+
+func (x *ContainerUploadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ContainerUploadRequest) GetContents() []byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *ContainerUploadRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	System        *SystemInfo            `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Containers    []*ContainerStats      `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StatsResponse) GetSystem() *SystemInfo {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetContainers() []*ContainerStats {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type StatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          *ComposeFile           `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	SortBy        SORT_FIELD             `protobuf:"varint,2,opt,name=sortBy,proto3,enum=docker.v1.SORT_FIELD" json:"sortBy,omitempty"`
+	Order         ORDER                  `protobuf:"varint,3,opt,name=order,proto3,enum=docker.v1.ORDER" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StatsRequest) GetFile() *ComposeFile {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *StatsRequest) GetSortBy() SORT_FIELD {
+	if x != nil {
+		return x.SortBy
+	}
+	return SORT_FIELD_NAME
+}
+
+func (x *StatsRequest) GetOrder() ORDER {
+	if x != nil {
+		return x.Order
+	}
+	return ORDER_DSC
+}
+
+type SystemInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CPU           float64                `protobuf:"fixed64,1,opt,name=CPU,proto3" json:"CPU,omitempty"` // represents float64
+	MemInBytes    uint64                 `protobuf:"varint,2,opt,name=memInBytes,proto3" json:"memInBytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	mi := &file_docker_v1_docker_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SystemInfo) GetCPU() float64 {
+	if x != nil {
+		return x.CPU
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetMemInBytes() uint64 {
+	if x != nil {
+		return x.MemInBytes
+	}
+	return 0
+}
+
+type ListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*ContainerList       `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListResponse) GetList() []*ContainerList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type ContainerList struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImageID   string                 `protobuf:"bytes,2,opt,name=imageID,proto3" json:"imageID,omitempty"`
+	ImageName string                 `protobuf:"bytes,3,opt,name=imageName,proto3" json:"imageName,omitempty"`
+	Status    string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Name      string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Created   string                 `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Ports     []*Port                `protobuf:"bytes,7,rep,name=ports,proto3" json:"ports,omitempty"`
+	// name to use in selecting service in docker compose
+	ServiceName     string `protobuf:"bytes,8,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	ServicePath     string `protobuf:"bytes,9,opt,name=servicePath,proto3" json:"servicePath,omitempty"`
+	StackName       string `protobuf:"bytes,10,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	UpdateAvailable string `protobuf:"bytes,11,opt,name=updateAvailable,proto3" json:"updateAvailable,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ContainerList) Reset() {
+	*x = ContainerList{}
+	mi := &file_docker_v1_docker_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerList) ProtoMessage() {}
+
+func (x *ContainerList) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerList.ProtoReflect.Descriptor instead.
+func (*ContainerList) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ContainerList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerList) GetImageID() string {
+	if x != nil {
+		return x.ImageID
+	}
+	return ""
+}
+
+func (x *ContainerList) GetImageName() string {
+	if x != nil {
+		return x.ImageName
+	}
+	return ""
+}
+
+func (x *ContainerList) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContainerList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerList) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *ContainerList) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ContainerList) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ContainerList) GetServicePath() string {
+	if x != nil {
+		return x.ServicePath
+	}
+	return ""
+}
+
+func (x *ContainerList) GetStackName() string {
+	if x != nil {
+		return x.StackName
+	}
+	return ""
+}
+
+func (x *ContainerList) GetUpdateAvailable() string {
+	if x != nil {
+		return x.UpdateAvailable
+	}
+	return ""
+}
+
+// ContainerInfo holds metrics for a single Docker container.
+type ContainerStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the container.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the container.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// CPU usage as a percentage.
+	CpuUsage float64 `protobuf:"fixed64,3,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	// Current memory usage in bytes.
+	MemoryUsage uint64 `protobuf:"varint,4,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
+	// Maximum memory limit in bytes.
+	MemoryLimit uint64 `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	// Total bytes received over the network.
+	NetworkRx uint64 `protobuf:"varint,6,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
+	// Total bytes sent over the network.
+	NetworkTx uint64 `protobuf:"varint,7,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
+	// Total bytes read from block devices.
+	BlockRead uint64 `protobuf:"varint,8,opt,name=block_read,json=blockRead,proto3" json:"block_read,omitempty"`
+	// Total bytes written to block devices.
+	BlockWrite    uint64 `protobuf:"varint,9,opt,name=block_write,json=blockWrite,proto3" json:"block_write,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerStats) Reset() {
+	*x = ContainerStats{}
+	mi := &file_docker_v1_docker_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerStats) ProtoMessage() {}
+
+func (x *ContainerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerStats.ProtoReflect.Descriptor instead.
+func (*ContainerStats) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ContainerStats) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerStats) GetCpuUsage() float64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetMemoryUsage() uint64 {
+	if x != nil {
+		return x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetMemoryLimit() uint64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetNetworkRx() uint64 {
+	if x != nil {
+		return x.NetworkRx
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetNetworkTx() uint64 {
+	if x != nil {
+		return x.NetworkTx
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetBlockRead() uint64 {
+	if x != nil {
+		return x.BlockRead
+	}
+	return 0
+}
+
+func (x *ContainerStats) GetBlockWrite() uint64 {
+	if x != nil {
+		return x.BlockWrite
+	}
+	return 0
+}
+
+type Port struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Public        int32                  `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	Private       int32                  `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	mi := &file_docker_v1_docker_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Port) GetPublic() int32 {
+	if x != nil {
+		return x.Public
+	}
+	return 0
+}
+
+func (x *Port) GetPrivate() int32 {
+	if x != nil {
+		return x.Private
+	}
+	return 0
+}
+
+func (x *Port) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Port) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_docker_v1_docker_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{78}
+}
+
+type ContainerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerIds  []string               `protobuf:"bytes,1,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerRequest) Reset() {
+	*x = ContainerRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerRequest) ProtoMessage() {}
+
+func (x *ContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerRequest.ProtoReflect.Descriptor instead.
+func (*ContainerRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ContainerRequest) GetContainerIds() []string {
+	if x != nil {
+		return x.ContainerIds
+	}
+	return nil
+}
+
+type ContainerImportRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ContainerIds []string               `protobuf:"bytes,1,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
+	// compose file to create, relative to the compose root
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerImportRequest) Reset() {
+	*x = ContainerImportRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ContainerList) GetServicePath() string {
+func (*ContainerImportRequest) ProtoMessage() {}
+
+func (x *ContainerImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[80]
 	if x != nil {
-		return x.ServicePath
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerList) GetStackName() string {
+// Deprecated: Use ContainerImportRequest.ProtoReflect.Descriptor instead.
+func (*ContainerImportRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ContainerImportRequest) GetContainerIds() []string {
 	if x != nil {
-		return x.StackName
+		return x.ContainerIds
 	}
-	return ""
+	return nil
 }
 
-func (x *ContainerList) GetUpdateAvailable() string {
+func (x *ContainerImportRequest) GetFilename() string {
 	if x != nil {
-		return x.UpdateAvailable
+		return x.Filename
 	}
 	return ""
 }
 
-// ContainerInfo holds metrics for a single Docker container.
-type ContainerStats struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier of the container.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Name of the container.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// CPU usage as a percentage.
-	CpuUsage float64 `protobuf:"fixed64,3,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
-	// Current memory usage in bytes.
-	MemoryUsage uint64 `protobuf:"varint,4,opt,name=memory_usage,json=memoryUsage,proto3" json:"memory_usage,omitempty"`
-	// Maximum memory limit in bytes.
-	MemoryLimit uint64 `protobuf:"varint,5,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
-	// Total bytes received over the network.
-	NetworkRx uint64 `protobuf:"varint,6,opt,name=network_rx,json=networkRx,proto3" json:"network_rx,omitempty"`
-	// Total bytes sent over the network.
-	NetworkTx uint64 `protobuf:"varint,7,opt,name=network_tx,json=networkTx,proto3" json:"network_tx,omitempty"`
-	// Total bytes read from block devices.
-	BlockRead uint64 `protobuf:"varint,8,opt,name=block_read,json=blockRead,proto3" json:"block_read,omitempty"`
-	// Total bytes written to block devices.
-	BlockWrite    uint64 `protobuf:"varint,9,opt,name=block_write,json=blockWrite,proto3" json:"block_write,omitempty"`
+type ContainerImportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Yaml          string                 `protobuf:"bytes,2,opt,name=yaml,proto3" json:"yaml,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerStats) Reset() {
-	*x = ContainerStats{}
-	mi := &file_docker_v1_docker_proto_msgTypes[33]
+func (x *ContainerImportResponse) Reset() {
+	*x = ContainerImportResponse{}
+	mi := &file_docker_v1_docker_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerStats) String() string {
+func (x *ContainerImportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerStats) ProtoMessage() {}
+func (*ContainerImportResponse) ProtoMessage() {}
 
-func (x *ContainerStats) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[33]
+func (x *ContainerImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1988,99 +5459,111 @@ func (x *ContainerStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerStats.ProtoReflect.Descriptor instead.
-func (*ContainerStats) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ContainerImportResponse.ProtoReflect.Descriptor instead.
+func (*ContainerImportResponse) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ContainerStats) GetId() string {
+func (x *ContainerImportResponse) GetFilename() string {
 	if x != nil {
-		return x.Id
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *ContainerStats) GetName() string {
+func (x *ContainerImportResponse) GetYaml() string {
 	if x != nil {
-		return x.Name
+		return x.Yaml
 	}
 	return ""
 }
 
-func (x *ContainerStats) GetCpuUsage() float64 {
-	if x != nil {
-		return x.CpuUsage
-	}
-	return 0
+type BulkComposeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// start|stop|restart|update
+	Action  string        `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Targets []*BulkTarget `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+	// max stacks processed at once, defaults to 4
+	Concurrency   int32 `protobuf:"varint,3,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerStats) GetMemoryUsage() uint64 {
-	if x != nil {
-		return x.MemoryUsage
-	}
-	return 0
+func (x *BulkComposeRequest) Reset() {
+	*x = BulkComposeRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerStats) GetMemoryLimit() uint64 {
-	if x != nil {
-		return x.MemoryLimit
-	}
-	return 0
+func (x *BulkComposeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ContainerStats) GetNetworkRx() uint64 {
+func (*BulkComposeRequest) ProtoMessage() {}
+
+func (x *BulkComposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[82]
 	if x != nil {
-		return x.NetworkRx
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ContainerStats) GetNetworkTx() uint64 {
+// Deprecated: Use BulkComposeRequest.ProtoReflect.Descriptor instead.
+func (*BulkComposeRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BulkComposeRequest) GetAction() string {
 	if x != nil {
-		return x.NetworkTx
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-func (x *ContainerStats) GetBlockRead() uint64 {
+func (x *BulkComposeRequest) GetTargets() []*BulkTarget {
 	if x != nil {
-		return x.BlockRead
+		return x.Targets
 	}
-	return 0
+	return nil
 }
 
-func (x *ContainerStats) GetBlockWrite() uint64 {
+func (x *BulkComposeRequest) GetConcurrency() int32 {
 	if x != nil {
-		return x.BlockWrite
+		return x.Concurrency
 	}
 	return 0
 }
 
-type Port struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Public        int32                  `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
-	Private       int32                  `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
-	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+type BulkTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for the active host
+	Host          string       `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	File          *ComposeFile `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Port) Reset() {
-	*x = Port{}
-	mi := &file_docker_v1_docker_proto_msgTypes[34]
+func (x *BulkTarget) Reset() {
+	*x = BulkTarget{}
+	mi := &file_docker_v1_docker_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Port) String() string {
+func (x *BulkTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Port) ProtoMessage() {}
+func (*BulkTarget) ProtoMessage() {}
 
-func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[34]
+func (x *BulkTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,60 +5574,52 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Port.ProtoReflect.Descriptor instead.
-func (*Port) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *Port) GetPublic() int32 {
-	if x != nil {
-		return x.Public
-	}
-	return 0
-}
-
-func (x *Port) GetPrivate() int32 {
-	if x != nil {
-		return x.Private
-	}
-	return 0
+// Deprecated: Use BulkTarget.ProtoReflect.Descriptor instead.
+func (*BulkTarget) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *Port) GetHost() string {
+func (x *BulkTarget) GetHost() string {
 	if x != nil {
 		return x.Host
 	}
 	return ""
 }
 
-func (x *Port) GetType() string {
+func (x *BulkTarget) GetFile() *ComposeFile {
 	if x != nil {
-		return x.Type
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-type Empty struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type BulkProgress struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Host     string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Filename string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// queued|running|log|done|failed
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// compose output for log, error for failed
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_docker_v1_docker_proto_msgTypes[35]
+func (x *BulkProgress) Reset() {
+	*x = BulkProgress{}
+	mi := &file_docker_v1_docker_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *BulkProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*BulkProgress) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[35]
+func (x *BulkProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2155,33 +5630,65 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use BulkProgress.ProtoReflect.Descriptor instead.
+func (*BulkProgress) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{84}
 }
 
-type ContainerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerIds  []string               `protobuf:"bytes,1,rep,name=containerIds,proto3" json:"containerIds,omitempty"`
+func (x *BulkProgress) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *BulkProgress) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *BulkProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ComposeBuildRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// selectedServices limits the build to those services
+	File *ComposeFile `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// always attempt to pull newer base images
+	Pull          bool `protobuf:"varint,2,opt,name=pull,proto3" json:"pull,omitempty"`
+	NoCache       bool `protobuf:"varint,3,opt,name=noCache,proto3" json:"noCache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerRequest) Reset() {
-	*x = ContainerRequest{}
-	mi := &file_docker_v1_docker_proto_msgTypes[36]
+func (x *ComposeBuildRequest) Reset() {
+	*x = ComposeBuildRequest{}
+	mi := &file_docker_v1_docker_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerRequest) String() string {
+func (x *ComposeBuildRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerRequest) ProtoMessage() {}
+func (*ComposeBuildRequest) ProtoMessage() {}
 
-func (x *ContainerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[36]
+func (x *ComposeBuildRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_docker_v1_docker_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,29 +5699,48 @@ func (x *ContainerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerRequest.ProtoReflect.Descriptor instead.
-func (*ContainerRequest) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ComposeBuildRequest.ProtoReflect.Descriptor instead.
+func (*ComposeBuildRequest) Descriptor() ([]byte, []int) {
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ContainerRequest) GetContainerIds() []string {
+func (x *ComposeBuildRequest) GetFile() *ComposeFile {
 	if x != nil {
-		return x.ContainerIds
+		return x.File
 	}
 	return nil
 }
 
+func (x *ComposeBuildRequest) GetPull() bool {
+	if x != nil {
+		return x.Pull
+	}
+	return false
+}
+
+func (x *ComposeBuildRequest) GetNoCache() bool {
+	if x != nil {
+		return x.NoCache
+	}
+	return false
+}
+
 type ComposeFile struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Filename         string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	SelectedServices []string               `protobuf:"bytes,2,rep,name=selectedServices,proto3" json:"selectedServices,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// additional compose files relative to the compose root, merged in order after
+	// the main file and its override file (compose.override.yaml) which is detected automatically
+	ExtraFiles []string `protobuf:"bytes,3,rep,name=extraFiles,proto3" json:"extraFiles,omitempty"`
+	// active profiles, defaults to COMPOSE_PROFILES if empty
+	Profiles      []string `protobuf:"bytes,4,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ComposeFile) Reset() {
 	*x = ComposeFile{}
-	mi := &file_docker_v1_docker_proto_msgTypes[37]
+	mi := &file_docker_v1_docker_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2226,7 +5752,7 @@ func (x *ComposeFile) String() string {
 func (*ComposeFile) ProtoMessage() {}
 
 func (x *ComposeFile) ProtoReflect() protoreflect.Message {
-	mi := &file_docker_v1_docker_proto_msgTypes[37]
+	mi := &file_docker_v1_docker_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2239,7 +5765,7 @@ func (x *ComposeFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComposeFile.ProtoReflect.Descriptor instead.
 func (*ComposeFile) Descriptor() ([]byte, []int) {
-	return file_docker_v1_docker_proto_rawDescGZIP(), []int{37}
+	return file_docker_v1_docker_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *ComposeFile) GetFilename() string {
@@ -2256,13 +5782,123 @@ func (x *ComposeFile) GetSelectedServices() []string {
 	return nil
 }
 
+func (x *ComposeFile) GetExtraFiles() []string {
+	if x != nil {
+		return x.ExtraFiles
+	}
+	return nil
+}
+
+func (x *ComposeFile) GetProfiles() []string {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
 var File_docker_v1_docker_proto protoreflect.FileDescriptor
 
 const file_docker_v1_docker_proto_rawDesc = "" +
 	"\n" +
-	"\x16docker/v1/docker.proto\x12\tdocker.v1\"-\n" +
+	"\x16docker/v1/docker.proto\x12\tdocker.v1\"C\n" +
+	"\rEventsRequest\x12\x14\n" +
+	"\x05types\x18\x01 \x03(\tR\x05types\x12\x1c\n" +
+	"\tstackName\x18\x02 \x01(\tR\tstackName\"\xd6\x02\n" +
+	"\vDockerEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x18\n" +
+	"\aactorID\x18\x03 \x01(\tR\aactorID\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12F\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2&.docker.v1.DockerEvent.AttributesEntryR\n" +
+	"attributes\x12\x1c\n" +
+	"\tstackName\x18\x06 \x01(\tR\tstackName\x12 \n" +
+	"\vserviceName\x18\a \x01(\tR\vserviceName\x12\x12\n" +
+	"\x04time\x18\b \x01(\tR\x04time\x12\x12\n" +
+	"\x04host\x18\t \x01(\tR\x04host\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"I\n" +
+	"\x17ComposeOverviewResponse\x12.\n" +
+	"\x06stacks\x18\x01 \x03(\v2\x16.docker.v1.StackStatusR\x06stacks\"\xa1\x02\n" +
+	"\vStackStatus\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1c\n" +
+	"\tstackName\x18\x02 \x01(\tR\tstackName\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12*\n" +
+	"\x10expectedServices\x18\x04 \x01(\x05R\x10expectedServices\x12(\n" +
+	"\x0frunningServices\x18\x05 \x01(\x05R\x0frunningServices\x12(\n" +
+	"\x0fdriftedServices\x18\x06 \x03(\tR\x0fdriftedServices\x12,\n" +
+	"\x11unhealthyServices\x18\a \x03(\tR\x11unhealthyServices\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"e\n" +
+	"\x14ComposeDriftResponse\x12\x18\n" +
+	"\adrifted\x18\x01 \x01(\bR\adrifted\x123\n" +
+	"\bservices\x18\x02 \x03(\v2\x17.docker.v1.ServiceDriftR\bservices\"\xd4\x01\n" +
+	"\fServiceDrift\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12$\n" +
+	"\rcontainerName\x18\x02 \x01(\tR\rcontainerName\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\"\n" +
+	"\fexpectedHash\x18\x04 \x01(\tR\fexpectedHash\x12\x1e\n" +
+	"\n" +
+	"actualHash\x18\x05 \x01(\tR\n" +
+	"actualHash\x12*\n" +
+	"\x05diffs\x18\x06 \x03(\v2\x14.docker.v1.FieldDiffR\x05diffs\"U\n" +
+	"\tFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1a\n" +
+	"\bexpected\x18\x02 \x01(\tR\bexpected\x12\x16\n" +
+	"\x06actual\x18\x03 \x01(\tR\x06actual\"\xe0\x01\n" +
+	"\x13ComposePlanResponse\x12\x1e\n" +
+	"\n" +
+	"hasChanges\x18\x01 \x01(\bR\n" +
+	"hasChanges\x12;\n" +
+	"\n" +
+	"containers\x18\x02 \x03(\v2\x1b.docker.v1.PlannedContainerR\n" +
+	"containers\x12\x1e\n" +
+	"\n" +
+	"pullImages\x18\x03 \x03(\tR\n" +
+	"pullImages\x12&\n" +
+	"\x0ecreateNetworks\x18\x04 \x03(\tR\x0ecreateNetworks\x12$\n" +
+	"\rcreateVolumes\x18\x05 \x03(\tR\rcreateVolumes\"\x82\x01\n" +
+	"\x10PlannedContainer\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12$\n" +
+	"\rcontainerName\x18\x02 \x01(\tR\rcontainerName\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"d\n" +
+	"\x15ComposeConfigResponse\x12\x12\n" +
+	"\x04yaml\x18\x01 \x01(\tR\x04yaml\x127\n" +
+	"\tvariables\x18\x02 \x03(\v2\x19.docker.v1.ConfigVariableR\tvariables\"\x88\x01\n" +
+	"\x0eConfigVariable\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x18\n" +
+	"\adefault\x18\x04 \x01(\tR\adefault\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\"\xac\x01\n" +
+	"\x12StackGraphResponse\x12*\n" +
+	"\x05nodes\x18\x01 \x03(\v2\x14.docker.v1.GraphNodeR\x05nodes\x12*\n" +
+	"\x05edges\x18\x02 \x03(\v2\x14.docker.v1.GraphEdgeR\x05edges\x12\x1e\n" +
+	"\n" +
+	"startOrder\x18\x03 \x03(\tR\n" +
+	"startOrder\x12\x1e\n" +
+	"\n" +
+	"orderError\x18\x04 \x01(\tR\n" +
+	"orderError\"[\n" +
+	"\tGraphNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"Y\n" +
+	"\tGraphEdge\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\"g\n" +
 	"\x17ComposeValidateResponse\x12\x12\n" +
-	"\x04errs\x18\x01 \x03(\tR\x04errs\"S\n" +
+	"\x04errs\x18\x01 \x03(\tR\x04errs\x128\n" +
+	"\bfindings\x18\x02 \x03(\v2\x1c.docker.v1.ValidationFindingR\bfindings\"y\n" +
+	"\x11ValidationFinding\x12\x1a\n" +
+	"\bseverity\x18\x01 \x01(\tR\bseverity\x12\x14\n" +
+	"\x05check\x18\x02 \x01(\tR\x05check\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"S\n" +
 	"\x15ContainerExecCmdInput\x12\x18\n" +
 	"\auserCmd\x18\x01 \x01(\tR\auserCmd\x12 \n" +
 	"\vcontainerID\x18\x02 \x01(\tR\vcontainerID\"R\n" +
@@ -2306,7 +5942,57 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x0eSpaceReclaimed\x18\x01 \x01(\x04R\x0eSpaceReclaimed\x122\n" +
 	"\adeleted\x18\x02 \x03(\v2\x18.docker.v1.ImagesDeletedR\adeleted\"/\n" +
 	"\x11ImagePruneRequest\x12\x1a\n" +
-	"\bpruneAll\x18\x01 \x01(\bR\bpruneAll\"E\n" +
+	"\bpruneAll\x18\x01 \x01(\bR\bpruneAll\":\n" +
+	"\x10DiskUsageRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04topN\x18\x02 \x01(\x05R\x04topN\"\xed\x01\n" +
+	"\x11DiskUsageResponse\x120\n" +
+	"\x06images\x18\x01 \x01(\v2\x18.docker.v1.UsageCategoryR\x06images\x128\n" +
+	"\n" +
+	"containers\x18\x02 \x01(\v2\x18.docker.v1.UsageCategoryR\n" +
+	"containers\x122\n" +
+	"\avolumes\x18\x03 \x01(\v2\x18.docker.v1.UsageCategoryR\avolumes\x128\n" +
+	"\n" +
+	"buildCache\x18\x04 \x01(\v2\x18.docker.v1.UsageCategoryR\n" +
+	"buildCache\"\x9d\x01\n" +
+	"\rUsageCategory\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\x03R\x06active\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12 \n" +
+	"\vreclaimable\x18\x04 \x01(\x03R\vreclaimable\x12&\n" +
+	"\x03top\x18\x05 \x03(\v2\x14.docker.v1.UsageItemR\x03top\"e\n" +
+	"\tUsageItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12 \n" +
+	"\vreclaimable\x18\x04 \x01(\bR\vreclaimable\"\x9c\x02\n" +
+	"\fPruneRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1e\n" +
+	"\n" +
+	"containers\x18\x02 \x01(\bR\n" +
+	"containers\x12\x16\n" +
+	"\x06images\x18\x03 \x01(\bR\x06images\x12\x1c\n" +
+	"\tallImages\x18\x04 \x01(\bR\tallImages\x12\x18\n" +
+	"\avolumes\x18\x05 \x01(\bR\avolumes\x12\x1e\n" +
+	"\n" +
+	"allVolumes\x18\x06 \x01(\bR\n" +
+	"allVolumes\x12\x1a\n" +
+	"\bnetworks\x18\a \x01(\bR\bnetworks\x12\x1e\n" +
+	"\n" +
+	"buildCache\x18\b \x01(\bR\n" +
+	"buildCache\x12\x14\n" +
+	"\x05until\x18\t \x01(\tR\x05until\x12\x16\n" +
+	"\x06labels\x18\n" +
+	" \x03(\tR\x06labels\"\xb5\x02\n" +
+	"\rPruneResponse\x12,\n" +
+	"\x11containersDeleted\x18\x01 \x03(\tR\x11containersDeleted\x12$\n" +
+	"\rimagesDeleted\x18\x02 \x03(\tR\rimagesDeleted\x12&\n" +
+	"\x0evolumesDeleted\x18\x03 \x03(\tR\x0evolumesDeleted\x12(\n" +
+	"\x0fnetworksDeleted\x18\x04 \x03(\tR\x0fnetworksDeleted\x12$\n" +
+	"\rcachesDeleted\x18\x05 \x03(\tR\rcachesDeleted\x12&\n" +
+	"\x0espaceReclaimed\x18\x06 \x01(\x04R\x0espaceReclaimed\x12\x18\n" +
+	"\askipped\x18\a \x03(\tR\askipped\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\"E\n" +
 	"\rImagesDeleted\x12\x18\n" +
 	"\aDeleted\x18\x01 \x01(\tR\aDeleted\x12\x1a\n" +
 	"\bUntagged\x18\x02 \x01(\tR\bUntagged\"\xfa\x01\n" +
@@ -2322,10 +6008,55 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\vcomposePath\x18\a \x01(\tR\vcomposePath\x12.\n" +
 	"\x12composeProjectName\x18\b \x01(\tR\x12composeProjectName\"\x14\n" +
 	"\x12ListVolumesRequest\"B\n" +
+	"\x16VolumeListFilesRequest\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12\x10\n" +
+	"\x03dir\x18\x02 \x01(\tR\x03dir\"E\n" +
+	"\x17VolumeListFilesResponse\x12*\n" +
+	"\x05files\x18\x01 \x03(\v2\x14.docker.v1.FileEntryR\x05files\"\xcf\x01\n" +
+	"\tFileEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05isDir\x18\x04 \x01(\bR\x05isDir\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x18\n" +
+	"\amodTime\x18\x06 \x01(\tR\amodTime\x12\x10\n" +
+	"\x03uid\x18\a \x01(\x05R\x03uid\x12\x10\n" +
+	"\x03gid\x18\b \x01(\x05R\x03gid\x12\x1e\n" +
+	"\n" +
+	"linkTarget\x18\t \x01(\tR\n" +
+	"linkTarget\"?\n" +
+	"\x11VolumeFileRequest\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"D\n" +
+	"\x12VolumeFileContents\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1a\n" +
+	"\bcontents\x18\x02 \x01(\fR\bcontents\"t\n" +
+	"\x16VolumeWriteFileRequest\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
+	"\bcontents\x18\x03 \x01(\fR\bcontents\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\"c\n" +
+	"\x17VolumeDeleteFileRequest\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1c\n" +
+	"\trecursive\x18\x03 \x01(\bR\trecursive\"B\n" +
 	"\x13ListVolumesResponse\x12+\n" +
-	"\avolumes\x18\x01 \x03(\v2\x11.docker.v1.VolumeR\avolumes\"\x15\n" +
-	"\x13CreateVolumeRequest\"\x16\n" +
-	"\x14CreateVolumeResponse\"_\n" +
+	"\avolumes\x18\x01 \x03(\v2\x11.docker.v1.VolumeR\avolumes\"\xcf\x02\n" +
+	"\x13CreateVolumeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12N\n" +
+	"\n" +
+	"driverOpts\x18\x03 \x03(\v2..docker.v1.CreateVolumeRequest.DriverOptsEntryR\n" +
+	"driverOpts\x12B\n" +
+	"\x06labels\x18\x04 \x03(\v2*.docker.v1.CreateVolumeRequest.LabelsEntryR\x06labels\x1a=\n" +
+	"\x0fDriverOptsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"A\n" +
+	"\x14CreateVolumeResponse\x12)\n" +
+	"\x06volume\x18\x01 \x01(\v2\x11.docker.v1.VolumeR\x06volume\"_\n" +
 	"\x13DeleteVolumeRequest\x12\x1c\n" +
 	"\tvolumeIds\x18\x01 \x03(\tR\tvolumeIds\x12\x12\n" +
 	"\x04anon\x18\x02 \x01(\bR\x04anon\x12\x16\n" +
@@ -2351,19 +6082,127 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\fcontainerIds\x18\r \x03(\tR\fcontainerIds\"\x15\n" +
 	"\x13ListNetworksRequest\"F\n" +
 	"\x14ListNetworksResponse\x12.\n" +
-	"\bnetworks\x18\x01 \x03(\v2\x12.docker.v1.NetworkR\bnetworks\"\x16\n" +
-	"\x14CreateNetworkRequest\"\x17\n" +
-	"\x15CreateNetworkResponse\"L\n" +
+	"\bnetworks\x18\x01 \x03(\v2\x12.docker.v1.NetworkR\bnetworks\"\xb0\x04\n" +
+	"\x14CreateNetworkRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12F\n" +
+	"\aoptions\x18\x03 \x03(\v2,.docker.v1.CreateNetworkRequest.OptionsEntryR\aoptions\x12\x16\n" +
+	"\x06subnet\x18\x04 \x01(\tR\x06subnet\x12\x18\n" +
+	"\agateway\x18\x05 \x01(\tR\agateway\x12\x18\n" +
+	"\aipRange\x18\x06 \x01(\tR\aipRange\x12\x1a\n" +
+	"\binternal\x18\a \x01(\bR\binternal\x12\x1e\n" +
+	"\n" +
+	"attachable\x18\b \x01(\bR\n" +
+	"attachable\x12\x1e\n" +
+	"\n" +
+	"enableIpv6\x18\t \x01(\bR\n" +
+	"enableIpv6\x12\x1e\n" +
+	"\n" +
+	"ipv6Subnet\x18\n" +
+	" \x01(\tR\n" +
+	"ipv6Subnet\x12 \n" +
+	"\vipv6Gateway\x18\v \x01(\tR\vipv6Gateway\x12C\n" +
+	"\x06labels\x18\f \x03(\v2+.docker.v1.CreateNetworkRequest.LabelsEntryR\x06labels\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"E\n" +
+	"\x15CreateNetworkResponse\x12,\n" +
+	"\anetwork\x18\x01 \x01(\v2\x12.docker.v1.NetworkR\anetwork\"L\n" +
 	"\x14DeleteNetworkRequest\x12\x1e\n" +
 	"\n" +
 	"networkIds\x18\x01 \x03(\tR\n" +
 	"networkIds\x12\x14\n" +
 	"\x05prune\x18\x02 \x01(\bR\x05prune\"\x17\n" +
-	"\x15DeleteNetworkResponse\"8\n" +
+	"\x15DeleteNetworkResponse\"M\n" +
+	"\x17NetworkTopologyResponse\x122\n" +
+	"\bnetworks\x18\x01 \x03(\v2\x16.docker.v1.NetworkNodeR\bnetworks\"\xa7\x01\n" +
+	"\vNetworkNode\x12,\n" +
+	"\anetwork\x18\x01 \x01(\v2\x12.docker.v1.NetworkR\anetwork\x128\n" +
+	"\tendpoints\x18\x02 \x03(\v2\x1a.docker.v1.NetworkEndpointR\tendpoints\x12\x16\n" +
+	"\x06unused\x18\x03 \x01(\bR\x06unused\x12\x18\n" +
+	"\abuiltin\x18\x04 \x01(\bR\abuiltin\"\xbd\x02\n" +
+	"\x0fNetworkEndpoint\x12 \n" +
+	"\vcontainerId\x18\x01 \x01(\tR\vcontainerId\x12$\n" +
+	"\rcontainerName\x18\x02 \x01(\tR\rcontainerName\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12 \n" +
+	"\vipv4Address\x18\x04 \x01(\tR\vipv4Address\x12 \n" +
+	"\vipv6Address\x18\x05 \x01(\tR\vipv6Address\x12\x1e\n" +
+	"\n" +
+	"macAddress\x18\x06 \x01(\tR\n" +
+	"macAddress\x12\x18\n" +
+	"\aaliases\x18\a \x03(\tR\aaliases\x12&\n" +
+	"\x0ecomposeProject\x18\b \x01(\tR\x0ecomposeProject\x12&\n" +
+	"\x0ecomposeService\x18\t \x01(\tR\x0ecomposeService\"\xb5\x01\n" +
+	"\x15NetworkConnectRequest\x12\x1c\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\tnetworkId\x12 \n" +
+	"\vcontainerId\x18\x02 \x01(\tR\vcontainerId\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\x12 \n" +
+	"\vipv4Address\x18\x04 \x01(\tR\vipv4Address\x12 \n" +
+	"\vipv6Address\x18\x05 \x01(\tR\vipv6Address\"p\n" +
+	"\x18NetworkDisconnectRequest\x12\x1c\n" +
+	"\tnetworkId\x18\x01 \x01(\tR\tnetworkId\x12 \n" +
+	"\vcontainerId\x18\x02 \x01(\tR\vcontainerId\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"8\n" +
 	"\x14ContainerLogsRequest\x12 \n" +
 	"\vcontainerID\x18\x01 \x01(\tR\vcontainerID\"'\n" +
 	"\vLogsMessage\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"y\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa8\x02\n" +
+	"\x11ImageBuildRequest\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12\x1e\n" +
+	"\n" +
+	"dockerfile\x18\x02 \x01(\tR\n" +
+	"dockerfile\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12I\n" +
+	"\tbuildArgs\x18\x04 \x03(\v2+.docker.v1.ImageBuildRequest.BuildArgsEntryR\tbuildArgs\x12\x16\n" +
+	"\x06target\x18\x05 \x01(\tR\x06target\x12\x12\n" +
+	"\x04pull\x18\x06 \x01(\bR\x04pull\x12\x18\n" +
+	"\anoCache\x18\a \x01(\bR\anoCache\x1a<\n" +
+	"\x0eBuildArgsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"`\n" +
+	"\x10ImagePushRequest\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"*\n" +
+	"\x10ImageSaveRequest\x12\x16\n" +
+	"\x06images\x18\x01 \x03(\tR\x06images\"n\n" +
+	"\x14ImageTransferRequest\x12\x16\n" +
+	"\x06images\x18\x01 \x03(\tR\x06images\x12\x1e\n" +
+	"\n" +
+	"sourceHost\x18\x02 \x01(\tR\n" +
+	"sourceHost\x12\x1e\n" +
+	"\n" +
+	"targetHost\x18\x03 \x01(\tR\n" +
+	"targetHost\"\xc3\x01\n" +
+	"\rImageProgress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06stream\x18\x03 \x01(\tR\x06stream\x12\x1a\n" +
+	"\bprogress\x18\x04 \x01(\tR\bprogress\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\x03R\acurrent\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x03R\x05total\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x10\n" +
+	"\x03aux\x18\b \x01(\tR\x03aux\"O\n" +
+	"\x19ContainerListFilesRequest\x12 \n" +
+	"\vcontainerID\x18\x01 \x01(\tR\vcontainerID\x12\x10\n" +
+	"\x03dir\x18\x02 \x01(\tR\x03dir\"f\n" +
+	"\x1aContainerListFilesResponse\x12*\n" +
+	"\x05files\x18\x01 \x03(\v2\x14.docker.v1.FileEntryR\x05files\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\"L\n" +
+	"\x14ContainerFileRequest\x12 \n" +
+	"\vcontainerID\x18\x01 \x01(\tR\vcontainerID\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\">\n" +
+	"\fArchiveChunk\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"~\n" +
+	"\x16ContainerUploadRequest\x12 \n" +
+	"\vcontainerID\x18\x01 \x01(\tR\vcontainerID\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1a\n" +
+	"\bcontents\x18\x03 \x01(\fR\bcontents\x12\x12\n" +
+	"\x04mode\x18\x04 \x01(\rR\x04mode\"y\n" +
 	"\rStatsResponse\x12-\n" +
 	"\x06system\x18\x01 \x01(\v2\x15.docker.v1.SystemInfoR\x06system\x129\n" +
 	"\n" +
@@ -2415,10 +6254,37 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x04type\x18\x04 \x01(\tR\x04type\"\a\n" +
 	"\x05Empty\"6\n" +
 	"\x10ContainerRequest\x12\"\n" +
-	"\fcontainerIds\x18\x01 \x03(\tR\fcontainerIds\"U\n" +
+	"\fcontainerIds\x18\x01 \x03(\tR\fcontainerIds\"X\n" +
+	"\x16ContainerImportRequest\x12\"\n" +
+	"\fcontainerIds\x18\x01 \x03(\tR\fcontainerIds\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"I\n" +
+	"\x17ContainerImportResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04yaml\x18\x02 \x01(\tR\x04yaml\"\x7f\n" +
+	"\x12BulkComposeRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12/\n" +
+	"\atargets\x18\x02 \x03(\v2\x15.docker.v1.BulkTargetR\atargets\x12 \n" +
+	"\vconcurrency\x18\x03 \x01(\x05R\vconcurrency\"L\n" +
+	"\n" +
+	"BulkTarget\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12*\n" +
+	"\x04file\x18\x02 \x01(\v2\x16.docker.v1.ComposeFileR\x04file\"p\n" +
+	"\fBulkProgress\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"o\n" +
+	"\x13ComposeBuildRequest\x12*\n" +
+	"\x04file\x18\x01 \x01(\v2\x16.docker.v1.ComposeFileR\x04file\x12\x12\n" +
+	"\x04pull\x18\x02 \x01(\bR\x04pull\x12\x18\n" +
+	"\anoCache\x18\x03 \x01(\bR\anoCache\"\x91\x01\n" +
 	"\vComposeFile\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12*\n" +
-	"\x10selectedServices\x18\x02 \x03(\tR\x10selectedServices*`\n" +
+	"\x10selectedServices\x18\x02 \x03(\tR\x10selectedServices\x12\x1e\n" +
+	"\n" +
+	"extraFiles\x18\x03 \x03(\tR\n" +
+	"extraFiles\x12\x1a\n" +
+	"\bprofiles\x18\x04 \x03(\tR\bprofiles*`\n" +
 	"\n" +
 	"SORT_FIELD\x12\b\n" +
 	"\x04NAME\x10\x00\x12\a\n" +
@@ -2434,35 +6300,64 @@ const file_docker_v1_docker_proto_rawDesc = "" +
 	"\x06DISK_W\x10\x06*\x19\n" +
 	"\x05ORDER\x12\a\n" +
 	"\x03DSC\x10\x00\x12\a\n" +
-	"\x03ASC\x10\x012\xb8\x0f\n" +
+	"\x03ASC\x10\x012\xef\x1f\n" +
 	"\rDockerService\x12G\n" +
 	"\x0eContainerStart\x12\x1b.docker.v1.ContainerRequest\x1a\x16.docker.v1.LogsMessage\"\x00\x12F\n" +
 	"\rContainerStop\x12\x1b.docker.v1.ContainerRequest\x1a\x16.docker.v1.LogsMessage\"\x00\x12H\n" +
 	"\x0fContainerRemove\x12\x1b.docker.v1.ContainerRequest\x1a\x16.docker.v1.LogsMessage\"\x00\x12I\n" +
 	"\x10ContainerRestart\x12\x1b.docker.v1.ContainerRequest\x1a\x16.docker.v1.LogsMessage\"\x00\x12B\n" +
-	"\x0fContainerUpdate\x12\x1b.docker.v1.ContainerRequest\x1a\x10.docker.v1.Empty\"\x00\x12<\n" +
-	"\rContainerList\x12\x10.docker.v1.Empty\x1a\x17.docker.v1.ListResponse\"\x00\x12E\n" +
-	"\x0eContainerStats\x12\x17.docker.v1.StatsRequest\x1a\x18.docker.v1.StatsResponse\"\x00\x12L\n" +
-	"\rContainerLogs\x12\x1f.docker.v1.ContainerLogsRequest\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12R\n" +
+	"\x0fContainerUpdate\x12\x1b.docker.v1.ContainerRequest\x1a\x10.docker.v1.Empty\"\x00\x12Z\n" +
+	"\x0fContainerImport\x12!.docker.v1.ContainerImportRequest\x1a\".docker.v1.ContainerImportResponse\"\x00\x12?\n" +
+	"\rContainerList\x12\x10.docker.v1.Empty\x1a\x17.docker.v1.ListResponse\"\x03\x90\x02\x01\x12H\n" +
+	"\x0eContainerStats\x12\x17.docker.v1.StatsRequest\x1a\x18.docker.v1.StatsResponse\"\x03\x90\x02\x01\x12O\n" +
+	"\rContainerLogs\x12\x1f.docker.v1.ContainerLogsRequest\x1a\x16.docker.v1.LogsMessage\"\x03\x90\x02\x010\x01\x12R\n" +
 	"\x13ContainerExecOutput\x12\x1f.docker.v1.ContainerExecRequest\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12J\n" +
-	"\x12ContainerExecInput\x12 .docker.v1.ContainerExecCmdInput\x1a\x10.docker.v1.Empty\"\x00\x12B\n" +
+	"\x12ContainerExecInput\x12 .docker.v1.ContainerExecCmdInput\x1a\x10.docker.v1.Empty\"\x00\x12f\n" +
+	"\x12ContainerListFiles\x12$.docker.v1.ContainerListFilesRequest\x1a%.docker.v1.ContainerListFilesResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x11ContainerDownload\x12\x1f.docker.v1.ContainerFileRequest\x1a\x17.docker.v1.ArchiveChunk\"\x03\x90\x02\x010\x01\x12H\n" +
+	"\x0fContainerUpload\x12!.docker.v1.ContainerUploadRequest\x1a\x10.docker.v1.Empty\"\x00\x12B\n" +
 	"\fComposeStart\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12A\n" +
 	"\vComposeStop\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12C\n" +
 	"\rComposeRemove\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12D\n" +
-	"\x0eComposeRestart\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12C\n" +
-	"\rComposeUpdate\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12@\n" +
-	"\vComposeList\x12\x16.docker.v1.ComposeFile\x1a\x17.docker.v1.ListResponse\"\x00\x12O\n" +
-	"\x0fComposeValidate\x12\x16.docker.v1.ComposeFile\x1a\".docker.v1.ComposeValidateResponse\"\x00\x12J\n" +
-	"\tImageList\x12\x1c.docker.v1.ListImagesRequest\x1a\x1d.docker.v1.ListImagesResponse\"\x00\x12N\n" +
+	"\x0eComposeRestart\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12J\n" +
+	"\fComposeBuild\x12\x1e.docker.v1.ComposeBuildRequest\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12C\n" +
+	"\rComposeUpdate\x12\x16.docker.v1.ComposeFile\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12C\n" +
+	"\vComposeList\x12\x16.docker.v1.ComposeFile\x1a\x17.docker.v1.ListResponse\"\x03\x90\x02\x01\x12R\n" +
+	"\x0fComposeValidate\x12\x16.docker.v1.ComposeFile\x1a\".docker.v1.ComposeValidateResponse\"\x03\x90\x02\x01\x12L\n" +
+	"\x0fComposeOverview\x12\x10.docker.v1.Empty\x1a\".docker.v1.ComposeOverviewResponse\"\x03\x90\x02\x01\x12L\n" +
+	"\fComposeDrift\x12\x16.docker.v1.ComposeFile\x1a\x1f.docker.v1.ComposeDriftResponse\"\x03\x90\x02\x01\x12J\n" +
+	"\vComposePlan\x12\x16.docker.v1.ComposeFile\x1a\x1e.docker.v1.ComposePlanResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\rComposeConfig\x12\x16.docker.v1.ComposeFile\x1a .docker.v1.ComposeConfigResponse\"\x03\x90\x02\x01\x12B\n" +
+	"\n" +
+	"StackGraph\x12\x10.docker.v1.Empty\x1a\x1d.docker.v1.StackGraphResponse\"\x03\x90\x02\x01\x12?\n" +
+	"\x0fComposeStartAll\x12\x10.docker.v1.Empty\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12>\n" +
+	"\x0eComposeStopAll\x12\x10.docker.v1.Empty\x1a\x16.docker.v1.LogsMessage\"\x000\x01\x12I\n" +
+	"\vComposeBulk\x12\x1d.docker.v1.BulkComposeRequest\x1a\x17.docker.v1.BulkProgress\"\x000\x01\x12M\n" +
+	"\tImageList\x12\x1c.docker.v1.ListImagesRequest\x1a\x1d.docker.v1.ListImagesResponse\"\x03\x90\x02\x01\x12N\n" +
 	"\vImageRemove\x12\x1d.docker.v1.RemoveImageRequest\x1a\x1e.docker.v1.RemoveImageResponse\"\x00\x12Q\n" +
-	"\x10ImagePruneUnused\x12\x1c.docker.v1.ImagePruneRequest\x1a\x1d.docker.v1.ImagePruneResponse\"\x00\x12M\n" +
+	"\x10ImagePruneUnused\x12\x1c.docker.v1.ImagePruneRequest\x1a\x1d.docker.v1.ImagePruneResponse\"\x00\x12K\n" +
+	"\tDiskUsage\x12\x1b.docker.v1.DiskUsageRequest\x1a\x1c.docker.v1.DiskUsageResponse\"\x03\x90\x02\x01\x12<\n" +
+	"\x05Prune\x12\x17.docker.v1.PruneRequest\x1a\x18.docker.v1.PruneResponse\"\x00\x12H\n" +
+	"\n" +
+	"ImageBuild\x12\x1c.docker.v1.ImageBuildRequest\x1a\x18.docker.v1.ImageProgress\"\x000\x01\x12F\n" +
+	"\tImagePush\x12\x1b.docker.v1.ImagePushRequest\x1a\x18.docker.v1.ImageProgress\"\x000\x01\x12H\n" +
+	"\tImageSave\x12\x1b.docker.v1.ImageSaveRequest\x1a\x17.docker.v1.ArchiveChunk\"\x03\x90\x02\x010\x01\x12N\n" +
+	"\rImageTransfer\x12\x1f.docker.v1.ImageTransferRequest\x1a\x18.docker.v1.ImageProgress\"\x000\x01\x12P\n" +
 	"\n" +
-	"VolumeList\x12\x1d.docker.v1.ListVolumesRequest\x1a\x1e.docker.v1.ListVolumesResponse\"\x00\x12Q\n" +
+	"VolumeList\x12\x1d.docker.v1.ListVolumesRequest\x1a\x1e.docker.v1.ListVolumesResponse\"\x03\x90\x02\x01\x12Q\n" +
 	"\fVolumeCreate\x12\x1e.docker.v1.CreateVolumeRequest\x1a\x1f.docker.v1.CreateVolumeResponse\"\x00\x12Q\n" +
-	"\fVolumeDelete\x12\x1e.docker.v1.DeleteVolumeRequest\x1a\x1f.docker.v1.DeleteVolumeResponse\"\x00\x12P\n" +
-	"\vNetworkList\x12\x1e.docker.v1.ListNetworksRequest\x1a\x1f.docker.v1.ListNetworksResponse\"\x00\x12T\n" +
+	"\fVolumeDelete\x12\x1e.docker.v1.DeleteVolumeRequest\x1a\x1f.docker.v1.DeleteVolumeResponse\"\x00\x12]\n" +
+	"\x0fVolumeListFiles\x12!.docker.v1.VolumeListFilesRequest\x1a\".docker.v1.VolumeListFilesResponse\"\x03\x90\x02\x01\x12R\n" +
+	"\x0eVolumeReadFile\x12\x1c.docker.v1.VolumeFileRequest\x1a\x1d.docker.v1.VolumeFileContents\"\x03\x90\x02\x01\x12H\n" +
+	"\x0fVolumeWriteFile\x12!.docker.v1.VolumeWriteFileRequest\x1a\x10.docker.v1.Empty\"\x00\x12J\n" +
+	"\x10VolumeDeleteFile\x12\".docker.v1.VolumeDeleteFileRequest\x1a\x10.docker.v1.Empty\"\x00\x12S\n" +
+	"\vNetworkList\x12\x1e.docker.v1.ListNetworksRequest\x1a\x1f.docker.v1.ListNetworksResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\rNetworkCreate\x12\x1f.docker.v1.CreateNetworkRequest\x1a .docker.v1.CreateNetworkResponse\"\x00\x12T\n" +
-	"\rNetworkDelete\x12\x1f.docker.v1.DeleteNetworkRequest\x1a .docker.v1.DeleteNetworkResponse\"\x00B\x8f\x01\n" +
+	"\rNetworkDelete\x12\x1f.docker.v1.DeleteNetworkRequest\x1a .docker.v1.DeleteNetworkResponse\"\x00\x12L\n" +
+	"\x0fNetworkTopology\x12\x10.docker.v1.Empty\x1a\".docker.v1.NetworkTopologyResponse\"\x03\x90\x02\x01\x12F\n" +
+	"\x0eNetworkConnect\x12 .docker.v1.NetworkConnectRequest\x1a\x10.docker.v1.Empty\"\x00\x12L\n" +
+	"\x11NetworkDisconnect\x12#.docker.v1.NetworkDisconnectRequest\x1a\x10.docker.v1.Empty\"\x00\x12A\n" +
+	"\x06Events\x12\x18.docker.v1.EventsRequest\x1a\x16.docker.v1.DockerEvent\"\x03\x90\x02\x010\x01B\x8f\x01\n" +
 	"\rcom.docker.v1B\vDockerProtoP\x01Z,github.com/RA341/dockman/generated/docker/v1\xa2\x02\x03DXX\xaa\x02\tDocker.V1\xca\x02\tDocker\\V1\xe2\x02\x15Docker\\V1\\GPBMetadata\xea\x02\n" +
 	"Docker::V1b\x06proto3"
 
@@ -2479,121 +6374,259 @@ func file_docker_v1_docker_proto_rawDescGZIP() []byte {
 }
 
 var file_docker_v1_docker_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_docker_v1_docker_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_docker_v1_docker_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_docker_v1_docker_proto_goTypes = []any{
-	(SORT_FIELD)(0),                 // 0: docker.v1.SORT_FIELD
-	(ORDER)(0),                      // 1: docker.v1.ORDER
-	(*ComposeValidateResponse)(nil), // 2: docker.v1.ComposeValidateResponse
-	(*ContainerExecCmdInput)(nil),   // 3: docker.v1.ContainerExecCmdInput
-	(*ContainerExecRequest)(nil),    // 4: docker.v1.ContainerExecRequest
-	(*Image)(nil),                   // 5: docker.v1.Image
-	(*ManifestSummary)(nil),         // 6: docker.v1.ManifestSummary
-	(*ListImagesRequest)(nil),       // 7: docker.v1.ListImagesRequest
-	(*ListImagesResponse)(nil),      // 8: docker.v1.ListImagesResponse
-	(*RemoveImageRequest)(nil),      // 9: docker.v1.RemoveImageRequest
-	(*RemoveImageResponse)(nil),     // 10: docker.v1.RemoveImageResponse
-	(*ImagePruneResponse)(nil),      // 11: docker.v1.ImagePruneResponse
-	(*ImagePruneRequest)(nil),       // 12: docker.v1.ImagePruneRequest
-	(*ImagesDeleted)(nil),           // 13: docker.v1.ImagesDeleted
-	(*Volume)(nil),                  // 14: docker.v1.Volume
-	(*ListVolumesRequest)(nil),      // 15: docker.v1.ListVolumesRequest
-	(*ListVolumesResponse)(nil),     // 16: docker.v1.ListVolumesResponse
-	(*CreateVolumeRequest)(nil),     // 17: docker.v1.CreateVolumeRequest
-	(*CreateVolumeResponse)(nil),    // 18: docker.v1.CreateVolumeResponse
-	(*DeleteVolumeRequest)(nil),     // 19: docker.v1.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),    // 20: docker.v1.DeleteVolumeResponse
-	(*Network)(nil),                 // 21: docker.v1.Network
-	(*ListNetworksRequest)(nil),     // 22: docker.v1.ListNetworksRequest
-	(*ListNetworksResponse)(nil),    // 23: docker.v1.ListNetworksResponse
-	(*CreateNetworkRequest)(nil),    // 24: docker.v1.CreateNetworkRequest
-	(*CreateNetworkResponse)(nil),   // 25: docker.v1.CreateNetworkResponse
-	(*DeleteNetworkRequest)(nil),    // 26: docker.v1.DeleteNetworkRequest
-	(*DeleteNetworkResponse)(nil),   // 27: docker.v1.DeleteNetworkResponse
-	(*ContainerLogsRequest)(nil),    // 28: docker.v1.ContainerLogsRequest
-	(*LogsMessage)(nil),             // 29: docker.v1.LogsMessage
-	(*StatsResponse)(nil),           // 30: docker.v1.StatsResponse
-	(*StatsRequest)(nil),            // 31: docker.v1.StatsRequest
-	(*SystemInfo)(nil),              // 32: docker.v1.SystemInfo
-	(*ListResponse)(nil),            // 33: docker.v1.ListResponse
-	(*ContainerList)(nil),           // 34: docker.v1.ContainerList
-	(*ContainerStats)(nil),          // 35: docker.v1.ContainerStats
-	(*Port)(nil),                    // 36: docker.v1.Port
-	(*Empty)(nil),                   // 37: docker.v1.Empty
-	(*ContainerRequest)(nil),        // 38: docker.v1.ContainerRequest
-	(*ComposeFile)(nil),             // 39: docker.v1.ComposeFile
-	nil,                             // 40: docker.v1.Image.LabelsEntry
+	(SORT_FIELD)(0),                    // 0: docker.v1.SORT_FIELD
+	(ORDER)(0),                         // 1: docker.v1.ORDER
+	(*EventsRequest)(nil),              // 2: docker.v1.EventsRequest
+	(*DockerEvent)(nil),                // 3: docker.v1.DockerEvent
+	(*ComposeOverviewResponse)(nil),    // 4: docker.v1.ComposeOverviewResponse
+	(*StackStatus)(nil),                // 5: docker.v1.StackStatus
+	(*ComposeDriftResponse)(nil),       // 6: docker.v1.ComposeDriftResponse
+	(*ServiceDrift)(nil),               // 7: docker.v1.ServiceDrift
+	(*FieldDiff)(nil),                  // 8: docker.v1.FieldDiff
+	(*ComposePlanResponse)(nil),        // 9: docker.v1.ComposePlanResponse
+	(*PlannedContainer)(nil),           // 10: docker.v1.PlannedContainer
+	(*ComposeConfigResponse)(nil),      // 11: docker.v1.ComposeConfigResponse
+	(*ConfigVariable)(nil),             // 12: docker.v1.ConfigVariable
+	(*StackGraphResponse)(nil),         // 13: docker.v1.StackGraphResponse
+	(*GraphNode)(nil),                  // 14: docker.v1.GraphNode
+	(*GraphEdge)(nil),                  // 15: docker.v1.GraphEdge
+	(*ComposeValidateResponse)(nil),    // 16: docker.v1.ComposeValidateResponse
+	(*ValidationFinding)(nil),          // 17: docker.v1.ValidationFinding
+	(*ContainerExecCmdInput)(nil),      // 18: docker.v1.ContainerExecCmdInput
+	(*ContainerExecRequest)(nil),       // 19: docker.v1.ContainerExecRequest
+	(*Image)(nil),                      // 20: docker.v1.Image
+	(*ManifestSummary)(nil),            // 21: docker.v1.ManifestSummary
+	(*ListImagesRequest)(nil),          // 22: docker.v1.ListImagesRequest
+	(*ListImagesResponse)(nil),         // 23: docker.v1.ListImagesResponse
+	(*RemoveImageRequest)(nil),         // 24: docker.v1.RemoveImageRequest
+	(*RemoveImageResponse)(nil),        // 25: docker.v1.RemoveImageResponse
+	(*ImagePruneResponse)(nil),         // 26: docker.v1.ImagePruneResponse
+	(*ImagePruneRequest)(nil),          // 27: docker.v1.ImagePruneRequest
+	(*DiskUsageRequest)(nil),           // 28: docker.v1.DiskUsageRequest
+	(*DiskUsageResponse)(nil),          // 29: docker.v1.DiskUsageResponse
+	(*UsageCategory)(nil),              // 30: docker.v1.UsageCategory
+	(*UsageItem)(nil),                  // 31: docker.v1.UsageItem
+	(*PruneRequest)(nil),               // 32: docker.v1.PruneRequest
+	(*PruneResponse)(nil),              // 33: docker.v1.PruneResponse
+	(*ImagesDeleted)(nil),              // 34: docker.v1.ImagesDeleted
+	(*Volume)(nil),                     // 35: docker.v1.Volume
+	(*ListVolumesRequest)(nil),         // 36: docker.v1.ListVolumesRequest
+	(*VolumeListFilesRequest)(nil),     // 37: docker.v1.VolumeListFilesRequest
+	(*VolumeListFilesResponse)(nil),    // 38: docker.v1.VolumeListFilesResponse
+	(*FileEntry)(nil),                  // 39: docker.v1.FileEntry
+	(*VolumeFileRequest)(nil),          // 40: docker.v1.VolumeFileRequest
+	(*VolumeFileContents)(nil),         // 41: docker.v1.VolumeFileContents
+	(*VolumeWriteFileRequest)(nil),     // 42: docker.v1.VolumeWriteFileRequest
+	(*VolumeDeleteFileRequest)(nil),    // 43: docker.v1.VolumeDeleteFileRequest
+	(*ListVolumesResponse)(nil),        // 44: docker.v1.ListVolumesResponse
+	(*CreateVolumeRequest)(nil),        // 45: docker.v1.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),       // 46: docker.v1.CreateVolumeResponse
+	(*DeleteVolumeRequest)(nil),        // 47: docker.v1.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),       // 48: docker.v1.DeleteVolumeResponse
+	(*Network)(nil),                    // 49: docker.v1.Network
+	(*ListNetworksRequest)(nil),        // 50: docker.v1.ListNetworksRequest
+	(*ListNetworksResponse)(nil),       // 51: docker.v1.ListNetworksResponse
+	(*CreateNetworkRequest)(nil),       // 52: docker.v1.CreateNetworkRequest
+	(*CreateNetworkResponse)(nil),      // 53: docker.v1.CreateNetworkResponse
+	(*DeleteNetworkRequest)(nil),       // 54: docker.v1.DeleteNetworkRequest
+	(*DeleteNetworkResponse)(nil),      // 55: docker.v1.DeleteNetworkResponse
+	(*NetworkTopologyResponse)(nil),    // 56: docker.v1.NetworkTopologyResponse
+	(*NetworkNode)(nil),                // 57: docker.v1.NetworkNode
+	(*NetworkEndpoint)(nil),            // 58: docker.v1.NetworkEndpoint
+	(*NetworkConnectRequest)(nil),      // 59: docker.v1.NetworkConnectRequest
+	(*NetworkDisconnectRequest)(nil),   // 60: docker.v1.NetworkDisconnectRequest
+	(*ContainerLogsRequest)(nil),       // 61: docker.v1.ContainerLogsRequest
+	(*LogsMessage)(nil),                // 62: docker.v1.LogsMessage
+	(*ImageBuildRequest)(nil),          // 63: docker.v1.ImageBuildRequest
+	(*ImagePushRequest)(nil),           // 64: docker.v1.ImagePushRequest
+	(*ImageSaveRequest)(nil),           // 65: docker.v1.ImageSaveRequest
+	(*ImageTransferRequest)(nil),       // 66: docker.v1.ImageTransferRequest
+	(*ImageProgress)(nil),              // 67: docker.v1.ImageProgress
+	(*ContainerListFilesRequest)(nil),  // 68: docker.v1.ContainerListFilesRequest
+	(*ContainerListFilesResponse)(nil), // 69: docker.v1.ContainerListFilesResponse
+	(*ContainerFileRequest)(nil),       // 70: docker.v1.ContainerFileRequest
+	(*ArchiveChunk)(nil),               // 71: docker.v1.ArchiveChunk
+	(*ContainerUploadRequest)(nil),     // 72: docker.v1.ContainerUploadRequest
+	(*StatsResponse)(nil),              // 73: docker.v1.StatsResponse
+	(*StatsRequest)(nil),               // 74: docker.v1.StatsRequest
+	(*SystemInfo)(nil),                 // 75: docker.v1.SystemInfo
+	(*ListResponse)(nil),               // 76: docker.v1.ListResponse
+	(*ContainerList)(nil),              // 77: docker.v1.ContainerList
+	(*ContainerStats)(nil),             // 78: docker.v1.ContainerStats
+	(*Port)(nil),                       // 79: docker.v1.Port
+	(*Empty)(nil),                      // 80: docker.v1.Empty
+	(*ContainerRequest)(nil),           // 81: docker.v1.ContainerRequest
+	(*ContainerImportRequest)(nil),     // 82: docker.v1.ContainerImportRequest
+	(*ContainerImportResponse)(nil),    // 83: docker.v1.ContainerImportResponse
+	(*BulkComposeRequest)(nil),         // 84: docker.v1.BulkComposeRequest
+	(*BulkTarget)(nil),                 // 85: docker.v1.BulkTarget
+	(*BulkProgress)(nil),               // 86: docker.v1.BulkProgress
+	(*ComposeBuildRequest)(nil),        // 87: docker.v1.ComposeBuildRequest
+	(*ComposeFile)(nil),                // 88: docker.v1.ComposeFile
+	nil,                                // 89: docker.v1.DockerEvent.AttributesEntry
+	nil,                                // 90: docker.v1.Image.LabelsEntry
+	nil,                                // 91: docker.v1.CreateVolumeRequest.DriverOptsEntry
+	nil,                                // 92: docker.v1.CreateVolumeRequest.LabelsEntry
+	nil,                                // 93: docker.v1.CreateNetworkRequest.OptionsEntry
+	nil,                                // 94: docker.v1.CreateNetworkRequest.LabelsEntry
+	nil,                                // 95: docker.v1.ImageBuildRequest.BuildArgsEntry
 }
 var file_docker_v1_docker_proto_depIdxs = []int32{
-	40, // 0: docker.v1.Image.labels:type_name -> docker.v1.Image.LabelsEntry
-	6,  // 1: docker.v1.Image.manifests:type_name -> docker.v1.ManifestSummary
-	5,  // 2: docker.v1.ListImagesResponse.images:type_name -> docker.v1.Image
-	13, // 3: docker.v1.ImagePruneResponse.deleted:type_name -> docker.v1.ImagesDeleted
-	14, // 4: docker.v1.ListVolumesResponse.volumes:type_name -> docker.v1.Volume
-	21, // 5: docker.v1.ListNetworksResponse.networks:type_name -> docker.v1.Network
-	32, // 6: docker.v1.StatsResponse.system:type_name -> docker.v1.SystemInfo
-	35, // 7: docker.v1.StatsResponse.containers:type_name -> docker.v1.ContainerStats
-	39, // 8: docker.v1.StatsRequest.file:type_name -> docker.v1.ComposeFile
-	0,  // 9: docker.v1.StatsRequest.sortBy:type_name -> docker.v1.SORT_FIELD
-	1,  // 10: docker.v1.StatsRequest.order:type_name -> docker.v1.ORDER
-	34, // 11: docker.v1.ListResponse.list:type_name -> docker.v1.ContainerList
-	36, // 12: docker.v1.ContainerList.ports:type_name -> docker.v1.Port
-	38, // 13: docker.v1.DockerService.ContainerStart:input_type -> docker.v1.ContainerRequest
-	38, // 14: docker.v1.DockerService.ContainerStop:input_type -> docker.v1.ContainerRequest
-	38, // 15: docker.v1.DockerService.ContainerRemove:input_type -> docker.v1.ContainerRequest
-	38, // 16: docker.v1.DockerService.ContainerRestart:input_type -> docker.v1.ContainerRequest
-	38, // 17: docker.v1.DockerService.ContainerUpdate:input_type -> docker.v1.ContainerRequest
-	37, // 18: docker.v1.DockerService.ContainerList:input_type -> docker.v1.Empty
-	31, // 19: docker.v1.DockerService.ContainerStats:input_type -> docker.v1.StatsRequest
-	28, // 20: docker.v1.DockerService.ContainerLogs:input_type -> docker.v1.ContainerLogsRequest
-	4,  // 21: docker.v1.DockerService.ContainerExecOutput:input_type -> docker.v1.ContainerExecRequest
-	3,  // 22: docker.v1.DockerService.ContainerExecInput:input_type -> docker.v1.ContainerExecCmdInput
-	39, // 23: docker.v1.DockerService.ComposeStart:input_type -> docker.v1.ComposeFile
-	39, // 24: docker.v1.DockerService.ComposeStop:input_type -> docker.v1.ComposeFile
-	39, // 25: docker.v1.DockerService.ComposeRemove:input_type -> docker.v1.ComposeFile
-	39, // 26: docker.v1.DockerService.ComposeRestart:input_type -> docker.v1.ComposeFile
-	39, // 27: docker.v1.DockerService.ComposeUpdate:input_type -> docker.v1.ComposeFile
-	39, // 28: docker.v1.DockerService.ComposeList:input_type -> docker.v1.ComposeFile
-	39, // 29: docker.v1.DockerService.ComposeValidate:input_type -> docker.v1.ComposeFile
-	7,  // 30: docker.v1.DockerService.ImageList:input_type -> docker.v1.ListImagesRequest
-	9,  // 31: docker.v1.DockerService.ImageRemove:input_type -> docker.v1.RemoveImageRequest
-	12, // 32: docker.v1.DockerService.ImagePruneUnused:input_type -> docker.v1.ImagePruneRequest
-	15, // 33: docker.v1.DockerService.VolumeList:input_type -> docker.v1.ListVolumesRequest
-	17, // 34: docker.v1.DockerService.VolumeCreate:input_type -> docker.v1.CreateVolumeRequest
-	19, // 35: docker.v1.DockerService.VolumeDelete:input_type -> docker.v1.DeleteVolumeRequest
-	22, // 36: docker.v1.DockerService.NetworkList:input_type -> docker.v1.ListNetworksRequest
-	24, // 37: docker.v1.DockerService.NetworkCreate:input_type -> docker.v1.CreateNetworkRequest
-	26, // 38: docker.v1.DockerService.NetworkDelete:input_type -> docker.v1.DeleteNetworkRequest
-	29, // 39: docker.v1.DockerService.ContainerStart:output_type -> docker.v1.LogsMessage
-	29, // 40: docker.v1.DockerService.ContainerStop:output_type -> docker.v1.LogsMessage
-	29, // 41: docker.v1.DockerService.ContainerRemove:output_type -> docker.v1.LogsMessage
-	29, // 42: docker.v1.DockerService.ContainerRestart:output_type -> docker.v1.LogsMessage
-	37, // 43: docker.v1.DockerService.ContainerUpdate:output_type -> docker.v1.Empty
-	33, // 44: docker.v1.DockerService.ContainerList:output_type -> docker.v1.ListResponse
-	30, // 45: docker.v1.DockerService.ContainerStats:output_type -> docker.v1.StatsResponse
-	29, // 46: docker.v1.DockerService.ContainerLogs:output_type -> docker.v1.LogsMessage
-	29, // 47: docker.v1.DockerService.ContainerExecOutput:output_type -> docker.v1.LogsMessage
-	37, // 48: docker.v1.DockerService.ContainerExecInput:output_type -> docker.v1.Empty
-	29, // 49: docker.v1.DockerService.ComposeStart:output_type -> docker.v1.LogsMessage
-	29, // 50: docker.v1.DockerService.ComposeStop:output_type -> docker.v1.LogsMessage
-	29, // 51: docker.v1.DockerService.ComposeRemove:output_type -> docker.v1.LogsMessage
-	29, // 52: docker.v1.DockerService.ComposeRestart:output_type -> docker.v1.LogsMessage
-	29, // 53: docker.v1.DockerService.ComposeUpdate:output_type -> docker.v1.LogsMessage
-	33, // 54: docker.v1.DockerService.ComposeList:output_type -> docker.v1.ListResponse
-	2,  // 55: docker.v1.DockerService.ComposeValidate:output_type -> docker.v1.ComposeValidateResponse
-	8,  // 56: docker.v1.DockerService.ImageList:output_type -> docker.v1.ListImagesResponse
-	10, // 57: docker.v1.DockerService.ImageRemove:output_type -> docker.v1.RemoveImageResponse
-	11, // 58: docker.v1.DockerService.ImagePruneUnused:output_type -> docker.v1.ImagePruneResponse
-	16, // 59: docker.v1.DockerService.VolumeList:output_type -> docker.v1.ListVolumesResponse
-	18, // 60: docker.v1.DockerService.VolumeCreate:output_type -> docker.v1.CreateVolumeResponse
-	20, // 61: docker.v1.DockerService.VolumeDelete:output_type -> docker.v1.DeleteVolumeResponse
-	23, // 62: docker.v1.DockerService.NetworkList:output_type -> docker.v1.ListNetworksResponse
-	25, // 63: docker.v1.DockerService.NetworkCreate:output_type -> docker.v1.CreateNetworkResponse
-	27, // 64: docker.v1.DockerService.NetworkDelete:output_type -> docker.v1.DeleteNetworkResponse
-	39, // [39:65] is the sub-list for method output_type
-	13, // [13:39] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	89, // 0: docker.v1.DockerEvent.attributes:type_name -> docker.v1.DockerEvent.AttributesEntry
+	5,  // 1: docker.v1.ComposeOverviewResponse.stacks:type_name -> docker.v1.StackStatus
+	7,  // 2: docker.v1.ComposeDriftResponse.services:type_name -> docker.v1.ServiceDrift
+	8,  // 3: docker.v1.ServiceDrift.diffs:type_name -> docker.v1.FieldDiff
+	10, // 4: docker.v1.ComposePlanResponse.containers:type_name -> docker.v1.PlannedContainer
+	12, // 5: docker.v1.ComposeConfigResponse.variables:type_name -> docker.v1.ConfigVariable
+	14, // 6: docker.v1.StackGraphResponse.nodes:type_name -> docker.v1.GraphNode
+	15, // 7: docker.v1.StackGraphResponse.edges:type_name -> docker.v1.GraphEdge
+	17, // 8: docker.v1.ComposeValidateResponse.findings:type_name -> docker.v1.ValidationFinding
+	90, // 9: docker.v1.Image.labels:type_name -> docker.v1.Image.LabelsEntry
+	21, // 10: docker.v1.Image.manifests:type_name -> docker.v1.ManifestSummary
+	20, // 11: docker.v1.ListImagesResponse.images:type_name -> docker.v1.Image
+	34, // 12: docker.v1.ImagePruneResponse.deleted:type_name -> docker.v1.ImagesDeleted
+	30, // 13: docker.v1.DiskUsageResponse.images:type_name -> docker.v1.UsageCategory
+	30, // 14: docker.v1.DiskUsageResponse.containers:type_name -> docker.v1.UsageCategory
+	30, // 15: docker.v1.DiskUsageResponse.volumes:type_name -> docker.v1.UsageCategory
+	30, // 16: docker.v1.DiskUsageResponse.buildCache:type_name -> docker.v1.UsageCategory
+	31, // 17: docker.v1.UsageCategory.top:type_name -> docker.v1.UsageItem
+	39, // 18: docker.v1.VolumeListFilesResponse.files:type_name -> docker.v1.FileEntry
+	35, // 19: docker.v1.ListVolumesResponse.volumes:type_name -> docker.v1.Volume
+	91, // 20: docker.v1.CreateVolumeRequest.driverOpts:type_name -> docker.v1.CreateVolumeRequest.DriverOptsEntry
+	92, // 21: docker.v1.CreateVolumeRequest.labels:type_name -> docker.v1.CreateVolumeRequest.LabelsEntry
+	35, // 22: docker.v1.CreateVolumeResponse.volume:type_name -> docker.v1.Volume
+	49, // 23: docker.v1.ListNetworksResponse.networks:type_name -> docker.v1.Network
+	93, // 24: docker.v1.CreateNetworkRequest.options:type_name -> docker.v1.CreateNetworkRequest.OptionsEntry
+	94, // 25: docker.v1.CreateNetworkRequest.labels:type_name -> docker.v1.CreateNetworkRequest.LabelsEntry
+	49, // 26: docker.v1.CreateNetworkResponse.network:type_name -> docker.v1.Network
+	57, // 27: docker.v1.NetworkTopologyResponse.networks:type_name -> docker.v1.NetworkNode
+	49, // 28: docker.v1.NetworkNode.network:type_name -> docker.v1.Network
+	58, // 29: docker.v1.NetworkNode.endpoints:type_name -> docker.v1.NetworkEndpoint
+	95, // 30: docker.v1.ImageBuildRequest.buildArgs:type_name -> docker.v1.ImageBuildRequest.BuildArgsEntry
+	39, // 31: docker.v1.ContainerListFilesResponse.files:type_name -> docker.v1.FileEntry
+	75, // 32: docker.v1.StatsResponse.system:type_name -> docker.v1.SystemInfo
+	78, // 33: docker.v1.StatsResponse.containers:type_name -> docker.v1.ContainerStats
+	88, // 34: docker.v1.StatsRequest.file:type_name -> docker.v1.ComposeFile
+	0,  // 35: docker.v1.StatsRequest.sortBy:type_name -> docker.v1.SORT_FIELD
+	1,  // 36: docker.v1.StatsRequest.order:type_name -> docker.v1.ORDER
+	77, // 37: docker.v1.ListResponse.list:type_name -> docker.v1.ContainerList
+	79, // 38: docker.v1.ContainerList.ports:type_name -> docker.v1.Port
+	85, // 39: docker.v1.BulkComposeRequest.targets:type_name -> docker.v1.BulkTarget
+	88, // 40: docker.v1.BulkTarget.file:type_name -> docker.v1.ComposeFile
+	88, // 41: docker.v1.ComposeBuildRequest.file:type_name -> docker.v1.ComposeFile
+	81, // 42: docker.v1.DockerService.ContainerStart:input_type -> docker.v1.ContainerRequest
+	81, // 43: docker.v1.DockerService.ContainerStop:input_type -> docker.v1.ContainerRequest
+	81, // 44: docker.v1.DockerService.ContainerRemove:input_type -> docker.v1.ContainerRequest
+	81, // 45: docker.v1.DockerService.ContainerRestart:input_type -> docker.v1.ContainerRequest
+	81, // 46: docker.v1.DockerService.ContainerUpdate:input_type -> docker.v1.ContainerRequest
+	82, // 47: docker.v1.DockerService.ContainerImport:input_type -> docker.v1.ContainerImportRequest
+	80, // 48: docker.v1.DockerService.ContainerList:input_type -> docker.v1.Empty
+	74, // 49: docker.v1.DockerService.ContainerStats:input_type -> docker.v1.StatsRequest
+	61, // 50: docker.v1.DockerService.ContainerLogs:input_type -> docker.v1.ContainerLogsRequest
+	19, // 51: docker.v1.DockerService.ContainerExecOutput:input_type -> docker.v1.ContainerExecRequest
+	18, // 52: docker.v1.DockerService.ContainerExecInput:input_type -> docker.v1.ContainerExecCmdInput
+	68, // 53: docker.v1.DockerService.ContainerListFiles:input_type -> docker.v1.ContainerListFilesRequest
+	70, // 54: docker.v1.DockerService.ContainerDownload:input_type -> docker.v1.ContainerFileRequest
+	72, // 55: docker.v1.DockerService.ContainerUpload:input_type -> docker.v1.ContainerUploadRequest
+	88, // 56: docker.v1.DockerService.ComposeStart:input_type -> docker.v1.ComposeFile
+	88, // 57: docker.v1.DockerService.ComposeStop:input_type -> docker.v1.ComposeFile
+	88, // 58: docker.v1.DockerService.ComposeRemove:input_type -> docker.v1.ComposeFile
+	88, // 59: docker.v1.DockerService.ComposeRestart:input_type -> docker.v1.ComposeFile
+	87, // 60: docker.v1.DockerService.ComposeBuild:input_type -> docker.v1.ComposeBuildRequest
+	88, // 61: docker.v1.DockerService.ComposeUpdate:input_type -> docker.v1.ComposeFile
+	88, // 62: docker.v1.DockerService.ComposeList:input_type -> docker.v1.ComposeFile
+	88, // 63: docker.v1.DockerService.ComposeValidate:input_type -> docker.v1.ComposeFile
+	80, // 64: docker.v1.DockerService.ComposeOverview:input_type -> docker.v1.Empty
+	88, // 65: docker.v1.DockerService.ComposeDrift:input_type -> docker.v1.ComposeFile
+	88, // 66: docker.v1.DockerService.ComposePlan:input_type -> docker.v1.ComposeFile
+	88, // 67: docker.v1.DockerService.ComposeConfig:input_type -> docker.v1.ComposeFile
+	80, // 68: docker.v1.DockerService.StackGraph:input_type -> docker.v1.Empty
+	80, // 69: docker.v1.DockerService.ComposeStartAll:input_type -> docker.v1.Empty
+	80, // 70: docker.v1.DockerService.ComposeStopAll:input_type -> docker.v1.Empty
+	84, // 71: docker.v1.DockerService.ComposeBulk:input_type -> docker.v1.BulkComposeRequest
+	22, // 72: docker.v1.DockerService.ImageList:input_type -> docker.v1.ListImagesRequest
+	24, // 73: docker.v1.DockerService.ImageRemove:input_type -> docker.v1.RemoveImageRequest
+	27, // 74: docker.v1.DockerService.ImagePruneUnused:input_type -> docker.v1.ImagePruneRequest
+	28, // 75: docker.v1.DockerService.DiskUsage:input_type -> docker.v1.DiskUsageRequest
+	32, // 76: docker.v1.DockerService.Prune:input_type -> docker.v1.PruneRequest
+	63, // 77: docker.v1.DockerService.ImageBuild:input_type -> docker.v1.ImageBuildRequest
+	64, // 78: docker.v1.DockerService.ImagePush:input_type -> docker.v1.ImagePushRequest
+	65, // 79: docker.v1.DockerService.ImageSave:input_type -> docker.v1.ImageSaveRequest
+	66, // 80: docker.v1.DockerService.ImageTransfer:input_type -> docker.v1.ImageTransferRequest
+	36, // 81: docker.v1.DockerService.VolumeList:input_type -> docker.v1.ListVolumesRequest
+	45, // 82: docker.v1.DockerService.VolumeCreate:input_type -> docker.v1.CreateVolumeRequest
+	47, // 83: docker.v1.DockerService.VolumeDelete:input_type -> docker.v1.DeleteVolumeRequest
+	37, // 84: docker.v1.DockerService.VolumeListFiles:input_type -> docker.v1.VolumeListFilesRequest
+	40, // 85: docker.v1.DockerService.VolumeReadFile:input_type -> docker.v1.VolumeFileRequest
+	42, // 86: docker.v1.DockerService.VolumeWriteFile:input_type -> docker.v1.VolumeWriteFileRequest
+	43, // 87: docker.v1.DockerService.VolumeDeleteFile:input_type -> docker.v1.VolumeDeleteFileRequest
+	50, // 88: docker.v1.DockerService.NetworkList:input_type -> docker.v1.ListNetworksRequest
+	52, // 89: docker.v1.DockerService.NetworkCreate:input_type -> docker.v1.CreateNetworkRequest
+	54, // 90: docker.v1.DockerService.NetworkDelete:input_type -> docker.v1.DeleteNetworkRequest
+	80, // 91: docker.v1.DockerService.NetworkTopology:input_type -> docker.v1.Empty
+	59, // 92: docker.v1.DockerService.NetworkConnect:input_type -> docker.v1.NetworkConnectRequest
+	60, // 93: docker.v1.DockerService.NetworkDisconnect:input_type -> docker.v1.NetworkDisconnectRequest
+	2,  // 94: docker.v1.DockerService.Events:input_type -> docker.v1.EventsRequest
+	62, // 95: docker.v1.DockerService.ContainerStart:output_type -> docker.v1.LogsMessage
+	62, // 96: docker.v1.DockerService.ContainerStop:output_type -> docker.v1.LogsMessage
+	62, // 97: docker.v1.DockerService.ContainerRemove:output_type -> docker.v1.LogsMessage
+	62, // 98: docker.v1.DockerService.ContainerRestart:output_type -> docker.v1.LogsMessage
+	80, // 99: docker.v1.DockerService.ContainerUpdate:output_type -> docker.v1.Empty
+	83, // 100: docker.v1.DockerService.ContainerImport:output_type -> docker.v1.ContainerImportResponse
+	76, // 101: docker.v1.DockerService.ContainerList:output_type -> docker.v1.ListResponse
+	73, // 102: docker.v1.DockerService.ContainerStats:output_type -> docker.v1.StatsResponse
+	62, // 103: docker.v1.DockerService.ContainerLogs:output_type -> docker.v1.LogsMessage
+	62, // 104: docker.v1.DockerService.ContainerExecOutput:output_type -> docker.v1.LogsMessage
+	80, // 105: docker.v1.DockerService.ContainerExecInput:output_type -> docker.v1.Empty
+	69, // 106: docker.v1.DockerService.ContainerListFiles:output_type -> docker.v1.ContainerListFilesResponse
+	71, // 107: docker.v1.DockerService.ContainerDownload:output_type -> docker.v1.ArchiveChunk
+	80, // 108: docker.v1.DockerService.ContainerUpload:output_type -> docker.v1.Empty
+	62, // 109: docker.v1.DockerService.ComposeStart:output_type -> docker.v1.LogsMessage
+	62, // 110: docker.v1.DockerService.ComposeStop:output_type -> docker.v1.LogsMessage
+	62, // 111: docker.v1.DockerService.ComposeRemove:output_type -> docker.v1.LogsMessage
+	62, // 112: docker.v1.DockerService.ComposeRestart:output_type -> docker.v1.LogsMessage
+	62, // 113: docker.v1.DockerService.ComposeBuild:output_type -> docker.v1.LogsMessage
+	62, // 114: docker.v1.DockerService.ComposeUpdate:output_type -> docker.v1.LogsMessage
+	76, // 115: docker.v1.DockerService.ComposeList:output_type -> docker.v1.ListResponse
+	16, // 116: docker.v1.DockerService.ComposeValidate:output_type -> docker.v1.ComposeValidateResponse
+	4,  // 117: docker.v1.DockerService.ComposeOverview:output_type -> docker.v1.ComposeOverviewResponse
+	6,  // 118: docker.v1.DockerService.ComposeDrift:output_type -> docker.v1.ComposeDriftResponse
+	9,  // 119: docker.v1.DockerService.ComposePlan:output_type -> docker.v1.ComposePlanResponse
+	11, // 120: docker.v1.DockerService.ComposeConfig:output_type -> docker.v1.ComposeConfigResponse
+	13, // 121: docker.v1.DockerService.StackGraph:output_type -> docker.v1.StackGraphResponse
+	62, // 122: docker.v1.DockerService.ComposeStartAll:output_type -> docker.v1.LogsMessage
+	62, // 123: docker.v1.DockerService.ComposeStopAll:output_type -> docker.v1.LogsMessage
+	86, // 124: docker.v1.DockerService.ComposeBulk:output_type -> docker.v1.BulkProgress
+	23, // 125: docker.v1.DockerService.ImageList:output_type -> docker.v1.ListImagesResponse
+	25, // 126: docker.v1.DockerService.ImageRemove:output_type -> docker.v1.RemoveImageResponse
+	26, // 127: docker.v1.DockerService.ImagePruneUnused:output_type -> docker.v1.ImagePruneResponse
+	29, // 128: docker.v1.DockerService.DiskUsage:output_type -> docker.v1.DiskUsageResponse
+	33, // 129: docker.v1.DockerService.Prune:output_type -> docker.v1.PruneResponse
+	67, // 130: docker.v1.DockerService.ImageBuild:output_type -> docker.v1.ImageProgress
+	67, // 131: docker.v1.DockerService.ImagePush:output_type -> docker.v1.ImageProgress
+	71, // 132: docker.v1.DockerService.ImageSave:output_type -> docker.v1.ArchiveChunk
+	67, // 133: docker.v1.DockerService.ImageTransfer:output_type -> docker.v1.ImageProgress
+	44, // 134: docker.v1.DockerService.VolumeList:output_type -> docker.v1.ListVolumesResponse
+	46, // 135: docker.v1.DockerService.VolumeCreate:output_type -> docker.v1.CreateVolumeResponse
+	48, // 136: docker.v1.DockerService.VolumeDelete:output_type -> docker.v1.DeleteVolumeResponse
+	38, // 137: docker.v1.DockerService.VolumeListFiles:output_type -> docker.v1.VolumeListFilesResponse
+	41, // 138: docker.v1.DockerService.VolumeReadFile:output_type -> docker.v1.VolumeFileContents
+	80, // 139: docker.v1.DockerService.VolumeWriteFile:output_type -> docker.v1.Empty
+	80, // 140: docker.v1.DockerService.VolumeDeleteFile:output_type -> docker.v1.Empty
+	51, // 141: docker.v1.DockerService.NetworkList:output_type -> docker.v1.ListNetworksResponse
+	53, // 142: docker.v1.DockerService.NetworkCreate:output_type -> docker.v1.CreateNetworkResponse
+	55, // 143: docker.v1.DockerService.NetworkDelete:output_type -> docker.v1.DeleteNetworkResponse
+	56, // 144: docker.v1.DockerService.NetworkTopology:output_type -> docker.v1.NetworkTopologyResponse
+	80, // 145: docker.v1.DockerService.NetworkConnect:output_type -> docker.v1.Empty
+	80, // 146: docker.v1.DockerService.NetworkDisconnect:output_type -> docker.v1.Empty
+	3,  // 147: docker.v1.DockerService.Events:output_type -> docker.v1.DockerEvent
+	95, // [95:148] is the sub-list for method output_type
+	42, // [42:95] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_docker_v1_docker_proto_init() }
@@ -2607,7 +6640,7 @@ func file_docker_v1_docker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_docker_v1_docker_proto_rawDesc), len(file_docker_v1_docker_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   39,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
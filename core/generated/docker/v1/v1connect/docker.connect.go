@@ -48,6 +48,9 @@ const (
 	// DockerServiceContainerUpdateProcedure is the fully-qualified name of the DockerService's
 	// ContainerUpdate RPC.
 	DockerServiceContainerUpdateProcedure = "/docker.v1.DockerService/ContainerUpdate"
+	// DockerServiceContainerImportProcedure is the fully-qualified name of the DockerService's
+	// ContainerImport RPC.
+	DockerServiceContainerImportProcedure = "/docker.v1.DockerService/ContainerImport"
 	// DockerServiceContainerListProcedure is the fully-qualified name of the DockerService's
 	// ContainerList RPC.
 	DockerServiceContainerListProcedure = "/docker.v1.DockerService/ContainerList"
@@ -63,6 +66,15 @@ const (
 	// DockerServiceContainerExecInputProcedure is the fully-qualified name of the DockerService's
 	// ContainerExecInput RPC.
 	DockerServiceContainerExecInputProcedure = "/docker.v1.DockerService/ContainerExecInput"
+	// DockerServiceContainerListFilesProcedure is the fully-qualified name of the DockerService's
+	// ContainerListFiles RPC.
+	DockerServiceContainerListFilesProcedure = "/docker.v1.DockerService/ContainerListFiles"
+	// DockerServiceContainerDownloadProcedure is the fully-qualified name of the DockerService's
+	// ContainerDownload RPC.
+	DockerServiceContainerDownloadProcedure = "/docker.v1.DockerService/ContainerDownload"
+	// DockerServiceContainerUploadProcedure is the fully-qualified name of the DockerService's
+	// ContainerUpload RPC.
+	DockerServiceContainerUploadProcedure = "/docker.v1.DockerService/ContainerUpload"
 	// DockerServiceComposeStartProcedure is the fully-qualified name of the DockerService's
 	// ComposeStart RPC.
 	DockerServiceComposeStartProcedure = "/docker.v1.DockerService/ComposeStart"
@@ -75,6 +87,9 @@ const (
 	// DockerServiceComposeRestartProcedure is the fully-qualified name of the DockerService's
 	// ComposeRestart RPC.
 	DockerServiceComposeRestartProcedure = "/docker.v1.DockerService/ComposeRestart"
+	// DockerServiceComposeBuildProcedure is the fully-qualified name of the DockerService's
+	// ComposeBuild RPC.
+	DockerServiceComposeBuildProcedure = "/docker.v1.DockerService/ComposeBuild"
 	// DockerServiceComposeUpdateProcedure is the fully-qualified name of the DockerService's
 	// ComposeUpdate RPC.
 	DockerServiceComposeUpdateProcedure = "/docker.v1.DockerService/ComposeUpdate"
@@ -84,6 +99,30 @@ const (
 	// DockerServiceComposeValidateProcedure is the fully-qualified name of the DockerService's
 	// ComposeValidate RPC.
 	DockerServiceComposeValidateProcedure = "/docker.v1.DockerService/ComposeValidate"
+	// DockerServiceComposeOverviewProcedure is the fully-qualified name of the DockerService's
+	// ComposeOverview RPC.
+	DockerServiceComposeOverviewProcedure = "/docker.v1.DockerService/ComposeOverview"
+	// DockerServiceComposeDriftProcedure is the fully-qualified name of the DockerService's
+	// ComposeDrift RPC.
+	DockerServiceComposeDriftProcedure = "/docker.v1.DockerService/ComposeDrift"
+	// DockerServiceComposePlanProcedure is the fully-qualified name of the DockerService's ComposePlan
+	// RPC.
+	DockerServiceComposePlanProcedure = "/docker.v1.DockerService/ComposePlan"
+	// DockerServiceComposeConfigProcedure is the fully-qualified name of the DockerService's
+	// ComposeConfig RPC.
+	DockerServiceComposeConfigProcedure = "/docker.v1.DockerService/ComposeConfig"
+	// DockerServiceStackGraphProcedure is the fully-qualified name of the DockerService's StackGraph
+	// RPC.
+	DockerServiceStackGraphProcedure = "/docker.v1.DockerService/StackGraph"
+	// DockerServiceComposeStartAllProcedure is the fully-qualified name of the DockerService's
+	// ComposeStartAll RPC.
+	DockerServiceComposeStartAllProcedure = "/docker.v1.DockerService/ComposeStartAll"
+	// DockerServiceComposeStopAllProcedure is the fully-qualified name of the DockerService's
+	// ComposeStopAll RPC.
+	DockerServiceComposeStopAllProcedure = "/docker.v1.DockerService/ComposeStopAll"
+	// DockerServiceComposeBulkProcedure is the fully-qualified name of the DockerService's ComposeBulk
+	// RPC.
+	DockerServiceComposeBulkProcedure = "/docker.v1.DockerService/ComposeBulk"
 	// DockerServiceImageListProcedure is the fully-qualified name of the DockerService's ImageList RPC.
 	DockerServiceImageListProcedure = "/docker.v1.DockerService/ImageList"
 	// DockerServiceImageRemoveProcedure is the fully-qualified name of the DockerService's ImageRemove
@@ -92,6 +131,20 @@ const (
 	// DockerServiceImagePruneUnusedProcedure is the fully-qualified name of the DockerService's
 	// ImagePruneUnused RPC.
 	DockerServiceImagePruneUnusedProcedure = "/docker.v1.DockerService/ImagePruneUnused"
+	// DockerServiceDiskUsageProcedure is the fully-qualified name of the DockerService's DiskUsage RPC.
+	DockerServiceDiskUsageProcedure = "/docker.v1.DockerService/DiskUsage"
+	// DockerServicePruneProcedure is the fully-qualified name of the DockerService's Prune RPC.
+	DockerServicePruneProcedure = "/docker.v1.DockerService/Prune"
+	// DockerServiceImageBuildProcedure is the fully-qualified name of the DockerService's ImageBuild
+	// RPC.
+	DockerServiceImageBuildProcedure = "/docker.v1.DockerService/ImageBuild"
+	// DockerServiceImagePushProcedure is the fully-qualified name of the DockerService's ImagePush RPC.
+	DockerServiceImagePushProcedure = "/docker.v1.DockerService/ImagePush"
+	// DockerServiceImageSaveProcedure is the fully-qualified name of the DockerService's ImageSave RPC.
+	DockerServiceImageSaveProcedure = "/docker.v1.DockerService/ImageSave"
+	// DockerServiceImageTransferProcedure is the fully-qualified name of the DockerService's
+	// ImageTransfer RPC.
+	DockerServiceImageTransferProcedure = "/docker.v1.DockerService/ImageTransfer"
 	// DockerServiceVolumeListProcedure is the fully-qualified name of the DockerService's VolumeList
 	// RPC.
 	DockerServiceVolumeListProcedure = "/docker.v1.DockerService/VolumeList"
@@ -101,6 +154,18 @@ const (
 	// DockerServiceVolumeDeleteProcedure is the fully-qualified name of the DockerService's
 	// VolumeDelete RPC.
 	DockerServiceVolumeDeleteProcedure = "/docker.v1.DockerService/VolumeDelete"
+	// DockerServiceVolumeListFilesProcedure is the fully-qualified name of the DockerService's
+	// VolumeListFiles RPC.
+	DockerServiceVolumeListFilesProcedure = "/docker.v1.DockerService/VolumeListFiles"
+	// DockerServiceVolumeReadFileProcedure is the fully-qualified name of the DockerService's
+	// VolumeReadFile RPC.
+	DockerServiceVolumeReadFileProcedure = "/docker.v1.DockerService/VolumeReadFile"
+	// DockerServiceVolumeWriteFileProcedure is the fully-qualified name of the DockerService's
+	// VolumeWriteFile RPC.
+	DockerServiceVolumeWriteFileProcedure = "/docker.v1.DockerService/VolumeWriteFile"
+	// DockerServiceVolumeDeleteFileProcedure is the fully-qualified name of the DockerService's
+	// VolumeDeleteFile RPC.
+	DockerServiceVolumeDeleteFileProcedure = "/docker.v1.DockerService/VolumeDeleteFile"
 	// DockerServiceNetworkListProcedure is the fully-qualified name of the DockerService's NetworkList
 	// RPC.
 	DockerServiceNetworkListProcedure = "/docker.v1.DockerService/NetworkList"
@@ -110,6 +175,17 @@ const (
 	// DockerServiceNetworkDeleteProcedure is the fully-qualified name of the DockerService's
 	// NetworkDelete RPC.
 	DockerServiceNetworkDeleteProcedure = "/docker.v1.DockerService/NetworkDelete"
+	// DockerServiceNetworkTopologyProcedure is the fully-qualified name of the DockerService's
+	// NetworkTopology RPC.
+	DockerServiceNetworkTopologyProcedure = "/docker.v1.DockerService/NetworkTopology"
+	// DockerServiceNetworkConnectProcedure is the fully-qualified name of the DockerService's
+	// NetworkConnect RPC.
+	DockerServiceNetworkConnectProcedure = "/docker.v1.DockerService/NetworkConnect"
+	// DockerServiceNetworkDisconnectProcedure is the fully-qualified name of the DockerService's
+	// NetworkDisconnect RPC.
+	DockerServiceNetworkDisconnectProcedure = "/docker.v1.DockerService/NetworkDisconnect"
+	// DockerServiceEventsProcedure is the fully-qualified name of the DockerService's Events RPC.
+	DockerServiceEventsProcedure = "/docker.v1.DockerService/Events"
 )
 
 // DockerServiceClient is a client for the docker.v1.DockerService service.
@@ -120,6 +196,8 @@ type DockerServiceClient interface {
 	ContainerRemove(context.Context, *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.LogsMessage], error)
 	ContainerRestart(context.Context, *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.LogsMessage], error)
 	ContainerUpdate(context.Context, *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.Empty], error)
+	// generate a compose file from containers not managed by compose
+	ContainerImport(context.Context, *connect.Request[v1.ContainerImportRequest]) (*connect.Response[v1.ContainerImportResponse], error)
 	ContainerList(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListResponse], error)
 	ContainerStats(context.Context, *connect.Request[v1.StatsRequest]) (*connect.Response[v1.StatsResponse], error)
 	ContainerLogs(context.Context, *connect.Request[v1.ContainerLogsRequest]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
@@ -127,26 +205,75 @@ type DockerServiceClient interface {
 	ContainerExecOutput(context.Context, *connect.Request[v1.ContainerExecRequest]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	// pass in the commands with the container ID
 	ContainerExecInput(context.Context, *connect.Request[v1.ContainerExecCmdInput]) (*connect.Response[v1.Empty], error)
+	// container filesystem, running containers are listed with find and stat,
+	// stopped containers and images without them fall back to the archive api
+	ContainerListFiles(context.Context, *connect.Request[v1.ContainerListFilesRequest]) (*connect.Response[v1.ContainerListFilesResponse], error)
+	// streams a file or folder as a tar archive
+	ContainerDownload(context.Context, *connect.Request[v1.ContainerFileRequest]) (*connect.ServerStreamForClient[v1.ArchiveChunk], error)
+	ContainerUpload(context.Context, *connect.Request[v1.ContainerUploadRequest]) (*connect.Response[v1.Empty], error)
 	// compose
 	ComposeStart(context.Context, *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	ComposeStop(context.Context, *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	ComposeRemove(context.Context, *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	ComposeRestart(context.Context, *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
+	ComposeBuild(context.Context, *connect.Request[v1.ComposeBuildRequest]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	ComposeUpdate(context.Context, *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
 	ComposeList(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ListResponse], error)
 	ComposeValidate(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeValidateResponse], error)
+	// status of every compose file in the compose root
+	ComposeOverview(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ComposeOverviewResponse], error)
+	// compare deployed containers with the current compose file
+	ComposeDrift(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeDriftResponse], error)
+	// preview the changes ComposeStart/ComposeUpdate would make without applying them
+	ComposePlan(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposePlanResponse], error)
+	// fully rendered compose file, same as docker compose config
+	ComposeConfig(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeConfigResponse], error)
+	// dependencies between stacks through depends_on, external networks/volumes and shared bind mounts
+	StackGraph(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.StackGraphResponse], error)
+	// start every stack, stacks providing networks and volumes first
+	ComposeStartAll(context.Context, *connect.Request[v1.Empty]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
+	// stop every stack in reverse start order
+	ComposeStopAll(context.Context, *connect.Request[v1.Empty]) (*connect.ServerStreamForClient[v1.LogsMessage], error)
+	// run the same action on many stacks across hosts
+	ComposeBulk(context.Context, *connect.Request[v1.BulkComposeRequest]) (*connect.ServerStreamForClient[v1.BulkProgress], error)
 	// images
 	ImageList(context.Context, *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error)
 	ImageRemove(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error)
+	// superseded by Prune, kept for older clients
 	ImagePruneUnused(context.Context, *connect.Request[v1.ImagePruneRequest]) (*connect.Response[v1.ImagePruneResponse], error)
+	// space used by images, containers, volumes and build cache
+	DiskUsage(context.Context, *connect.Request[v1.DiskUsageRequest]) (*connect.Response[v1.DiskUsageResponse], error)
+	// removes unused objects of the selected types in one call
+	Prune(context.Context, *connect.Request[v1.PruneRequest]) (*connect.Response[v1.PruneResponse], error)
+	// builds from a directory under the compose root, streams the daemon progress
+	ImageBuild(context.Context, *connect.Request[v1.ImageBuildRequest]) (*connect.ServerStreamForClient[v1.ImageProgress], error)
+	ImagePush(context.Context, *connect.Request[v1.ImagePushRequest]) (*connect.ServerStreamForClient[v1.ImageProgress], error)
+	// streams the images as a docker save tar archive,
+	// archives are loaded back with a POST of the raw tar to /api/docker/image/load
+	ImageSave(context.Context, *connect.Request[v1.ImageSaveRequest]) (*connect.ServerStreamForClient[v1.ArchiveChunk], error)
+	// copies images between two connected hosts without a registry
+	ImageTransfer(context.Context, *connect.Request[v1.ImageTransferRequest]) (*connect.ServerStreamForClient[v1.ImageProgress], error)
 	// volumes
 	VolumeList(context.Context, *connect.Request[v1.ListVolumesRequest]) (*connect.Response[v1.ListVolumesResponse], error)
 	VolumeCreate(context.Context, *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error)
 	VolumeDelete(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error)
+	// browse files inside a named volume, paths are relative to the volume root
+	VolumeListFiles(context.Context, *connect.Request[v1.VolumeListFilesRequest]) (*connect.Response[v1.VolumeListFilesResponse], error)
+	VolumeReadFile(context.Context, *connect.Request[v1.VolumeFileRequest]) (*connect.Response[v1.VolumeFileContents], error)
+	VolumeWriteFile(context.Context, *connect.Request[v1.VolumeWriteFileRequest]) (*connect.Response[v1.Empty], error)
+	VolumeDeleteFile(context.Context, *connect.Request[v1.VolumeDeleteFileRequest]) (*connect.Response[v1.Empty], error)
 	// networks
 	NetworkList(context.Context, *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error)
 	NetworkCreate(context.Context, *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error)
 	NetworkDelete(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error)
+	// networks with the containers attached to them
+	NetworkTopology(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.NetworkTopologyResponse], error)
+	NetworkConnect(context.Context, *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.Empty], error)
+	NetworkDisconnect(context.Context, *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.Empty], error)
+	// events
+	// streams daemon events of the active host,
+	// reconnects and replays missed events if the connection to the daemon drops
+	Events(context.Context, *connect.Request[v1.EventsRequest]) (*connect.ServerStreamForClient[v1.DockerEvent], error)
 }
 
 // NewDockerServiceClient constructs a client for the docker.v1.DockerService service. By default,
@@ -190,22 +317,31 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("ContainerUpdate")),
 			connect.WithClientOptions(opts...),
 		),
+		containerImport: connect.NewClient[v1.ContainerImportRequest, v1.ContainerImportResponse](
+			httpClient,
+			baseURL+DockerServiceContainerImportProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ContainerImport")),
+			connect.WithClientOptions(opts...),
+		),
 		containerList: connect.NewClient[v1.Empty, v1.ListResponse](
 			httpClient,
 			baseURL+DockerServiceContainerListProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ContainerList")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		containerStats: connect.NewClient[v1.StatsRequest, v1.StatsResponse](
 			httpClient,
 			baseURL+DockerServiceContainerStatsProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ContainerStats")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		containerLogs: connect.NewClient[v1.ContainerLogsRequest, v1.LogsMessage](
 			httpClient,
 			baseURL+DockerServiceContainerLogsProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ContainerLogs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		containerExecOutput: connect.NewClient[v1.ContainerExecRequest, v1.LogsMessage](
@@ -220,6 +356,26 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("ContainerExecInput")),
 			connect.WithClientOptions(opts...),
 		),
+		containerListFiles: connect.NewClient[v1.ContainerListFilesRequest, v1.ContainerListFilesResponse](
+			httpClient,
+			baseURL+DockerServiceContainerListFilesProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ContainerListFiles")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		containerDownload: connect.NewClient[v1.ContainerFileRequest, v1.ArchiveChunk](
+			httpClient,
+			baseURL+DockerServiceContainerDownloadProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ContainerDownload")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		containerUpload: connect.NewClient[v1.ContainerUploadRequest, v1.Empty](
+			httpClient,
+			baseURL+DockerServiceContainerUploadProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ContainerUpload")),
+			connect.WithClientOptions(opts...),
+		),
 		composeStart: connect.NewClient[v1.ComposeFile, v1.LogsMessage](
 			httpClient,
 			baseURL+DockerServiceComposeStartProcedure,
@@ -244,6 +400,12 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("ComposeRestart")),
 			connect.WithClientOptions(opts...),
 		),
+		composeBuild: connect.NewClient[v1.ComposeBuildRequest, v1.LogsMessage](
+			httpClient,
+			baseURL+DockerServiceComposeBuildProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeBuild")),
+			connect.WithClientOptions(opts...),
+		),
 		composeUpdate: connect.NewClient[v1.ComposeFile, v1.LogsMessage](
 			httpClient,
 			baseURL+DockerServiceComposeUpdateProcedure,
@@ -254,18 +416,74 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			httpClient,
 			baseURL+DockerServiceComposeListProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ComposeList")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		composeValidate: connect.NewClient[v1.ComposeFile, v1.ComposeValidateResponse](
 			httpClient,
 			baseURL+DockerServiceComposeValidateProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ComposeValidate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		composeOverview: connect.NewClient[v1.Empty, v1.ComposeOverviewResponse](
+			httpClient,
+			baseURL+DockerServiceComposeOverviewProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeOverview")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		composeDrift: connect.NewClient[v1.ComposeFile, v1.ComposeDriftResponse](
+			httpClient,
+			baseURL+DockerServiceComposeDriftProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeDrift")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		composePlan: connect.NewClient[v1.ComposeFile, v1.ComposePlanResponse](
+			httpClient,
+			baseURL+DockerServiceComposePlanProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposePlan")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		composeConfig: connect.NewClient[v1.ComposeFile, v1.ComposeConfigResponse](
+			httpClient,
+			baseURL+DockerServiceComposeConfigProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeConfig")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		stackGraph: connect.NewClient[v1.Empty, v1.StackGraphResponse](
+			httpClient,
+			baseURL+DockerServiceStackGraphProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("StackGraph")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		composeStartAll: connect.NewClient[v1.Empty, v1.LogsMessage](
+			httpClient,
+			baseURL+DockerServiceComposeStartAllProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeStartAll")),
+			connect.WithClientOptions(opts...),
+		),
+		composeStopAll: connect.NewClient[v1.Empty, v1.LogsMessage](
+			httpClient,
+			baseURL+DockerServiceComposeStopAllProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeStopAll")),
+			connect.WithClientOptions(opts...),
+		),
+		composeBulk: connect.NewClient[v1.BulkComposeRequest, v1.BulkProgress](
+			httpClient,
+			baseURL+DockerServiceComposeBulkProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ComposeBulk")),
 			connect.WithClientOptions(opts...),
 		),
 		imageList: connect.NewClient[v1.ListImagesRequest, v1.ListImagesResponse](
 			httpClient,
 			baseURL+DockerServiceImageListProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("ImageList")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		imageRemove: connect.NewClient[v1.RemoveImageRequest, v1.RemoveImageResponse](
@@ -280,10 +498,49 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("ImagePruneUnused")),
 			connect.WithClientOptions(opts...),
 		),
+		diskUsage: connect.NewClient[v1.DiskUsageRequest, v1.DiskUsageResponse](
+			httpClient,
+			baseURL+DockerServiceDiskUsageProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("DiskUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		prune: connect.NewClient[v1.PruneRequest, v1.PruneResponse](
+			httpClient,
+			baseURL+DockerServicePruneProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("Prune")),
+			connect.WithClientOptions(opts...),
+		),
+		imageBuild: connect.NewClient[v1.ImageBuildRequest, v1.ImageProgress](
+			httpClient,
+			baseURL+DockerServiceImageBuildProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ImageBuild")),
+			connect.WithClientOptions(opts...),
+		),
+		imagePush: connect.NewClient[v1.ImagePushRequest, v1.ImageProgress](
+			httpClient,
+			baseURL+DockerServiceImagePushProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ImagePush")),
+			connect.WithClientOptions(opts...),
+		),
+		imageSave: connect.NewClient[v1.ImageSaveRequest, v1.ArchiveChunk](
+			httpClient,
+			baseURL+DockerServiceImageSaveProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ImageSave")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		imageTransfer: connect.NewClient[v1.ImageTransferRequest, v1.ImageProgress](
+			httpClient,
+			baseURL+DockerServiceImageTransferProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("ImageTransfer")),
+			connect.WithClientOptions(opts...),
+		),
 		volumeList: connect.NewClient[v1.ListVolumesRequest, v1.ListVolumesResponse](
 			httpClient,
 			baseURL+DockerServiceVolumeListProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("VolumeList")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		volumeCreate: connect.NewClient[v1.CreateVolumeRequest, v1.CreateVolumeResponse](
@@ -298,10 +555,37 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("VolumeDelete")),
 			connect.WithClientOptions(opts...),
 		),
+		volumeListFiles: connect.NewClient[v1.VolumeListFilesRequest, v1.VolumeListFilesResponse](
+			httpClient,
+			baseURL+DockerServiceVolumeListFilesProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeListFiles")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		volumeReadFile: connect.NewClient[v1.VolumeFileRequest, v1.VolumeFileContents](
+			httpClient,
+			baseURL+DockerServiceVolumeReadFileProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeReadFile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		volumeWriteFile: connect.NewClient[v1.VolumeWriteFileRequest, v1.Empty](
+			httpClient,
+			baseURL+DockerServiceVolumeWriteFileProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeWriteFile")),
+			connect.WithClientOptions(opts...),
+		),
+		volumeDeleteFile: connect.NewClient[v1.VolumeDeleteFileRequest, v1.Empty](
+			httpClient,
+			baseURL+DockerServiceVolumeDeleteFileProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("VolumeDeleteFile")),
+			connect.WithClientOptions(opts...),
+		),
 		networkList: connect.NewClient[v1.ListNetworksRequest, v1.ListNetworksResponse](
 			httpClient,
 			baseURL+DockerServiceNetworkListProcedure,
 			connect.WithSchema(dockerServiceMethods.ByName("NetworkList")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		networkCreate: connect.NewClient[v1.CreateNetworkRequest, v1.CreateNetworkResponse](
@@ -316,6 +600,32 @@ func NewDockerServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(dockerServiceMethods.ByName("NetworkDelete")),
 			connect.WithClientOptions(opts...),
 		),
+		networkTopology: connect.NewClient[v1.Empty, v1.NetworkTopologyResponse](
+			httpClient,
+			baseURL+DockerServiceNetworkTopologyProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("NetworkTopology")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		networkConnect: connect.NewClient[v1.NetworkConnectRequest, v1.Empty](
+			httpClient,
+			baseURL+DockerServiceNetworkConnectProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("NetworkConnect")),
+			connect.WithClientOptions(opts...),
+		),
+		networkDisconnect: connect.NewClient[v1.NetworkDisconnectRequest, v1.Empty](
+			httpClient,
+			baseURL+DockerServiceNetworkDisconnectProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("NetworkDisconnect")),
+			connect.WithClientOptions(opts...),
+		),
+		events: connect.NewClient[v1.EventsRequest, v1.DockerEvent](
+			httpClient,
+			baseURL+DockerServiceEventsProcedure,
+			connect.WithSchema(dockerServiceMethods.ByName("Events")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -326,27 +636,54 @@ type dockerServiceClient struct {
 	containerRemove     *connect.Client[v1.ContainerRequest, v1.LogsMessage]
 	containerRestart    *connect.Client[v1.ContainerRequest, v1.LogsMessage]
 	containerUpdate     *connect.Client[v1.ContainerRequest, v1.Empty]
+	containerImport     *connect.Client[v1.ContainerImportRequest, v1.ContainerImportResponse]
 	containerList       *connect.Client[v1.Empty, v1.ListResponse]
 	containerStats      *connect.Client[v1.StatsRequest, v1.StatsResponse]
 	containerLogs       *connect.Client[v1.ContainerLogsRequest, v1.LogsMessage]
 	containerExecOutput *connect.Client[v1.ContainerExecRequest, v1.LogsMessage]
 	containerExecInput  *connect.Client[v1.ContainerExecCmdInput, v1.Empty]
+	containerListFiles  *connect.Client[v1.ContainerListFilesRequest, v1.ContainerListFilesResponse]
+	containerDownload   *connect.Client[v1.ContainerFileRequest, v1.ArchiveChunk]
+	containerUpload     *connect.Client[v1.ContainerUploadRequest, v1.Empty]
 	composeStart        *connect.Client[v1.ComposeFile, v1.LogsMessage]
 	composeStop         *connect.Client[v1.ComposeFile, v1.LogsMessage]
 	composeRemove       *connect.Client[v1.ComposeFile, v1.LogsMessage]
 	composeRestart      *connect.Client[v1.ComposeFile, v1.LogsMessage]
+	composeBuild        *connect.Client[v1.ComposeBuildRequest, v1.LogsMessage]
 	composeUpdate       *connect.Client[v1.ComposeFile, v1.LogsMessage]
 	composeList         *connect.Client[v1.ComposeFile, v1.ListResponse]
 	composeValidate     *connect.Client[v1.ComposeFile, v1.ComposeValidateResponse]
+	composeOverview     *connect.Client[v1.Empty, v1.ComposeOverviewResponse]
+	composeDrift        *connect.Client[v1.ComposeFile, v1.ComposeDriftResponse]
+	composePlan         *connect.Client[v1.ComposeFile, v1.ComposePlanResponse]
+	composeConfig       *connect.Client[v1.ComposeFile, v1.ComposeConfigResponse]
+	stackGraph          *connect.Client[v1.Empty, v1.StackGraphResponse]
+	composeStartAll     *connect.Client[v1.Empty, v1.LogsMessage]
+	composeStopAll      *connect.Client[v1.Empty, v1.LogsMessage]
+	composeBulk         *connect.Client[v1.BulkComposeRequest, v1.BulkProgress]
 	imageList           *connect.Client[v1.ListImagesRequest, v1.ListImagesResponse]
 	imageRemove         *connect.Client[v1.RemoveImageRequest, v1.RemoveImageResponse]
 	imagePruneUnused    *connect.Client[v1.ImagePruneRequest, v1.ImagePruneResponse]
+	diskUsage           *connect.Client[v1.DiskUsageRequest, v1.DiskUsageResponse]
+	prune               *connect.Client[v1.PruneRequest, v1.PruneResponse]
+	imageBuild          *connect.Client[v1.ImageBuildRequest, v1.ImageProgress]
+	imagePush           *connect.Client[v1.ImagePushRequest, v1.ImageProgress]
+	imageSave           *connect.Client[v1.ImageSaveRequest, v1.ArchiveChunk]
+	imageTransfer       *connect.Client[v1.ImageTransferRequest, v1.ImageProgress]
 	volumeList          *connect.Client[v1.ListVolumesRequest, v1.ListVolumesResponse]
 	volumeCreate        *connect.Client[v1.CreateVolumeRequest, v1.CreateVolumeResponse]
 	volumeDelete        *connect.Client[v1.DeleteVolumeRequest, v1.DeleteVolumeResponse]
+	volumeListFiles     *connect.Client[v1.VolumeListFilesRequest, v1.VolumeListFilesResponse]
+	volumeReadFile      *connect.Client[v1.VolumeFileRequest, v1.VolumeFileContents]
+	volumeWriteFile     *connect.Client[v1.VolumeWriteFileRequest, v1.Empty]
+	volumeDeleteFile    *connect.Client[v1.VolumeDeleteFileRequest, v1.Empty]
 	networkList         *connect.Client[v1.ListNetworksRequest, v1.ListNetworksResponse]
 	networkCreate       *connect.Client[v1.CreateNetworkRequest, v1.CreateNetworkResponse]
 	networkDelete       *connect.Client[v1.DeleteNetworkRequest, v1.DeleteNetworkResponse]
+	networkTopology     *connect.Client[v1.Empty, v1.NetworkTopologyResponse]
+	networkConnect      *connect.Client[v1.NetworkConnectRequest, v1.Empty]
+	networkDisconnect   *connect.Client[v1.NetworkDisconnectRequest, v1.Empty]
+	events              *connect.Client[v1.EventsRequest, v1.DockerEvent]
 }
 
 // ContainerStart calls docker.v1.DockerService.ContainerStart.
@@ -374,6 +711,11 @@ func (c *dockerServiceClient) ContainerUpdate(ctx context.Context, req *connect.
 	return c.containerUpdate.CallUnary(ctx, req)
 }
 
+// ContainerImport calls docker.v1.DockerService.ContainerImport.
+func (c *dockerServiceClient) ContainerImport(ctx context.Context, req *connect.Request[v1.ContainerImportRequest]) (*connect.Response[v1.ContainerImportResponse], error) {
+	return c.containerImport.CallUnary(ctx, req)
+}
+
 // ContainerList calls docker.v1.DockerService.ContainerList.
 func (c *dockerServiceClient) ContainerList(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListResponse], error) {
 	return c.containerList.CallUnary(ctx, req)
@@ -399,6 +741,21 @@ func (c *dockerServiceClient) ContainerExecInput(ctx context.Context, req *conne
 	return c.containerExecInput.CallUnary(ctx, req)
 }
 
+// ContainerListFiles calls docker.v1.DockerService.ContainerListFiles.
+func (c *dockerServiceClient) ContainerListFiles(ctx context.Context, req *connect.Request[v1.ContainerListFilesRequest]) (*connect.Response[v1.ContainerListFilesResponse], error) {
+	return c.containerListFiles.CallUnary(ctx, req)
+}
+
+// ContainerDownload calls docker.v1.DockerService.ContainerDownload.
+func (c *dockerServiceClient) ContainerDownload(ctx context.Context, req *connect.Request[v1.ContainerFileRequest]) (*connect.ServerStreamForClient[v1.ArchiveChunk], error) {
+	return c.containerDownload.CallServerStream(ctx, req)
+}
+
+// ContainerUpload calls docker.v1.DockerService.ContainerUpload.
+func (c *dockerServiceClient) ContainerUpload(ctx context.Context, req *connect.Request[v1.ContainerUploadRequest]) (*connect.Response[v1.Empty], error) {
+	return c.containerUpload.CallUnary(ctx, req)
+}
+
 // ComposeStart calls docker.v1.DockerService.ComposeStart.
 func (c *dockerServiceClient) ComposeStart(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error) {
 	return c.composeStart.CallServerStream(ctx, req)
@@ -419,6 +776,11 @@ func (c *dockerServiceClient) ComposeRestart(ctx context.Context, req *connect.R
 	return c.composeRestart.CallServerStream(ctx, req)
 }
 
+// ComposeBuild calls docker.v1.DockerService.ComposeBuild.
+func (c *dockerServiceClient) ComposeBuild(ctx context.Context, req *connect.Request[v1.ComposeBuildRequest]) (*connect.ServerStreamForClient[v1.LogsMessage], error) {
+	return c.composeBuild.CallServerStream(ctx, req)
+}
+
 // ComposeUpdate calls docker.v1.DockerService.ComposeUpdate.
 func (c *dockerServiceClient) ComposeUpdate(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.ServerStreamForClient[v1.LogsMessage], error) {
 	return c.composeUpdate.CallServerStream(ctx, req)
@@ -434,6 +796,46 @@ func (c *dockerServiceClient) ComposeValidate(ctx context.Context, req *connect.
 	return c.composeValidate.CallUnary(ctx, req)
 }
 
+// ComposeOverview calls docker.v1.DockerService.ComposeOverview.
+func (c *dockerServiceClient) ComposeOverview(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ComposeOverviewResponse], error) {
+	return c.composeOverview.CallUnary(ctx, req)
+}
+
+// ComposeDrift calls docker.v1.DockerService.ComposeDrift.
+func (c *dockerServiceClient) ComposeDrift(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeDriftResponse], error) {
+	return c.composeDrift.CallUnary(ctx, req)
+}
+
+// ComposePlan calls docker.v1.DockerService.ComposePlan.
+func (c *dockerServiceClient) ComposePlan(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposePlanResponse], error) {
+	return c.composePlan.CallUnary(ctx, req)
+}
+
+// ComposeConfig calls docker.v1.DockerService.ComposeConfig.
+func (c *dockerServiceClient) ComposeConfig(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeConfigResponse], error) {
+	return c.composeConfig.CallUnary(ctx, req)
+}
+
+// StackGraph calls docker.v1.DockerService.StackGraph.
+func (c *dockerServiceClient) StackGraph(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.StackGraphResponse], error) {
+	return c.stackGraph.CallUnary(ctx, req)
+}
+
+// ComposeStartAll calls docker.v1.DockerService.ComposeStartAll.
+func (c *dockerServiceClient) ComposeStartAll(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.ServerStreamForClient[v1.LogsMessage], error) {
+	return c.composeStartAll.CallServerStream(ctx, req)
+}
+
+// ComposeStopAll calls docker.v1.DockerService.ComposeStopAll.
+func (c *dockerServiceClient) ComposeStopAll(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.ServerStreamForClient[v1.LogsMessage], error) {
+	return c.composeStopAll.CallServerStream(ctx, req)
+}
+
+// ComposeBulk calls docker.v1.DockerService.ComposeBulk.
+func (c *dockerServiceClient) ComposeBulk(ctx context.Context, req *connect.Request[v1.BulkComposeRequest]) (*connect.ServerStreamForClient[v1.BulkProgress], error) {
+	return c.composeBulk.CallServerStream(ctx, req)
+}
+
 // ImageList calls docker.v1.DockerService.ImageList.
 func (c *dockerServiceClient) ImageList(ctx context.Context, req *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error) {
 	return c.imageList.CallUnary(ctx, req)
@@ -449,6 +851,36 @@ func (c *dockerServiceClient) ImagePruneUnused(ctx context.Context, req *connect
 	return c.imagePruneUnused.CallUnary(ctx, req)
 }
 
+// DiskUsage calls docker.v1.DockerService.DiskUsage.
+func (c *dockerServiceClient) DiskUsage(ctx context.Context, req *connect.Request[v1.DiskUsageRequest]) (*connect.Response[v1.DiskUsageResponse], error) {
+	return c.diskUsage.CallUnary(ctx, req)
+}
+
+// Prune calls docker.v1.DockerService.Prune.
+func (c *dockerServiceClient) Prune(ctx context.Context, req *connect.Request[v1.PruneRequest]) (*connect.Response[v1.PruneResponse], error) {
+	return c.prune.CallUnary(ctx, req)
+}
+
+// ImageBuild calls docker.v1.DockerService.ImageBuild.
+func (c *dockerServiceClient) ImageBuild(ctx context.Context, req *connect.Request[v1.ImageBuildRequest]) (*connect.ServerStreamForClient[v1.ImageProgress], error) {
+	return c.imageBuild.CallServerStream(ctx, req)
+}
+
+// ImagePush calls docker.v1.DockerService.ImagePush.
+func (c *dockerServiceClient) ImagePush(ctx context.Context, req *connect.Request[v1.ImagePushRequest]) (*connect.ServerStreamForClient[v1.ImageProgress], error) {
+	return c.imagePush.CallServerStream(ctx, req)
+}
+
+// ImageSave calls docker.v1.DockerService.ImageSave.
+func (c *dockerServiceClient) ImageSave(ctx context.Context, req *connect.Request[v1.ImageSaveRequest]) (*connect.ServerStreamForClient[v1.ArchiveChunk], error) {
+	return c.imageSave.CallServerStream(ctx, req)
+}
+
+// ImageTransfer calls docker.v1.DockerService.ImageTransfer.
+func (c *dockerServiceClient) ImageTransfer(ctx context.Context, req *connect.Request[v1.ImageTransferRequest]) (*connect.ServerStreamForClient[v1.ImageProgress], error) {
+	return c.imageTransfer.CallServerStream(ctx, req)
+}
+
 // VolumeList calls docker.v1.DockerService.VolumeList.
 func (c *dockerServiceClient) VolumeList(ctx context.Context, req *connect.Request[v1.ListVolumesRequest]) (*connect.Response[v1.ListVolumesResponse], error) {
 	return c.volumeList.CallUnary(ctx, req)
@@ -464,6 +896,26 @@ func (c *dockerServiceClient) VolumeDelete(ctx context.Context, req *connect.Req
 	return c.volumeDelete.CallUnary(ctx, req)
 }
 
+// VolumeListFiles calls docker.v1.DockerService.VolumeListFiles.
+func (c *dockerServiceClient) VolumeListFiles(ctx context.Context, req *connect.Request[v1.VolumeListFilesRequest]) (*connect.Response[v1.VolumeListFilesResponse], error) {
+	return c.volumeListFiles.CallUnary(ctx, req)
+}
+
+// VolumeReadFile calls docker.v1.DockerService.VolumeReadFile.
+func (c *dockerServiceClient) VolumeReadFile(ctx context.Context, req *connect.Request[v1.VolumeFileRequest]) (*connect.Response[v1.VolumeFileContents], error) {
+	return c.volumeReadFile.CallUnary(ctx, req)
+}
+
+// VolumeWriteFile calls docker.v1.DockerService.VolumeWriteFile.
+func (c *dockerServiceClient) VolumeWriteFile(ctx context.Context, req *connect.Request[v1.VolumeWriteFileRequest]) (*connect.Response[v1.Empty], error) {
+	return c.volumeWriteFile.CallUnary(ctx, req)
+}
+
+// VolumeDeleteFile calls docker.v1.DockerService.VolumeDeleteFile.
+func (c *dockerServiceClient) VolumeDeleteFile(ctx context.Context, req *connect.Request[v1.VolumeDeleteFileRequest]) (*connect.Response[v1.Empty], error) {
+	return c.volumeDeleteFile.CallUnary(ctx, req)
+}
+
 // NetworkList calls docker.v1.DockerService.NetworkList.
 func (c *dockerServiceClient) NetworkList(ctx context.Context, req *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error) {
 	return c.networkList.CallUnary(ctx, req)
@@ -479,6 +931,26 @@ func (c *dockerServiceClient) NetworkDelete(ctx context.Context, req *connect.Re
 	return c.networkDelete.CallUnary(ctx, req)
 }
 
+// NetworkTopology calls docker.v1.DockerService.NetworkTopology.
+func (c *dockerServiceClient) NetworkTopology(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.NetworkTopologyResponse], error) {
+	return c.networkTopology.CallUnary(ctx, req)
+}
+
+// NetworkConnect calls docker.v1.DockerService.NetworkConnect.
+func (c *dockerServiceClient) NetworkConnect(ctx context.Context, req *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.Empty], error) {
+	return c.networkConnect.CallUnary(ctx, req)
+}
+
+// NetworkDisconnect calls docker.v1.DockerService.NetworkDisconnect.
+func (c *dockerServiceClient) NetworkDisconnect(ctx context.Context, req *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.Empty], error) {
+	return c.networkDisconnect.CallUnary(ctx, req)
+}
+
+// Events calls docker.v1.DockerService.Events.
+func (c *dockerServiceClient) Events(ctx context.Context, req *connect.Request[v1.EventsRequest]) (*connect.ServerStreamForClient[v1.DockerEvent], error) {
+	return c.events.CallServerStream(ctx, req)
+}
+
 // DockerServiceHandler is an implementation of the docker.v1.DockerService service.
 type DockerServiceHandler interface {
 	// container
@@ -487,6 +959,8 @@ type DockerServiceHandler interface {
 	ContainerRemove(context.Context, *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.LogsMessage], error)
 	ContainerRestart(context.Context, *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.LogsMessage], error)
 	ContainerUpdate(context.Context, *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.Empty], error)
+	// generate a compose file from containers not managed by compose
+	ContainerImport(context.Context, *connect.Request[v1.ContainerImportRequest]) (*connect.Response[v1.ContainerImportResponse], error)
 	ContainerList(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListResponse], error)
 	ContainerStats(context.Context, *connect.Request[v1.StatsRequest]) (*connect.Response[v1.StatsResponse], error)
 	ContainerLogs(context.Context, *connect.Request[v1.ContainerLogsRequest], *connect.ServerStream[v1.LogsMessage]) error
@@ -494,26 +968,75 @@ type DockerServiceHandler interface {
 	ContainerExecOutput(context.Context, *connect.Request[v1.ContainerExecRequest], *connect.ServerStream[v1.LogsMessage]) error
 	// pass in the commands with the container ID
 	ContainerExecInput(context.Context, *connect.Request[v1.ContainerExecCmdInput]) (*connect.Response[v1.Empty], error)
+	// container filesystem, running containers are listed with find and stat,
+	// stopped containers and images without them fall back to the archive api
+	ContainerListFiles(context.Context, *connect.Request[v1.ContainerListFilesRequest]) (*connect.Response[v1.ContainerListFilesResponse], error)
+	// streams a file or folder as a tar archive
+	ContainerDownload(context.Context, *connect.Request[v1.ContainerFileRequest], *connect.ServerStream[v1.ArchiveChunk]) error
+	ContainerUpload(context.Context, *connect.Request[v1.ContainerUploadRequest]) (*connect.Response[v1.Empty], error)
 	// compose
 	ComposeStart(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error
 	ComposeStop(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error
 	ComposeRemove(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error
 	ComposeRestart(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error
+	ComposeBuild(context.Context, *connect.Request[v1.ComposeBuildRequest], *connect.ServerStream[v1.LogsMessage]) error
 	ComposeUpdate(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error
 	ComposeList(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ListResponse], error)
 	ComposeValidate(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeValidateResponse], error)
+	// status of every compose file in the compose root
+	ComposeOverview(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ComposeOverviewResponse], error)
+	// compare deployed containers with the current compose file
+	ComposeDrift(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeDriftResponse], error)
+	// preview the changes ComposeStart/ComposeUpdate would make without applying them
+	ComposePlan(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposePlanResponse], error)
+	// fully rendered compose file, same as docker compose config
+	ComposeConfig(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeConfigResponse], error)
+	// dependencies between stacks through depends_on, external networks/volumes and shared bind mounts
+	StackGraph(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.StackGraphResponse], error)
+	// start every stack, stacks providing networks and volumes first
+	ComposeStartAll(context.Context, *connect.Request[v1.Empty], *connect.ServerStream[v1.LogsMessage]) error
+	// stop every stack in reverse start order
+	ComposeStopAll(context.Context, *connect.Request[v1.Empty], *connect.ServerStream[v1.LogsMessage]) error
+	// run the same action on many stacks across hosts
+	ComposeBulk(context.Context, *connect.Request[v1.BulkComposeRequest], *connect.ServerStream[v1.BulkProgress]) error
 	// images
 	ImageList(context.Context, *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error)
 	ImageRemove(context.Context, *connect.Request[v1.RemoveImageRequest]) (*connect.Response[v1.RemoveImageResponse], error)
+	// superseded by Prune, kept for older clients
 	ImagePruneUnused(context.Context, *connect.Request[v1.ImagePruneRequest]) (*connect.Response[v1.ImagePruneResponse], error)
+	// space used by images, containers, volumes and build cache
+	DiskUsage(context.Context, *connect.Request[v1.DiskUsageRequest]) (*connect.Response[v1.DiskUsageResponse], error)
+	// removes unused objects of the selected types in one call
+	Prune(context.Context, *connect.Request[v1.PruneRequest]) (*connect.Response[v1.PruneResponse], error)
+	// builds from a directory under the compose root, streams the daemon progress
+	ImageBuild(context.Context, *connect.Request[v1.ImageBuildRequest], *connect.ServerStream[v1.ImageProgress]) error
+	ImagePush(context.Context, *connect.Request[v1.ImagePushRequest], *connect.ServerStream[v1.ImageProgress]) error
+	// streams the images as a docker save tar archive,
+	// archives are loaded back with a POST of the raw tar to /api/docker/image/load
+	ImageSave(context.Context, *connect.Request[v1.ImageSaveRequest], *connect.ServerStream[v1.ArchiveChunk]) error
+	// copies images between two connected hosts without a registry
+	ImageTransfer(context.Context, *connect.Request[v1.ImageTransferRequest], *connect.ServerStream[v1.ImageProgress]) error
 	// volumes
 	VolumeList(context.Context, *connect.Request[v1.ListVolumesRequest]) (*connect.Response[v1.ListVolumesResponse], error)
 	VolumeCreate(context.Context, *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error)
 	VolumeDelete(context.Context, *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error)
+	// browse files inside a named volume, paths are relative to the volume root
+	VolumeListFiles(context.Context, *connect.Request[v1.VolumeListFilesRequest]) (*connect.Response[v1.VolumeListFilesResponse], error)
+	VolumeReadFile(context.Context, *connect.Request[v1.VolumeFileRequest]) (*connect.Response[v1.VolumeFileContents], error)
+	VolumeWriteFile(context.Context, *connect.Request[v1.VolumeWriteFileRequest]) (*connect.Response[v1.Empty], error)
+	VolumeDeleteFile(context.Context, *connect.Request[v1.VolumeDeleteFileRequest]) (*connect.Response[v1.Empty], error)
 	// networks
 	NetworkList(context.Context, *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error)
 	NetworkCreate(context.Context, *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error)
 	NetworkDelete(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error)
+	// networks with the containers attached to them
+	NetworkTopology(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.NetworkTopologyResponse], error)
+	NetworkConnect(context.Context, *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.Empty], error)
+	NetworkDisconnect(context.Context, *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.Empty], error)
+	// events
+	// streams daemon events of the active host,
+	// reconnects and replays missed events if the connection to the daemon drops
+	Events(context.Context, *connect.Request[v1.EventsRequest], *connect.ServerStream[v1.DockerEvent]) error
 }
 
 // NewDockerServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -553,22 +1076,31 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("ContainerUpdate")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceContainerImportHandler := connect.NewUnaryHandler(
+		DockerServiceContainerImportProcedure,
+		svc.ContainerImport,
+		connect.WithSchema(dockerServiceMethods.ByName("ContainerImport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceContainerListHandler := connect.NewUnaryHandler(
 		DockerServiceContainerListProcedure,
 		svc.ContainerList,
 		connect.WithSchema(dockerServiceMethods.ByName("ContainerList")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceContainerStatsHandler := connect.NewUnaryHandler(
 		DockerServiceContainerStatsProcedure,
 		svc.ContainerStats,
 		connect.WithSchema(dockerServiceMethods.ByName("ContainerStats")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceContainerLogsHandler := connect.NewServerStreamHandler(
 		DockerServiceContainerLogsProcedure,
 		svc.ContainerLogs,
 		connect.WithSchema(dockerServiceMethods.ByName("ContainerLogs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceContainerExecOutputHandler := connect.NewServerStreamHandler(
@@ -583,6 +1115,26 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("ContainerExecInput")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceContainerListFilesHandler := connect.NewUnaryHandler(
+		DockerServiceContainerListFilesProcedure,
+		svc.ContainerListFiles,
+		connect.WithSchema(dockerServiceMethods.ByName("ContainerListFiles")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceContainerDownloadHandler := connect.NewServerStreamHandler(
+		DockerServiceContainerDownloadProcedure,
+		svc.ContainerDownload,
+		connect.WithSchema(dockerServiceMethods.ByName("ContainerDownload")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceContainerUploadHandler := connect.NewUnaryHandler(
+		DockerServiceContainerUploadProcedure,
+		svc.ContainerUpload,
+		connect.WithSchema(dockerServiceMethods.ByName("ContainerUpload")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceComposeStartHandler := connect.NewServerStreamHandler(
 		DockerServiceComposeStartProcedure,
 		svc.ComposeStart,
@@ -607,6 +1159,12 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("ComposeRestart")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceComposeBuildHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeBuildProcedure,
+		svc.ComposeBuild,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeBuild")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceComposeUpdateHandler := connect.NewServerStreamHandler(
 		DockerServiceComposeUpdateProcedure,
 		svc.ComposeUpdate,
@@ -617,18 +1175,74 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		DockerServiceComposeListProcedure,
 		svc.ComposeList,
 		connect.WithSchema(dockerServiceMethods.ByName("ComposeList")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceComposeValidateHandler := connect.NewUnaryHandler(
 		DockerServiceComposeValidateProcedure,
 		svc.ComposeValidate,
 		connect.WithSchema(dockerServiceMethods.ByName("ComposeValidate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeOverviewHandler := connect.NewUnaryHandler(
+		DockerServiceComposeOverviewProcedure,
+		svc.ComposeOverview,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeOverview")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeDriftHandler := connect.NewUnaryHandler(
+		DockerServiceComposeDriftProcedure,
+		svc.ComposeDrift,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeDrift")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposePlanHandler := connect.NewUnaryHandler(
+		DockerServiceComposePlanProcedure,
+		svc.ComposePlan,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposePlan")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeConfigHandler := connect.NewUnaryHandler(
+		DockerServiceComposeConfigProcedure,
+		svc.ComposeConfig,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeConfig")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceStackGraphHandler := connect.NewUnaryHandler(
+		DockerServiceStackGraphProcedure,
+		svc.StackGraph,
+		connect.WithSchema(dockerServiceMethods.ByName("StackGraph")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeStartAllHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeStartAllProcedure,
+		svc.ComposeStartAll,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeStartAll")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeStopAllHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeStopAllProcedure,
+		svc.ComposeStopAll,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeStopAll")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceComposeBulkHandler := connect.NewServerStreamHandler(
+		DockerServiceComposeBulkProcedure,
+		svc.ComposeBulk,
+		connect.WithSchema(dockerServiceMethods.ByName("ComposeBulk")),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceImageListHandler := connect.NewUnaryHandler(
 		DockerServiceImageListProcedure,
 		svc.ImageList,
 		connect.WithSchema(dockerServiceMethods.ByName("ImageList")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceImageRemoveHandler := connect.NewUnaryHandler(
@@ -643,10 +1257,49 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("ImagePruneUnused")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceDiskUsageHandler := connect.NewUnaryHandler(
+		DockerServiceDiskUsageProcedure,
+		svc.DiskUsage,
+		connect.WithSchema(dockerServiceMethods.ByName("DiskUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServicePruneHandler := connect.NewUnaryHandler(
+		DockerServicePruneProcedure,
+		svc.Prune,
+		connect.WithSchema(dockerServiceMethods.ByName("Prune")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceImageBuildHandler := connect.NewServerStreamHandler(
+		DockerServiceImageBuildProcedure,
+		svc.ImageBuild,
+		connect.WithSchema(dockerServiceMethods.ByName("ImageBuild")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceImagePushHandler := connect.NewServerStreamHandler(
+		DockerServiceImagePushProcedure,
+		svc.ImagePush,
+		connect.WithSchema(dockerServiceMethods.ByName("ImagePush")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceImageSaveHandler := connect.NewServerStreamHandler(
+		DockerServiceImageSaveProcedure,
+		svc.ImageSave,
+		connect.WithSchema(dockerServiceMethods.ByName("ImageSave")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceImageTransferHandler := connect.NewServerStreamHandler(
+		DockerServiceImageTransferProcedure,
+		svc.ImageTransfer,
+		connect.WithSchema(dockerServiceMethods.ByName("ImageTransfer")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceVolumeListHandler := connect.NewUnaryHandler(
 		DockerServiceVolumeListProcedure,
 		svc.VolumeList,
 		connect.WithSchema(dockerServiceMethods.ByName("VolumeList")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceVolumeCreateHandler := connect.NewUnaryHandler(
@@ -661,10 +1314,37 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("VolumeDelete")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceVolumeListFilesHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeListFilesProcedure,
+		svc.VolumeListFiles,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeListFiles")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceVolumeReadFileHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeReadFileProcedure,
+		svc.VolumeReadFile,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeReadFile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceVolumeWriteFileHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeWriteFileProcedure,
+		svc.VolumeWriteFile,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeWriteFile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceVolumeDeleteFileHandler := connect.NewUnaryHandler(
+		DockerServiceVolumeDeleteFileProcedure,
+		svc.VolumeDeleteFile,
+		connect.WithSchema(dockerServiceMethods.ByName("VolumeDeleteFile")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dockerServiceNetworkListHandler := connect.NewUnaryHandler(
 		DockerServiceNetworkListProcedure,
 		svc.NetworkList,
 		connect.WithSchema(dockerServiceMethods.ByName("NetworkList")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerServiceNetworkCreateHandler := connect.NewUnaryHandler(
@@ -679,6 +1359,32 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(dockerServiceMethods.ByName("NetworkDelete")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dockerServiceNetworkTopologyHandler := connect.NewUnaryHandler(
+		DockerServiceNetworkTopologyProcedure,
+		svc.NetworkTopology,
+		connect.WithSchema(dockerServiceMethods.ByName("NetworkTopology")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceNetworkConnectHandler := connect.NewUnaryHandler(
+		DockerServiceNetworkConnectProcedure,
+		svc.NetworkConnect,
+		connect.WithSchema(dockerServiceMethods.ByName("NetworkConnect")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceNetworkDisconnectHandler := connect.NewUnaryHandler(
+		DockerServiceNetworkDisconnectProcedure,
+		svc.NetworkDisconnect,
+		connect.WithSchema(dockerServiceMethods.ByName("NetworkDisconnect")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dockerServiceEventsHandler := connect.NewServerStreamHandler(
+		DockerServiceEventsProcedure,
+		svc.Events,
+		connect.WithSchema(dockerServiceMethods.ByName("Events")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/docker.v1.DockerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DockerServiceContainerStartProcedure:
@@ -691,6 +1397,8 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 			dockerServiceContainerRestartHandler.ServeHTTP(w, r)
 		case DockerServiceContainerUpdateProcedure:
 			dockerServiceContainerUpdateHandler.ServeHTTP(w, r)
+		case DockerServiceContainerImportProcedure:
+			dockerServiceContainerImportHandler.ServeHTTP(w, r)
 		case DockerServiceContainerListProcedure:
 			dockerServiceContainerListHandler.ServeHTTP(w, r)
 		case DockerServiceContainerStatsProcedure:
@@ -701,6 +1409,12 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 			dockerServiceContainerExecOutputHandler.ServeHTTP(w, r)
 		case DockerServiceContainerExecInputProcedure:
 			dockerServiceContainerExecInputHandler.ServeHTTP(w, r)
+		case DockerServiceContainerListFilesProcedure:
+			dockerServiceContainerListFilesHandler.ServeHTTP(w, r)
+		case DockerServiceContainerDownloadProcedure:
+			dockerServiceContainerDownloadHandler.ServeHTTP(w, r)
+		case DockerServiceContainerUploadProcedure:
+			dockerServiceContainerUploadHandler.ServeHTTP(w, r)
 		case DockerServiceComposeStartProcedure:
 			dockerServiceComposeStartHandler.ServeHTTP(w, r)
 		case DockerServiceComposeStopProcedure:
@@ -709,30 +1423,76 @@ func NewDockerServiceHandler(svc DockerServiceHandler, opts ...connect.HandlerOp
 			dockerServiceComposeRemoveHandler.ServeHTTP(w, r)
 		case DockerServiceComposeRestartProcedure:
 			dockerServiceComposeRestartHandler.ServeHTTP(w, r)
+		case DockerServiceComposeBuildProcedure:
+			dockerServiceComposeBuildHandler.ServeHTTP(w, r)
 		case DockerServiceComposeUpdateProcedure:
 			dockerServiceComposeUpdateHandler.ServeHTTP(w, r)
 		case DockerServiceComposeListProcedure:
 			dockerServiceComposeListHandler.ServeHTTP(w, r)
 		case DockerServiceComposeValidateProcedure:
 			dockerServiceComposeValidateHandler.ServeHTTP(w, r)
+		case DockerServiceComposeOverviewProcedure:
+			dockerServiceComposeOverviewHandler.ServeHTTP(w, r)
+		case DockerServiceComposeDriftProcedure:
+			dockerServiceComposeDriftHandler.ServeHTTP(w, r)
+		case DockerServiceComposePlanProcedure:
+			dockerServiceComposePlanHandler.ServeHTTP(w, r)
+		case DockerServiceComposeConfigProcedure:
+			dockerServiceComposeConfigHandler.ServeHTTP(w, r)
+		case DockerServiceStackGraphProcedure:
+			dockerServiceStackGraphHandler.ServeHTTP(w, r)
+		case DockerServiceComposeStartAllProcedure:
+			dockerServiceComposeStartAllHandler.ServeHTTP(w, r)
+		case DockerServiceComposeStopAllProcedure:
+			dockerServiceComposeStopAllHandler.ServeHTTP(w, r)
+		case DockerServiceComposeBulkProcedure:
+			dockerServiceComposeBulkHandler.ServeHTTP(w, r)
 		case DockerServiceImageListProcedure:
 			dockerServiceImageListHandler.ServeHTTP(w, r)
 		case DockerServiceImageRemoveProcedure:
 			dockerServiceImageRemoveHandler.ServeHTTP(w, r)
 		case DockerServiceImagePruneUnusedProcedure:
 			dockerServiceImagePruneUnusedHandler.ServeHTTP(w, r)
+		case DockerServiceDiskUsageProcedure:
+			dockerServiceDiskUsageHandler.ServeHTTP(w, r)
+		case DockerServicePruneProcedure:
+			dockerServicePruneHandler.ServeHTTP(w, r)
+		case DockerServiceImageBuildProcedure:
+			dockerServiceImageBuildHandler.ServeHTTP(w, r)
+		case DockerServiceImagePushProcedure:
+			dockerServiceImagePushHandler.ServeHTTP(w, r)
+		case DockerServiceImageSaveProcedure:
+			dockerServiceImageSaveHandler.ServeHTTP(w, r)
+		case DockerServiceImageTransferProcedure:
+			dockerServiceImageTransferHandler.ServeHTTP(w, r)
 		case DockerServiceVolumeListProcedure:
 			dockerServiceVolumeListHandler.ServeHTTP(w, r)
 		case DockerServiceVolumeCreateProcedure:
 			dockerServiceVolumeCreateHandler.ServeHTTP(w, r)
 		case DockerServiceVolumeDeleteProcedure:
 			dockerServiceVolumeDeleteHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeListFilesProcedure:
+			dockerServiceVolumeListFilesHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeReadFileProcedure:
+			dockerServiceVolumeReadFileHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeWriteFileProcedure:
+			dockerServiceVolumeWriteFileHandler.ServeHTTP(w, r)
+		case DockerServiceVolumeDeleteFileProcedure:
+			dockerServiceVolumeDeleteFileHandler.ServeHTTP(w, r)
 		case DockerServiceNetworkListProcedure:
 			dockerServiceNetworkListHandler.ServeHTTP(w, r)
 		case DockerServiceNetworkCreateProcedure:
 			dockerServiceNetworkCreateHandler.ServeHTTP(w, r)
 		case DockerServiceNetworkDeleteProcedure:
 			dockerServiceNetworkDeleteHandler.ServeHTTP(w, r)
+		case DockerServiceNetworkTopologyProcedure:
+			dockerServiceNetworkTopologyHandler.ServeHTTP(w, r)
+		case DockerServiceNetworkConnectProcedure:
+			dockerServiceNetworkConnectHandler.ServeHTTP(w, r)
+		case DockerServiceNetworkDisconnectProcedure:
+			dockerServiceNetworkDisconnectHandler.ServeHTTP(w, r)
+		case DockerServiceEventsProcedure:
+			dockerServiceEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -762,6 +1522,10 @@ func (UnimplementedDockerServiceHandler) ContainerUpdate(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerUpdate is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) ContainerImport(context.Context, *connect.Request[v1.ContainerImportRequest]) (*connect.Response[v1.ContainerImportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerImport is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) ContainerList(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerList is not implemented"))
 }
@@ -782,6 +1546,18 @@ func (UnimplementedDockerServiceHandler) ContainerExecInput(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerExecInput is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) ContainerListFiles(context.Context, *connect.Request[v1.ContainerListFilesRequest]) (*connect.Response[v1.ContainerListFilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerListFiles is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ContainerDownload(context.Context, *connect.Request[v1.ContainerFileRequest], *connect.ServerStream[v1.ArchiveChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerDownload is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ContainerUpload(context.Context, *connect.Request[v1.ContainerUploadRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ContainerUpload is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) ComposeStart(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeStart is not implemented"))
 }
@@ -798,6 +1574,10 @@ func (UnimplementedDockerServiceHandler) ComposeRestart(context.Context, *connec
 	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeRestart is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) ComposeBuild(context.Context, *connect.Request[v1.ComposeBuildRequest], *connect.ServerStream[v1.LogsMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeBuild is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) ComposeUpdate(context.Context, *connect.Request[v1.ComposeFile], *connect.ServerStream[v1.LogsMessage]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeUpdate is not implemented"))
 }
@@ -810,6 +1590,38 @@ func (UnimplementedDockerServiceHandler) ComposeValidate(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeValidate is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) ComposeOverview(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ComposeOverviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeOverview is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeDrift(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeDriftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeDrift is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposePlan(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposePlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposePlan is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeConfig(context.Context, *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeConfig is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) StackGraph(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.StackGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.StackGraph is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeStartAll(context.Context, *connect.Request[v1.Empty], *connect.ServerStream[v1.LogsMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeStartAll is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeStopAll(context.Context, *connect.Request[v1.Empty], *connect.ServerStream[v1.LogsMessage]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeStopAll is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ComposeBulk(context.Context, *connect.Request[v1.BulkComposeRequest], *connect.ServerStream[v1.BulkProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ComposeBulk is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) ImageList(context.Context, *connect.Request[v1.ListImagesRequest]) (*connect.Response[v1.ListImagesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImageList is not implemented"))
 }
@@ -822,6 +1634,30 @@ func (UnimplementedDockerServiceHandler) ImagePruneUnused(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImagePruneUnused is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) DiskUsage(context.Context, *connect.Request[v1.DiskUsageRequest]) (*connect.Response[v1.DiskUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.DiskUsage is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) Prune(context.Context, *connect.Request[v1.PruneRequest]) (*connect.Response[v1.PruneResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.Prune is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ImageBuild(context.Context, *connect.Request[v1.ImageBuildRequest], *connect.ServerStream[v1.ImageProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImageBuild is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ImagePush(context.Context, *connect.Request[v1.ImagePushRequest], *connect.ServerStream[v1.ImageProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImagePush is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ImageSave(context.Context, *connect.Request[v1.ImageSaveRequest], *connect.ServerStream[v1.ArchiveChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImageSave is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) ImageTransfer(context.Context, *connect.Request[v1.ImageTransferRequest], *connect.ServerStream[v1.ImageProgress]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.ImageTransfer is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) VolumeList(context.Context, *connect.Request[v1.ListVolumesRequest]) (*connect.Response[v1.ListVolumesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeList is not implemented"))
 }
@@ -834,6 +1670,22 @@ func (UnimplementedDockerServiceHandler) VolumeDelete(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeDelete is not implemented"))
 }
 
+func (UnimplementedDockerServiceHandler) VolumeListFiles(context.Context, *connect.Request[v1.VolumeListFilesRequest]) (*connect.Response[v1.VolumeListFilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeListFiles is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) VolumeReadFile(context.Context, *connect.Request[v1.VolumeFileRequest]) (*connect.Response[v1.VolumeFileContents], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeReadFile is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) VolumeWriteFile(context.Context, *connect.Request[v1.VolumeWriteFileRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeWriteFile is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) VolumeDeleteFile(context.Context, *connect.Request[v1.VolumeDeleteFileRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.VolumeDeleteFile is not implemented"))
+}
+
 func (UnimplementedDockerServiceHandler) NetworkList(context.Context, *connect.Request[v1.ListNetworksRequest]) (*connect.Response[v1.ListNetworksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkList is not implemented"))
 }
@@ -845,3 +1697,19 @@ func (UnimplementedDockerServiceHandler) NetworkCreate(context.Context, *connect
 func (UnimplementedDockerServiceHandler) NetworkDelete(context.Context, *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkDelete is not implemented"))
 }
+
+func (UnimplementedDockerServiceHandler) NetworkTopology(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.NetworkTopologyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkTopology is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) NetworkConnect(context.Context, *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkConnect is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) NetworkDisconnect(context.Context, *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.NetworkDisconnect is not implemented"))
+}
+
+func (UnimplementedDockerServiceHandler) Events(context.Context, *connect.Request[v1.EventsRequest], *connect.ServerStream[v1.DockerEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("docker.v1.DockerService.Events is not implemented"))
+}
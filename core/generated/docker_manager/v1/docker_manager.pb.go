@@ -420,13 +420,13 @@ const file_docker_manager_v1_docker_manager_proto_rawDesc = "" +
 	"\x13use_public_key_auth\x18\b \x01(\bR\x10usePublicKeyAuth\"-\n" +
 	"\rSwitchRequest\x12\x1c\n" +
 	"\tmachineID\x18\x01 \x01(\tR\tmachineID\"\a\n" +
-	"\x05Empty2\xaa\x05\n" +
+	"\x05Empty2\xb3\x05\n" +
 	"\x14DockerManagerService\x12C\n" +
 	"\vStartUpdate\x12\x18.docker_manager.v1.Empty\x1a\x18.docker_manager.v1.Empty\"\x00\x12L\n" +
-	"\fSwitchClient\x12 .docker_manager.v1.SwitchRequest\x1a\x18.docker_manager.v1.Empty\"\x00\x12Q\n" +
-	"\vListClients\x12\x18.docker_manager.v1.Empty\x1a&.docker_manager.v1.ListClientsResponse\"\x00\x12G\n" +
-	"\tListHosts\x12\x18.docker_manager.v1.Empty\x1a\x1e.docker_manager.v1.ListMachine\"\x00\x12B\n" +
-	"\x03Get\x12\x1d.docker_manager.v1.GetMachine\x1a\x1a.docker_manager.v1.Machine\"\x00\x12C\n" +
+	"\fSwitchClient\x12 .docker_manager.v1.SwitchRequest\x1a\x18.docker_manager.v1.Empty\"\x00\x12T\n" +
+	"\vListClients\x12\x18.docker_manager.v1.Empty\x1a&.docker_manager.v1.ListClientsResponse\"\x03\x90\x02\x01\x12J\n" +
+	"\tListHosts\x12\x18.docker_manager.v1.Empty\x1a\x1e.docker_manager.v1.ListMachine\"\x03\x90\x02\x01\x12E\n" +
+	"\x03Get\x12\x1d.docker_manager.v1.GetMachine\x1a\x1a.docker_manager.v1.Machine\"\x03\x90\x02\x01\x12C\n" +
 	"\tNewClient\x12\x1a.docker_manager.v1.Machine\x1a\x18.docker_manager.v1.Empty\"\x00\x12D\n" +
 	"\n" +
 	"EditClient\x12\x1a.docker_manager.v1.Machine\x1a\x18.docker_manager.v1.Empty\"\x00\x12F\n" +
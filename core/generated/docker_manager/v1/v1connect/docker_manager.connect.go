@@ -102,18 +102,21 @@ func NewDockerManagerServiceClient(httpClient connect.HTTPClient, baseURL string
 			httpClient,
 			baseURL+DockerManagerServiceListClientsProcedure,
 			connect.WithSchema(dockerManagerServiceMethods.ByName("ListClients")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listHosts: connect.NewClient[v1.Empty, v1.ListMachine](
 			httpClient,
 			baseURL+DockerManagerServiceListHostsProcedure,
 			connect.WithSchema(dockerManagerServiceMethods.ByName("ListHosts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		get: connect.NewClient[v1.GetMachine, v1.Machine](
 			httpClient,
 			baseURL+DockerManagerServiceGetProcedure,
 			connect.WithSchema(dockerManagerServiceMethods.ByName("Get")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		newClient: connect.NewClient[v1.Machine, v1.Empty](
@@ -238,18 +241,21 @@ func NewDockerManagerServiceHandler(svc DockerManagerServiceHandler, opts ...con
 		DockerManagerServiceListClientsProcedure,
 		svc.ListClients,
 		connect.WithSchema(dockerManagerServiceMethods.ByName("ListClients")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerManagerServiceListHostsHandler := connect.NewUnaryHandler(
 		DockerManagerServiceListHostsProcedure,
 		svc.ListHosts,
 		connect.WithSchema(dockerManagerServiceMethods.ByName("ListHosts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerManagerServiceGetHandler := connect.NewUnaryHandler(
 		DockerManagerServiceGetProcedure,
 		svc.Get,
 		connect.WithSchema(dockerManagerServiceMethods.ByName("Get")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	dockerManagerServiceNewClientHandler := connect.NewUnaryHandler(
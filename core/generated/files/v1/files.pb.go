@@ -117,6 +117,274 @@ func (x *FileGroup) GetSubFiles() []string {
 	return nil
 }
 
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*Template            `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_files_v1_files_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*Template {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type Template struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// main compose file in the template
+	Compose       string         `protobuf:"bytes,4,opt,name=compose,proto3" json:"compose,omitempty"`
+	Builtin       bool           `protobuf:"varint,5,opt,name=builtin,proto3" json:"builtin,omitempty"`
+	Placeholders  []*Placeholder `protobuf:"bytes,6,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Template) Reset() {
+	*x = Template{}
+	mi := &file_files_v1_files_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Template) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Template) ProtoMessage() {}
+
+func (x *Template) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Template.ProtoReflect.Descriptor instead.
+func (*Template) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Template) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Template) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Template) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Template) GetCompose() string {
+	if x != nil {
+		return x.Compose
+	}
+	return ""
+}
+
+func (x *Template) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+func (x *Template) GetPlaceholders() []*Placeholder {
+	if x != nil {
+		return x.Placeholders
+	}
+	return nil
+}
+
+type Placeholder struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Default     string                 `protobuf:"bytes,3,opt,name=default,proto3" json:"default,omitempty"`
+	Required    bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// a random value is generated if left empty
+	Random        bool `protobuf:"varint,5,opt,name=random,proto3" json:"random,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Placeholder) Reset() {
+	*x = Placeholder{}
+	mi := &file_files_v1_files_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Placeholder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Placeholder) ProtoMessage() {}
+
+func (x *Placeholder) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Placeholder.ProtoReflect.Descriptor instead.
+func (*Placeholder) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Placeholder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Placeholder) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Placeholder) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *Placeholder) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Placeholder) GetRandom() bool {
+	if x != nil {
+		return x.Random
+	}
+	return false
+}
+
+type CreateFromTemplateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId string                 `protobuf:"bytes,1,opt,name=templateId,proto3" json:"templateId,omitempty"`
+	// name of the folder created in the compose root
+	StackName string `protobuf:"bytes,2,opt,name=stackName,proto3" json:"stackName,omitempty"`
+	// placeholder name -> value
+	Values        map[string]string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFromTemplateRequest) Reset() {
+	*x = CreateFromTemplateRequest{}
+	mi := &file_files_v1_files_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_files_v1_files_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_files_v1_files_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateFromTemplateRequest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateFromTemplateRequest) GetStackName() string {
+	if x != nil {
+		return x.StackName
+	}
+	return ""
+}
+
+func (x *CreateFromTemplateRequest) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type RenameFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OldFilePath   string                 `protobuf:"bytes,1,opt,name=oldFilePath,proto3" json:"oldFilePath,omitempty"`
@@ -127,7 +395,7 @@ type RenameFile struct {
 
 func (x *RenameFile) Reset() {
 	*x = RenameFile{}
-	mi := &file_files_v1_files_proto_msgTypes[2]
+	mi := &file_files_v1_files_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +407,7 @@ func (x *RenameFile) String() string {
 func (*RenameFile) ProtoMessage() {}
 
 func (x *RenameFile) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[2]
+	mi := &file_files_v1_files_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +420,7 @@ func (x *RenameFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameFile.ProtoReflect.Descriptor instead.
 func (*RenameFile) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{2}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RenameFile) GetOldFilePath() string {
@@ -178,7 +446,7 @@ type File struct {
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_files_v1_files_proto_msgTypes[3]
+	mi := &file_files_v1_files_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -190,7 +458,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[3]
+	mi := &file_files_v1_files_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +471,7 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{3}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *File) GetFilename() string {
@@ -221,7 +489,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_files_v1_files_proto_msgTypes[4]
+	mi := &file_files_v1_files_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +501,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[4]
+	mi := &file_files_v1_files_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +514,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{4}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{8}
 }
 
 type DockmanYaml struct {
@@ -264,7 +532,7 @@ type DockmanYaml struct {
 
 func (x *DockmanYaml) Reset() {
 	*x = DockmanYaml{}
-	mi := &file_files_v1_files_proto_msgTypes[5]
+	mi := &file_files_v1_files_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +544,7 @@ func (x *DockmanYaml) String() string {
 func (*DockmanYaml) ProtoMessage() {}
 
 func (x *DockmanYaml) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[5]
+	mi := &file_files_v1_files_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +557,7 @@ func (x *DockmanYaml) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockmanYaml.ProtoReflect.Descriptor instead.
 func (*DockmanYaml) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{5}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DockmanYaml) GetUseComposeFolders() bool {
@@ -350,7 +618,7 @@ type VolumesConfig struct {
 
 func (x *VolumesConfig) Reset() {
 	*x = VolumesConfig{}
-	mi := &file_files_v1_files_proto_msgTypes[6]
+	mi := &file_files_v1_files_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +630,7 @@ func (x *VolumesConfig) String() string {
 func (*VolumesConfig) ProtoMessage() {}
 
 func (x *VolumesConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[6]
+	mi := &file_files_v1_files_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +643,7 @@ func (x *VolumesConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumesConfig.ProtoReflect.Descriptor instead.
 func (*VolumesConfig) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{6}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VolumesConfig) GetSort() *Sort {
@@ -394,7 +662,7 @@ type NetworkConfig struct {
 
 func (x *NetworkConfig) Reset() {
 	*x = NetworkConfig{}
-	mi := &file_files_v1_files_proto_msgTypes[7]
+	mi := &file_files_v1_files_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +674,7 @@ func (x *NetworkConfig) String() string {
 func (*NetworkConfig) ProtoMessage() {}
 
 func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[7]
+	mi := &file_files_v1_files_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +687,7 @@ func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
 func (*NetworkConfig) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{7}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NetworkConfig) GetSort() *Sort {
@@ -438,7 +706,7 @@ type ImageConfig struct {
 
 func (x *ImageConfig) Reset() {
 	*x = ImageConfig{}
-	mi := &file_files_v1_files_proto_msgTypes[8]
+	mi := &file_files_v1_files_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +718,7 @@ func (x *ImageConfig) String() string {
 func (*ImageConfig) ProtoMessage() {}
 
 func (x *ImageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[8]
+	mi := &file_files_v1_files_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +731,7 @@ func (x *ImageConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageConfig.ProtoReflect.Descriptor instead.
 func (*ImageConfig) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{8}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ImageConfig) GetSort() *Sort {
@@ -482,7 +750,7 @@ type ContainerConfig struct {
 
 func (x *ContainerConfig) Reset() {
 	*x = ContainerConfig{}
-	mi := &file_files_v1_files_proto_msgTypes[9]
+	mi := &file_files_v1_files_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +762,7 @@ func (x *ContainerConfig) String() string {
 func (*ContainerConfig) ProtoMessage() {}
 
 func (x *ContainerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[9]
+	mi := &file_files_v1_files_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +775,7 @@ func (x *ContainerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerConfig.ProtoReflect.Descriptor instead.
 func (*ContainerConfig) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{9}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ContainerConfig) GetSort() *Sort {
@@ -527,7 +795,7 @@ type Sort struct {
 
 func (x *Sort) Reset() {
 	*x = Sort{}
-	mi := &file_files_v1_files_proto_msgTypes[10]
+	mi := &file_files_v1_files_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +807,7 @@ func (x *Sort) String() string {
 func (*Sort) ProtoMessage() {}
 
 func (x *Sort) ProtoReflect() protoreflect.Message {
-	mi := &file_files_v1_files_proto_msgTypes[10]
+	mi := &file_files_v1_files_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +820,7 @@ func (x *Sort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sort.ProtoReflect.Descriptor instead.
 func (*Sort) Descriptor() ([]byte, []int) {
-	return file_files_v1_files_proto_rawDescGZIP(), []int{10}
+	return file_files_v1_files_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Sort) GetSortOrder() string {
@@ -578,7 +846,31 @@ const file_files_v1_files_proto_rawDesc = "" +
 	"\x06groups\x18\x01 \x03(\v2\x13.files.v1.FileGroupR\x06groups\";\n" +
 	"\tFileGroup\x12\x12\n" +
 	"\x04root\x18\x01 \x01(\tR\x04root\x12\x1a\n" +
-	"\bsubFiles\x18\x02 \x03(\tR\bsubFiles\"P\n" +
+	"\bsubFiles\x18\x02 \x03(\tR\bsubFiles\"I\n" +
+	"\x15ListTemplatesResponse\x120\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x12.files.v1.TemplateR\ttemplates\"\xbf\x01\n" +
+	"\bTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\acompose\x18\x04 \x01(\tR\acompose\x12\x18\n" +
+	"\abuiltin\x18\x05 \x01(\bR\abuiltin\x129\n" +
+	"\fplaceholders\x18\x06 \x03(\v2\x15.files.v1.PlaceholderR\fplaceholders\"\x91\x01\n" +
+	"\vPlaceholder\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\adefault\x18\x03 \x01(\tR\adefault\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x16\n" +
+	"\x06random\x18\x05 \x01(\bR\x06random\"\xdd\x01\n" +
+	"\x19CreateFromTemplateRequest\x12\x1e\n" +
+	"\n" +
+	"templateId\x18\x01 \x01(\tR\n" +
+	"templateId\x12\x1c\n" +
+	"\tstackName\x18\x02 \x01(\tR\tstackName\x12G\n" +
+	"\x06values\x18\x03 \x03(\v2/.files.v1.CreateFromTemplateRequest.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"P\n" +
 	"\n" +
 	"RenameFile\x12 \n" +
 	"\voldFilePath\x18\x01 \x01(\tR\voldFilePath\x12 \n" +
@@ -604,14 +896,16 @@ const file_files_v1_files_proto_rawDesc = "" +
 	"\x04sort\x18\x01 \x01(\v2\x0e.files.v1.SortR\x04sort\"B\n" +
 	"\x04Sort\x12\x1c\n" +
 	"\tsortOrder\x18\x01 \x01(\tR\tsortOrder\x12\x1c\n" +
-	"\tsortField\x18\x02 \x01(\tR\tsortField2\xb6\x02\n" +
+	"\tsortField\x18\x02 \x01(\tR\tsortField2\xd4\x03\n" +
 	"\vFileService\x12+\n" +
-	"\x06Create\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x121\n" +
-	"\x04List\x12\x0f.files.v1.Empty\x1a\x16.files.v1.ListResponse\"\x00\x12+\n" +
-	"\x06Delete\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x12+\n" +
-	"\x06Exists\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x121\n" +
-	"\x06Rename\x12\x14.files.v1.RenameFile\x1a\x0f.files.v1.Empty\"\x00\x12:\n" +
-	"\x0eGetDockmanYaml\x12\x0f.files.v1.Empty\x1a\x15.files.v1.DockmanYaml\"\x00B\x88\x01\n" +
+	"\x06Create\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x124\n" +
+	"\x04List\x12\x0f.files.v1.Empty\x1a\x16.files.v1.ListResponse\"\x03\x90\x02\x01\x12+\n" +
+	"\x06Delete\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x00\x12.\n" +
+	"\x06Exists\x12\x0e.files.v1.File\x1a\x0f.files.v1.Empty\"\x03\x90\x02\x01\x121\n" +
+	"\x06Rename\x12\x14.files.v1.RenameFile\x1a\x0f.files.v1.Empty\"\x00\x12=\n" +
+	"\x0eGetDockmanYaml\x12\x0f.files.v1.Empty\x1a\x15.files.v1.DockmanYaml\"\x03\x90\x02\x01\x12F\n" +
+	"\rListTemplates\x12\x0f.files.v1.Empty\x1a\x1f.files.v1.ListTemplatesResponse\"\x03\x90\x02\x01\x12K\n" +
+	"\x12CreateFromTemplate\x12#.files.v1.CreateFromTemplateRequest\x1a\x0e.files.v1.File\"\x00B\x88\x01\n" +
 	"\fcom.files.v1B\n" +
 	"FilesProtoP\x01Z+github.com/RA341/dockman/generated/files/v1\xa2\x02\x03FXX\xaa\x02\bFiles.V1\xca\x02\bFiles\\V1\xe2\x02\x14Files\\V1\\GPBMetadata\xea\x02\tFiles::V1b\x06proto3"
 
@@ -627,47 +921,59 @@ func file_files_v1_files_proto_rawDescGZIP() []byte {
 	return file_files_v1_files_proto_rawDescData
 }
 
-var file_files_v1_files_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_files_v1_files_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_files_v1_files_proto_goTypes = []any{
-	(*ListResponse)(nil),    // 0: files.v1.ListResponse
-	(*FileGroup)(nil),       // 1: files.v1.FileGroup
-	(*RenameFile)(nil),      // 2: files.v1.RenameFile
-	(*File)(nil),            // 3: files.v1.File
-	(*Empty)(nil),           // 4: files.v1.Empty
-	(*DockmanYaml)(nil),     // 5: files.v1.DockmanYaml
-	(*VolumesConfig)(nil),   // 6: files.v1.VolumesConfig
-	(*NetworkConfig)(nil),   // 7: files.v1.NetworkConfig
-	(*ImageConfig)(nil),     // 8: files.v1.ImageConfig
-	(*ContainerConfig)(nil), // 9: files.v1.ContainerConfig
-	(*Sort)(nil),            // 10: files.v1.Sort
+	(*ListResponse)(nil),              // 0: files.v1.ListResponse
+	(*FileGroup)(nil),                 // 1: files.v1.FileGroup
+	(*ListTemplatesResponse)(nil),     // 2: files.v1.ListTemplatesResponse
+	(*Template)(nil),                  // 3: files.v1.Template
+	(*Placeholder)(nil),               // 4: files.v1.Placeholder
+	(*CreateFromTemplateRequest)(nil), // 5: files.v1.CreateFromTemplateRequest
+	(*RenameFile)(nil),                // 6: files.v1.RenameFile
+	(*File)(nil),                      // 7: files.v1.File
+	(*Empty)(nil),                     // 8: files.v1.Empty
+	(*DockmanYaml)(nil),               // 9: files.v1.DockmanYaml
+	(*VolumesConfig)(nil),             // 10: files.v1.VolumesConfig
+	(*NetworkConfig)(nil),             // 11: files.v1.NetworkConfig
+	(*ImageConfig)(nil),               // 12: files.v1.ImageConfig
+	(*ContainerConfig)(nil),           // 13: files.v1.ContainerConfig
+	(*Sort)(nil),                      // 14: files.v1.Sort
+	nil,                               // 15: files.v1.CreateFromTemplateRequest.ValuesEntry
 }
 var file_files_v1_files_proto_depIdxs = []int32{
 	1,  // 0: files.v1.ListResponse.groups:type_name -> files.v1.FileGroup
-	6,  // 1: files.v1.DockmanYaml.volumesPage:type_name -> files.v1.VolumesConfig
-	7,  // 2: files.v1.DockmanYaml.networkPage:type_name -> files.v1.NetworkConfig
-	8,  // 3: files.v1.DockmanYaml.imagePage:type_name -> files.v1.ImageConfig
-	9,  // 4: files.v1.DockmanYaml.containerPage:type_name -> files.v1.ContainerConfig
-	10, // 5: files.v1.VolumesConfig.sort:type_name -> files.v1.Sort
-	10, // 6: files.v1.NetworkConfig.sort:type_name -> files.v1.Sort
-	10, // 7: files.v1.ImageConfig.sort:type_name -> files.v1.Sort
-	10, // 8: files.v1.ContainerConfig.sort:type_name -> files.v1.Sort
-	3,  // 9: files.v1.FileService.Create:input_type -> files.v1.File
-	4,  // 10: files.v1.FileService.List:input_type -> files.v1.Empty
-	3,  // 11: files.v1.FileService.Delete:input_type -> files.v1.File
-	3,  // 12: files.v1.FileService.Exists:input_type -> files.v1.File
-	2,  // 13: files.v1.FileService.Rename:input_type -> files.v1.RenameFile
-	4,  // 14: files.v1.FileService.GetDockmanYaml:input_type -> files.v1.Empty
-	4,  // 15: files.v1.FileService.Create:output_type -> files.v1.Empty
-	0,  // 16: files.v1.FileService.List:output_type -> files.v1.ListResponse
-	4,  // 17: files.v1.FileService.Delete:output_type -> files.v1.Empty
-	4,  // 18: files.v1.FileService.Exists:output_type -> files.v1.Empty
-	4,  // 19: files.v1.FileService.Rename:output_type -> files.v1.Empty
-	5,  // 20: files.v1.FileService.GetDockmanYaml:output_type -> files.v1.DockmanYaml
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	3,  // 1: files.v1.ListTemplatesResponse.templates:type_name -> files.v1.Template
+	4,  // 2: files.v1.Template.placeholders:type_name -> files.v1.Placeholder
+	15, // 3: files.v1.CreateFromTemplateRequest.values:type_name -> files.v1.CreateFromTemplateRequest.ValuesEntry
+	10, // 4: files.v1.DockmanYaml.volumesPage:type_name -> files.v1.VolumesConfig
+	11, // 5: files.v1.DockmanYaml.networkPage:type_name -> files.v1.NetworkConfig
+	12, // 6: files.v1.DockmanYaml.imagePage:type_name -> files.v1.ImageConfig
+	13, // 7: files.v1.DockmanYaml.containerPage:type_name -> files.v1.ContainerConfig
+	14, // 8: files.v1.VolumesConfig.sort:type_name -> files.v1.Sort
+	14, // 9: files.v1.NetworkConfig.sort:type_name -> files.v1.Sort
+	14, // 10: files.v1.ImageConfig.sort:type_name -> files.v1.Sort
+	14, // 11: files.v1.ContainerConfig.sort:type_name -> files.v1.Sort
+	7,  // 12: files.v1.FileService.Create:input_type -> files.v1.File
+	8,  // 13: files.v1.FileService.List:input_type -> files.v1.Empty
+	7,  // 14: files.v1.FileService.Delete:input_type -> files.v1.File
+	7,  // 15: files.v1.FileService.Exists:input_type -> files.v1.File
+	6,  // 16: files.v1.FileService.Rename:input_type -> files.v1.RenameFile
+	8,  // 17: files.v1.FileService.GetDockmanYaml:input_type -> files.v1.Empty
+	8,  // 18: files.v1.FileService.ListTemplates:input_type -> files.v1.Empty
+	5,  // 19: files.v1.FileService.CreateFromTemplate:input_type -> files.v1.CreateFromTemplateRequest
+	8,  // 20: files.v1.FileService.Create:output_type -> files.v1.Empty
+	0,  // 21: files.v1.FileService.List:output_type -> files.v1.ListResponse
+	8,  // 22: files.v1.FileService.Delete:output_type -> files.v1.Empty
+	8,  // 23: files.v1.FileService.Exists:output_type -> files.v1.Empty
+	8,  // 24: files.v1.FileService.Rename:output_type -> files.v1.Empty
+	9,  // 25: files.v1.FileService.GetDockmanYaml:output_type -> files.v1.DockmanYaml
+	2,  // 26: files.v1.FileService.ListTemplates:output_type -> files.v1.ListTemplatesResponse
+	7,  // 27: files.v1.FileService.CreateFromTemplate:output_type -> files.v1.File
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_files_v1_files_proto_init() }
@@ -681,7 +987,7 @@ func file_files_v1_files_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_files_v1_files_proto_rawDesc), len(file_files_v1_files_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -46,6 +46,12 @@ const (
 	// FileServiceGetDockmanYamlProcedure is the fully-qualified name of the FileService's
 	// GetDockmanYaml RPC.
 	FileServiceGetDockmanYamlProcedure = "/files.v1.FileService/GetDockmanYaml"
+	// FileServiceListTemplatesProcedure is the fully-qualified name of the FileService's ListTemplates
+	// RPC.
+	FileServiceListTemplatesProcedure = "/files.v1.FileService/ListTemplates"
+	// FileServiceCreateFromTemplateProcedure is the fully-qualified name of the FileService's
+	// CreateFromTemplate RPC.
+	FileServiceCreateFromTemplateProcedure = "/files.v1.FileService/CreateFromTemplate"
 )
 
 // FileServiceClient is a client for the files.v1.FileService service.
@@ -57,6 +63,10 @@ type FileServiceClient interface {
 	Exists(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.Empty], error)
 	Rename(context.Context, *connect.Request[v1.RenameFile]) (*connect.Response[v1.Empty], error)
 	GetDockmanYaml(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.DockmanYaml], error)
+	// stack templates
+	ListTemplates(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListTemplatesResponse], error)
+	// returns the compose file of the new stack
+	CreateFromTemplate(context.Context, *connect.Request[v1.CreateFromTemplateRequest]) (*connect.Response[v1.File], error)
 }
 
 // NewFileServiceClient constructs a client for the files.v1.FileService service. By default, it
@@ -80,6 +90,7 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+FileServiceListProcedure,
 			connect.WithSchema(fileServiceMethods.ByName("List")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		delete: connect.NewClient[v1.File, v1.Empty](
@@ -92,6 +103,7 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+FileServiceExistsProcedure,
 			connect.WithSchema(fileServiceMethods.ByName("Exists")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		rename: connect.NewClient[v1.RenameFile, v1.Empty](
@@ -104,6 +116,20 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+FileServiceGetDockmanYamlProcedure,
 			connect.WithSchema(fileServiceMethods.ByName("GetDockmanYaml")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listTemplates: connect.NewClient[v1.Empty, v1.ListTemplatesResponse](
+			httpClient,
+			baseURL+FileServiceListTemplatesProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("ListTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createFromTemplate: connect.NewClient[v1.CreateFromTemplateRequest, v1.File](
+			httpClient,
+			baseURL+FileServiceCreateFromTemplateProcedure,
+			connect.WithSchema(fileServiceMethods.ByName("CreateFromTemplate")),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -111,12 +137,14 @@ func NewFileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // fileServiceClient implements FileServiceClient.
 type fileServiceClient struct {
-	create         *connect.Client[v1.File, v1.Empty]
-	list           *connect.Client[v1.Empty, v1.ListResponse]
-	delete         *connect.Client[v1.File, v1.Empty]
-	exists         *connect.Client[v1.File, v1.Empty]
-	rename         *connect.Client[v1.RenameFile, v1.Empty]
-	getDockmanYaml *connect.Client[v1.Empty, v1.DockmanYaml]
+	create             *connect.Client[v1.File, v1.Empty]
+	list               *connect.Client[v1.Empty, v1.ListResponse]
+	delete             *connect.Client[v1.File, v1.Empty]
+	exists             *connect.Client[v1.File, v1.Empty]
+	rename             *connect.Client[v1.RenameFile, v1.Empty]
+	getDockmanYaml     *connect.Client[v1.Empty, v1.DockmanYaml]
+	listTemplates      *connect.Client[v1.Empty, v1.ListTemplatesResponse]
+	createFromTemplate *connect.Client[v1.CreateFromTemplateRequest, v1.File]
 }
 
 // Create calls files.v1.FileService.Create.
@@ -149,6 +177,16 @@ func (c *fileServiceClient) GetDockmanYaml(ctx context.Context, req *connect.Req
 	return c.getDockmanYaml.CallUnary(ctx, req)
 }
 
+// ListTemplates calls files.v1.FileService.ListTemplates.
+func (c *fileServiceClient) ListTemplates(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	return c.listTemplates.CallUnary(ctx, req)
+}
+
+// CreateFromTemplate calls files.v1.FileService.CreateFromTemplate.
+func (c *fileServiceClient) CreateFromTemplate(ctx context.Context, req *connect.Request[v1.CreateFromTemplateRequest]) (*connect.Response[v1.File], error) {
+	return c.createFromTemplate.CallUnary(ctx, req)
+}
+
 // FileServiceHandler is an implementation of the files.v1.FileService service.
 type FileServiceHandler interface {
 	// root file management
@@ -158,6 +196,10 @@ type FileServiceHandler interface {
 	Exists(context.Context, *connect.Request[v1.File]) (*connect.Response[v1.Empty], error)
 	Rename(context.Context, *connect.Request[v1.RenameFile]) (*connect.Response[v1.Empty], error)
 	GetDockmanYaml(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.DockmanYaml], error)
+	// stack templates
+	ListTemplates(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListTemplatesResponse], error)
+	// returns the compose file of the new stack
+	CreateFromTemplate(context.Context, *connect.Request[v1.CreateFromTemplateRequest]) (*connect.Response[v1.File], error)
 }
 
 // NewFileServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -177,6 +219,7 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 		FileServiceListProcedure,
 		svc.List,
 		connect.WithSchema(fileServiceMethods.ByName("List")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	fileServiceDeleteHandler := connect.NewUnaryHandler(
@@ -189,6 +232,7 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 		FileServiceExistsProcedure,
 		svc.Exists,
 		connect.WithSchema(fileServiceMethods.ByName("Exists")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	fileServiceRenameHandler := connect.NewUnaryHandler(
@@ -201,6 +245,20 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 		FileServiceGetDockmanYamlProcedure,
 		svc.GetDockmanYaml,
 		connect.WithSchema(fileServiceMethods.ByName("GetDockmanYaml")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceListTemplatesHandler := connect.NewUnaryHandler(
+		FileServiceListTemplatesProcedure,
+		svc.ListTemplates,
+		connect.WithSchema(fileServiceMethods.ByName("ListTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	fileServiceCreateFromTemplateHandler := connect.NewUnaryHandler(
+		FileServiceCreateFromTemplateProcedure,
+		svc.CreateFromTemplate,
+		connect.WithSchema(fileServiceMethods.ByName("CreateFromTemplate")),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/files.v1.FileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -217,6 +275,10 @@ func NewFileServiceHandler(svc FileServiceHandler, opts ...connect.HandlerOption
 			fileServiceRenameHandler.ServeHTTP(w, r)
 		case FileServiceGetDockmanYamlProcedure:
 			fileServiceGetDockmanYamlHandler.ServeHTTP(w, r)
+		case FileServiceListTemplatesProcedure:
+			fileServiceListTemplatesHandler.ServeHTTP(w, r)
+		case FileServiceCreateFromTemplateProcedure:
+			fileServiceCreateFromTemplateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -249,3 +311,11 @@ func (UnimplementedFileServiceHandler) Rename(context.Context, *connect.Request[
 func (UnimplementedFileServiceHandler) GetDockmanYaml(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.DockmanYaml], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.GetDockmanYaml is not implemented"))
 }
+
+func (UnimplementedFileServiceHandler) ListTemplates(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.ListTemplates is not implemented"))
+}
+
+func (UnimplementedFileServiceHandler) CreateFromTemplate(context.Context, *connect.Request[v1.CreateFromTemplateRequest]) (*connect.Response[v1.File], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("files.v1.FileService.CreateFromTemplate is not implemented"))
+}
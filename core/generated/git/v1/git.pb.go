@@ -522,14 +522,14 @@ const file_git_v1_git_proto_rawDesc = "" +
 	"\amessage\x18\x06 \x01(\tR\amessage\"\x1a\n" +
 	"\x04File\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"\a\n" +
-	"\x05Empty2\xc5\x02\n" +
+	"\x05Empty2\xce\x02\n" +
 	"\n" +
-	"GitService\x121\n" +
-	"\vListCommits\x12\f.git.v1.File\x1a\x12.git.v1.CommitList\"\x00\x12.\n" +
+	"GitService\x124\n" +
+	"\vListCommits\x12\f.git.v1.File\x1a\x12.git.v1.CommitList\"\x03\x90\x02\x01\x12.\n" +
 	"\x06Commit\x12\x13.git.v1.CommitQuery\x1a\r.git.v1.Empty\"\x00\x120\n" +
-	"\bSyncFile\x12\x13.git.v1.FileRequest\x1a\r.git.v1.Empty\"\x00\x12U\n" +
-	"\x12ListFileFromBranch\x12\x1d.git.v1.BranchListFileRequest\x1a\x1e.git.v1.BranchListFileResponse\"\x00\x12K\n" +
-	"\fListBranches\x12\x1b.git.v1.ListBranchesRequest\x1a\x1c.git.v1.ListBranchesResponse\"\x00Bz\n" +
+	"\bSyncFile\x12\x13.git.v1.FileRequest\x1a\r.git.v1.Empty\"\x00\x12X\n" +
+	"\x12ListFileFromBranch\x12\x1d.git.v1.BranchListFileRequest\x1a\x1e.git.v1.BranchListFileResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\fListBranches\x12\x1b.git.v1.ListBranchesRequest\x1a\x1c.git.v1.ListBranchesResponse\"\x03\x90\x02\x01Bz\n" +
 	"\n" +
 	"com.git.v1B\bGitProtoP\x01Z)github.com/RA341/dockman/generated/git/v1\xa2\x02\x03GXX\xaa\x02\x06Git.V1\xca\x02\x06Git\\V1\xe2\x02\x12Git\\V1\\GPBMetadata\xea\x02\aGit::V1b\x06proto3"
 
@@ -70,6 +70,7 @@ func NewGitServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			httpClient,
 			baseURL+GitServiceListCommitsProcedure,
 			connect.WithSchema(gitServiceMethods.ByName("ListCommits")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		commit: connect.NewClient[v1.CommitQuery, v1.Empty](
@@ -88,12 +89,14 @@ func NewGitServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			httpClient,
 			baseURL+GitServiceListFileFromBranchProcedure,
 			connect.WithSchema(gitServiceMethods.ByName("ListFileFromBranch")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listBranches: connect.NewClient[v1.ListBranchesRequest, v1.ListBranchesResponse](
 			httpClient,
 			baseURL+GitServiceListBranchesProcedure,
 			connect.WithSchema(gitServiceMethods.ByName("ListBranches")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -153,6 +156,7 @@ func NewGitServiceHandler(svc GitServiceHandler, opts ...connect.HandlerOption)
 		GitServiceListCommitsProcedure,
 		svc.ListCommits,
 		connect.WithSchema(gitServiceMethods.ByName("ListCommits")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	gitServiceCommitHandler := connect.NewUnaryHandler(
@@ -171,12 +175,14 @@ func NewGitServiceHandler(svc GitServiceHandler, opts ...connect.HandlerOption)
 		GitServiceListFileFromBranchProcedure,
 		svc.ListFileFromBranch,
 		connect.WithSchema(gitServiceMethods.ByName("ListFileFromBranch")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	gitServiceListBranchesHandler := connect.NewUnaryHandler(
 		GitServiceListBranchesProcedure,
 		svc.ListBranches,
 		connect.WithSchema(gitServiceMethods.ByName("ListBranches")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/git.v1.GitService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
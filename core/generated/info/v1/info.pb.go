@@ -254,11 +254,11 @@ const file_info_v1_info_proto_rawDesc = "" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x10\n" +
 	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1c\n" +
 	"\tchangelog\x18\x03 \x01(\tR\tchangelog\"\a\n" +
-	"\x05Empty2\xad\x01\n" +
-	"\vInfoService\x122\n" +
-	"\fGetChangelog\x12\x0e.info.v1.Empty\x1a\x12.info.v1.Changelog\x12.\n" +
+	"\x05Empty2\xb7\x01\n" +
+	"\vInfoService\x127\n" +
+	"\fGetChangelog\x12\x0e.info.v1.Empty\x1a\x12.info.v1.Changelog\"\x03\x90\x02\x01\x123\n" +
 	"\n" +
-	"GetAppInfo\x12\x0e.info.v1.Empty\x1a\x10.info.v1.AppInfo\x12:\n" +
+	"GetAppInfo\x12\x0e.info.v1.Empty\x1a\x10.info.v1.AppInfo\"\x03\x90\x02\x01\x12:\n" +
 	"\vReadVersion\x12\x1b.info.v1.ReadVersionRequest\x1a\x0e.info.v1.EmptyB\x81\x01\n" +
 	"\vcom.info.v1B\tInfoProtoP\x01Z*github.com/RA341/dockman/generated/info/v1\xa2\x02\x03IXX\xaa\x02\aInfo.V1\xca\x02\aInfo\\V1\xe2\x02\x13Info\\V1\\GPBMetadata\xea\x02\bInfo::V1b\x06proto3"
 
@@ -64,12 +64,14 @@ func NewInfoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+InfoServiceGetChangelogProcedure,
 			connect.WithSchema(infoServiceMethods.ByName("GetChangelog")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getAppInfo: connect.NewClient[v1.Empty, v1.AppInfo](
 			httpClient,
 			baseURL+InfoServiceGetAppInfoProcedure,
 			connect.WithSchema(infoServiceMethods.ByName("GetAppInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		readVersion: connect.NewClient[v1.ReadVersionRequest, v1.Empty](
@@ -121,12 +123,14 @@ func NewInfoServiceHandler(svc InfoServiceHandler, opts ...connect.HandlerOption
 		InfoServiceGetChangelogProcedure,
 		svc.GetChangelog,
 		connect.WithSchema(infoServiceMethods.ByName("GetChangelog")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	infoServiceGetAppInfoHandler := connect.NewUnaryHandler(
 		InfoServiceGetAppInfoProcedure,
 		svc.GetAppInfo,
 		connect.WithSchema(infoServiceMethods.ByName("GetAppInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	infoServiceReadVersionHandler := connect.NewUnaryHandler(
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: notifications/v1/notifications.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/RA341/dockman/generated/notifications/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "notifications.v1.NotificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NotificationServiceListProcedure is the fully-qualified name of the NotificationService's List
+	// RPC.
+	NotificationServiceListProcedure = "/notifications.v1.NotificationService/List"
+	// NotificationServiceSaveProcedure is the fully-qualified name of the NotificationService's Save
+	// RPC.
+	NotificationServiceSaveProcedure = "/notifications.v1.NotificationService/Save"
+	// NotificationServiceDeleteProcedure is the fully-qualified name of the NotificationService's
+	// Delete RPC.
+	NotificationServiceDeleteProcedure = "/notifications.v1.NotificationService/Delete"
+	// NotificationServiceTestProcedure is the fully-qualified name of the NotificationService's Test
+	// RPC.
+	NotificationServiceTestProcedure = "/notifications.v1.NotificationService/Test"
+)
+
+// NotificationServiceClient is a client for the notifications.v1.NotificationService service.
+type NotificationServiceClient interface {
+	List(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListNotificationsResponse], error)
+	Save(context.Context, *connect.Request[v1.Notification]) (*connect.Response[v1.Empty], error)
+	Delete(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.Empty], error)
+	// sends a test message using a saved notification config
+	Test(context.Context, *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.Empty], error)
+}
+
+// NewNotificationServiceClient constructs a client for the notifications.v1.NotificationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	notificationServiceMethods := v1.File_notifications_v1_notifications_proto.Services().ByName("NotificationService").Methods()
+	return &notificationServiceClient{
+		list: connect.NewClient[v1.Empty, v1.ListNotificationsResponse](
+			httpClient,
+			baseURL+NotificationServiceListProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("List")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		save: connect.NewClient[v1.Notification, v1.Empty](
+			httpClient,
+			baseURL+NotificationServiceSaveProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("Save")),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[v1.DeleteNotificationRequest, v1.Empty](
+			httpClient,
+			baseURL+NotificationServiceDeleteProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("Delete")),
+			connect.WithClientOptions(opts...),
+		),
+		test: connect.NewClient[v1.TestNotificationRequest, v1.Empty](
+			httpClient,
+			baseURL+NotificationServiceTestProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("Test")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	list   *connect.Client[v1.Empty, v1.ListNotificationsResponse]
+	save   *connect.Client[v1.Notification, v1.Empty]
+	delete *connect.Client[v1.DeleteNotificationRequest, v1.Empty]
+	test   *connect.Client[v1.TestNotificationRequest, v1.Empty]
+}
+
+// List calls notifications.v1.NotificationService.List.
+func (c *notificationServiceClient) List(ctx context.Context, req *connect.Request[v1.Empty]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// Save calls notifications.v1.NotificationService.Save.
+func (c *notificationServiceClient) Save(ctx context.Context, req *connect.Request[v1.Notification]) (*connect.Response[v1.Empty], error) {
+	return c.save.CallUnary(ctx, req)
+}
+
+// Delete calls notifications.v1.NotificationService.Delete.
+func (c *notificationServiceClient) Delete(ctx context.Context, req *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// Test calls notifications.v1.NotificationService.Test.
+func (c *notificationServiceClient) Test(ctx context.Context, req *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.Empty], error) {
+	return c.test.CallUnary(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the notifications.v1.NotificationService
+// service.
+type NotificationServiceHandler interface {
+	List(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListNotificationsResponse], error)
+	Save(context.Context, *connect.Request[v1.Notification]) (*connect.Response[v1.Empty], error)
+	Delete(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.Empty], error)
+	// sends a test message using a saved notification config
+	Test(context.Context, *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.Empty], error)
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceMethods := v1.File_notifications_v1_notifications_proto.Services().ByName("NotificationService").Methods()
+	notificationServiceListHandler := connect.NewUnaryHandler(
+		NotificationServiceListProcedure,
+		svc.List,
+		connect.WithSchema(notificationServiceMethods.ByName("List")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSaveHandler := connect.NewUnaryHandler(
+		NotificationServiceSaveProcedure,
+		svc.Save,
+		connect.WithSchema(notificationServiceMethods.ByName("Save")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceDeleteHandler := connect.NewUnaryHandler(
+		NotificationServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(notificationServiceMethods.ByName("Delete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceTestHandler := connect.NewUnaryHandler(
+		NotificationServiceTestProcedure,
+		svc.Test,
+		connect.WithSchema(notificationServiceMethods.ByName("Test")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/notifications.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceListProcedure:
+			notificationServiceListHandler.ServeHTTP(w, r)
+		case NotificationServiceSaveProcedure:
+			notificationServiceSaveHandler.ServeHTTP(w, r)
+		case NotificationServiceDeleteProcedure:
+			notificationServiceDeleteHandler.ServeHTTP(w, r)
+		case NotificationServiceTestProcedure:
+			notificationServiceTestHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) List(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notifications.v1.NotificationService.List is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) Save(context.Context, *connect.Request[v1.Notification]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notifications.v1.NotificationService.Save is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) Delete(context.Context, *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notifications.v1.NotificationService.Delete is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) Test(context.Context, *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("notifications.v1.NotificationService.Test is not implemented"))
+}
@@ -0,0 +1,175 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/docker/docker/api/types/container"
+	"github.com/rs/zerolog/log"
+)
+
+const bytesInGB = 1000 * 1000 * 1000
+
+// observation is the result of a rule evaluated against a single target
+type observation struct {
+	target   string
+	value    float64
+	breached bool
+}
+
+// snapshot holds the docker state of a host, fetched once per evaluation
+type snapshot struct {
+	host string
+	srv  *docker.Service
+
+	containers []container.Summary
+	inspects   map[string]container.InspectResponse
+	stats      []docker.ContainerStats
+	volumes    []docker.VolumeInfo
+}
+
+func newSnapshot(ctx context.Context, host string, srv *docker.Service, rules []Rule) (*snapshot, error) {
+	snap := &snapshot{host: host, srv: srv}
+
+	var needInspect, needStats, needVolumes bool
+	for _, r := range rules {
+		switch r.Type {
+		case RuleRestarts, RuleUnhealthy:
+			needInspect = true
+		case RuleMemoryPercent:
+			needStats = true
+		case RuleVolumeSize:
+			needVolumes = true
+		}
+	}
+
+	var err error
+	snap.containers, err = srv.Container.ContainersList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list containers: %w", err)
+	}
+
+	if needInspect {
+		snap.inspects = make(map[string]container.InspectResponse, len(snap.containers))
+		for _, cont := range snap.containers {
+			inspect, err := srv.Container.ContainerInspect(ctx, cont.ID)
+			if err != nil {
+				log.Debug().Err(err).Str("container", cont.ID[:12]).Msg("unable to inspect container for alerts")
+				continue
+			}
+			snap.inspects[cont.ID] = inspect
+		}
+	}
+
+	if needStats {
+		snap.stats, err = srv.Container.ContainerStats(ctx, container.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to get container stats: %w", err)
+		}
+	}
+
+	if needVolumes {
+		snap.volumes, err = srv.Container.VolumesList(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list volumes: %w", err)
+		}
+	}
+
+	return snap, nil
+}
+
+func (s *Service) evaluateRule(rule *Rule, snap *snapshot, now time.Time) []observation {
+	var result []observation
+
+	switch rule.Type {
+	case RuleMemoryPercent:
+		for _, st := range snap.stats {
+			name := strings.TrimPrefix(st.Name, "/")
+			if !matchTarget(rule.Target, name) || st.MemoryLimit == 0 {
+				continue
+			}
+
+			percent := float64(st.MemoryUsage) / float64(st.MemoryLimit) * 100
+			result = append(result, observation{
+				target:   name,
+				value:    percent,
+				breached: percent > rule.Threshold,
+			})
+		}
+	case RuleRestarts:
+		for _, inspect := range snap.inspects {
+			name := strings.TrimPrefix(inspect.Name, "/")
+			if !matchTarget(rule.Target, name) {
+				continue
+			}
+
+			restarts := s.restarts.increase(snap.host, inspect.ID, rule.Duration, now)
+			result = append(result, observation{
+				target:   name,
+				value:    float64(restarts),
+				breached: float64(restarts) > rule.Threshold,
+			})
+		}
+	case RuleUnhealthy:
+		for _, inspect := range snap.inspects {
+			name := strings.TrimPrefix(inspect.Name, "/")
+			if !matchTarget(rule.Target, name) || inspect.State == nil || inspect.State.Health == nil {
+				continue
+			}
+
+			unhealthy := inspect.State.Health.Status == container.Unhealthy
+			result = append(result, observation{
+				target:   name,
+				value:    float64(inspect.State.Health.FailingStreak),
+				breached: unhealthy,
+			})
+		}
+	case RuleVolumeSize:
+		for _, vol := range snap.volumes {
+			if !matchTarget(rule.Target, vol.Name) || vol.UsageData == nil || vol.UsageData.Size < 0 {
+				continue
+			}
+
+			sizeGB := float64(vol.UsageData.Size) / bytesInGB
+			result = append(result, observation{
+				target:   vol.Name,
+				value:    sizeGB,
+				breached: sizeGB > rule.Threshold,
+			})
+		}
+	}
+
+	return result
+}
+
+func matchTarget(pattern, name string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	matched, err := path.Match(pattern, name)
+	if err != nil {
+		// invalid glob, fallback to exact match
+		return pattern == name
+	}
+	return matched
+}
+
+func describe(rule *Rule, value float64) string {
+	switch rule.Type {
+	case RuleMemoryPercent:
+		return fmt.Sprintf("memory usage is %.1f%% (threshold %.1f%%)", value, rule.Threshold)
+	case RuleRestarts:
+		return fmt.Sprintf("restarted %.0f times in the last %s (threshold %.0f)", value, rule.Duration, rule.Threshold)
+	case RuleUnhealthy:
+		return fmt.Sprintf("healthcheck is failing, failing streak %.0f", value)
+	case RuleVolumeSize:
+		return fmt.Sprintf("volume size is %.2f GB (threshold %.2f GB)", value, rule.Threshold)
+	default:
+		return fmt.Sprintf("value %.2f", value)
+	}
+}
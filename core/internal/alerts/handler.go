@@ -0,0 +1,111 @@
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/alerts/v1"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewConnectHandler(srv *Service) *Handler {
+	return &Handler{srv: srv}
+}
+
+func (h *Handler) ListRules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListRulesResponse], error) {
+	rules, err := h.srv.ListRules()
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcRules []*v1.Rule
+	for _, r := range rules {
+		rpcRules = append(rpcRules, ToProto(r))
+	}
+
+	return connect.NewResponse(&v1.ListRulesResponse{Rules: rpcRules}), nil
+}
+
+func (h *Handler) SaveRule(_ context.Context, req *connect.Request[v1.Rule]) (*connect.Response[v1.Empty], error) {
+	rule := FromProto(req.Msg)
+	if err := h.srv.SaveRule(&rule); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) DeleteRule(_ context.Context, req *connect.Request[v1.DeleteRuleRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.DeleteRule(uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) SilenceRule(_ context.Context, req *connect.Request[v1.SilenceRuleRequest]) (*connect.Response[v1.Empty], error) {
+	duration := time.Duration(req.Msg.DurationInSeconds) * time.Second
+	if err := h.srv.SilenceRule(uint(req.Msg.Id), duration); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) ListAlerts(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListAlertsResponse], error) {
+	var rpcAlerts []*v1.Alert
+	for _, a := range h.srv.ListAlerts() {
+		rpcAlerts = append(rpcAlerts, &v1.Alert{
+			RuleId:   uint64(a.RuleID),
+			RuleName: a.RuleName,
+			Host:     a.Host,
+			Target:   a.Target,
+			State:    string(a.State),
+			Value:    a.Value,
+			Since:    a.Since.Format(time.RFC3339),
+			FiredAt:  formatTime(a.FiredAt),
+			Silenced: a.Silenced,
+		})
+	}
+
+	return connect.NewResponse(&v1.ListAlertsResponse{Alerts: rpcAlerts}), nil
+}
+
+func ToProto(rule Rule) *v1.Rule {
+	return &v1.Rule{
+		Id:                uint64(rule.ID),
+		Name:              rule.Name,
+		Enable:            rule.Enable,
+		Type:              string(rule.Type),
+		Host:              rule.Host,
+		Target:            rule.Target,
+		Threshold:         rule.Threshold,
+		DurationInSeconds: int64(rule.Duration.Seconds()),
+		SilencedUntil:     formatTime(rule.SilencedUntil),
+	}
+}
+
+func FromProto(rule *v1.Rule) Rule {
+	r := Rule{
+		Name:      rule.Name,
+		Enable:    rule.Enable,
+		Type:      RuleType(rule.Type),
+		Host:      rule.Host,
+		Target:    rule.Target,
+		Threshold: rule.Threshold,
+		Duration:  time.Duration(rule.DurationInSeconds) * time.Second,
+	}
+	r.ID = uint(rule.Id)
+	return r
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
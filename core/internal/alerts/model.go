@@ -0,0 +1,104 @@
+package alerts
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RuleType string
+
+const (
+	// RuleMemoryPercent fires when container memory usage exceeds threshold percent of its limit
+	RuleMemoryPercent RuleType = "memory_percent"
+	// RuleRestarts fires when a container restarts more than threshold times within the rule duration
+	RuleRestarts RuleType = "restarts"
+	// RuleUnhealthy fires when a container healthcheck reports unhealthy
+	RuleUnhealthy RuleType = "unhealthy"
+	// RuleVolumeSize fires when a volume grows larger than threshold GB
+	RuleVolumeSize RuleType = "volume_size"
+)
+
+type Rule struct {
+	gorm.Model
+	Name   string   `gorm:"not null"`
+	Enable bool     `gorm:"not null;default:true"`
+	Type   RuleType `gorm:"not null"`
+	// host to evaluate the rule on, empty for all hosts
+	Host string
+	// container or volume name, supports globs, empty matches all
+	Target    string
+	Threshold float64
+	// how long the condition must hold before firing,
+	// for restart rules this is the window restarts are counted in
+	Duration time.Duration
+	// notifications are not sent while silenced
+	SilencedUntil time.Time
+}
+
+func (r *Rule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("rule name is required")
+	}
+
+	switch r.Type {
+	case RuleMemoryPercent, RuleVolumeSize:
+		if r.Threshold <= 0 {
+			return fmt.Errorf("threshold must be greater than 0")
+		}
+	case RuleRestarts:
+		if r.Threshold < 0 {
+			return fmt.Errorf("threshold must not be negative")
+		}
+		if r.Duration <= 0 {
+			return fmt.Errorf("restart rules require a window duration")
+		}
+	case RuleUnhealthy:
+	default:
+		return fmt.Errorf("unknown rule type: %q", r.Type)
+	}
+
+	return nil
+}
+
+func (r *Rule) Silenced(now time.Time) bool {
+	return r.SilencedUntil.After(now)
+}
+
+// time the condition must be breached before firing
+func (r *Rule) pendingFor() time.Duration {
+	if r.Type == RuleRestarts {
+		// duration is used as the counting window
+		return 0
+	}
+	return r.Duration
+}
+
+type Store interface {
+	Save(rule *Rule) error
+	Get(id uint) (*Rule, error)
+	List() ([]Rule, error)
+	Delete(id uint) error
+}
+
+type State string
+
+const (
+	StatePending State = "pending"
+	StateFiring  State = "firing"
+)
+
+// Alert is the current state of a rule for a single host and target,
+// they are only kept in memory while the condition is breached
+type Alert struct {
+	RuleID   uint
+	RuleName string
+	Host     string
+	Target   string
+	State    State
+	Value    float64
+	Since    time.Time
+	FiredAt  time.Time
+	Silenced bool
+}
@@ -0,0 +1,83 @@
+package alerts
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+type restartSample struct {
+	at    time.Time
+	count int
+}
+
+// restartTracker keeps a history of container restart counts
+// so restarts can be counted within a time window
+type restartTracker struct {
+	mu      sync.Mutex
+	samples map[string][]restartSample
+}
+
+func newRestartTracker() *restartTracker {
+	return &restartTracker{samples: make(map[string][]restartSample)}
+}
+
+func restartKey(host, containerID string) string {
+	return host + "/" + containerID
+}
+
+// record stores the current restart count and drops samples older than keep
+func (t *restartTracker) record(host, containerID string, count int, now time.Time, keep time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := restartKey(host, containerID)
+	samples := t.samples[key]
+	if n := len(samples); n > 0 && samples[n-1].count > count {
+		// counter was reset, old samples are meaningless
+		samples = nil
+	}
+
+	cutoff := now.Add(-keep)
+	kept := samples[:0]
+	for _, s := range samples {
+		if !s.at.Before(cutoff) {
+			kept = append(kept, s)
+		}
+	}
+
+	t.samples[key] = append(kept, restartSample{at: now, count: count})
+}
+
+// increase returns the number of restarts within the window
+func (t *restartTracker) increase(host, containerID string, window time.Duration, now time.Time) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	samples := t.samples[restartKey(host, containerID)]
+	if len(samples) == 0 {
+		return 0
+	}
+
+	cutoff := now.Add(-window)
+	latest := samples[len(samples)-1].count
+	for _, s := range samples {
+		if !s.at.Before(cutoff) {
+			return latest - s.count
+		}
+	}
+	return 0
+}
+
+// prune removes history of containers that no longer exist on the evaluated hosts
+func (t *restartTracker) prune(seen map[string]bool, hosts map[string]bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key := range t.samples {
+		host, _, _ := strings.Cut(key, "/")
+		if hosts[host] && !seen[key] {
+			delete(t.samples, key)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package alerts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestartTrackerIncrease(t *testing.T) {
+	tracker := newRestartTracker()
+	start := time.Now()
+	keep := 10 * time.Minute
+
+	tracker.record("local", "abc", 1, start, keep)
+	tracker.record("local", "abc", 3, start.Add(2*time.Minute), keep)
+	tracker.record("local", "abc", 6, start.Add(4*time.Minute), keep)
+
+	now := start.Add(4 * time.Minute)
+	require.Equal(t, 5, tracker.increase("local", "abc", 5*time.Minute, now))
+	require.Equal(t, 3, tracker.increase("local", "abc", 3*time.Minute, now))
+	require.Equal(t, 0, tracker.increase("local", "missing", 5*time.Minute, now))
+
+	// counter reset drops old history
+	tracker.record("local", "abc", 0, start.Add(5*time.Minute), keep)
+	require.Equal(t, 0, tracker.increase("local", "abc", 5*time.Minute, start.Add(5*time.Minute)))
+
+	tracker.prune(map[string]bool{}, map[string]bool{"local": true})
+	require.Empty(t, tracker.samples)
+}
@@ -0,0 +1,271 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/rs/zerolog/log"
+)
+
+// EvaluationInterval how often rules are evaluated against all hosts
+const EvaluationInterval = 30 * time.Second
+
+// max time allowed to fetch the state of a single host
+const evaluationTimeout = 20 * time.Second
+
+// HostProvider returns a docker service for every connected host
+type HostProvider func() map[string]*docker.Service
+
+type Service struct {
+	store Store
+	hosts HostProvider
+
+	mu sync.Mutex
+	// active alerts keyed by rule/host/target
+	alerts   map[string]*Alert
+	restarts *restartTracker
+}
+
+func NewService(store Store, hosts HostProvider) *Service {
+	srv := &Service{
+		store:    store,
+		hosts:    hosts,
+		alerts:   make(map[string]*Alert),
+		restarts: newRestartTracker(),
+	}
+
+	go srv.run()
+
+	log.Debug().Msg("Alert service loaded successfully")
+	return srv
+}
+
+// blocking function must be run a go routine
+func (s *Service) run() {
+	tick := time.NewTicker(EvaluationInterval)
+	defer tick.Stop()
+
+	for range tick.C {
+		s.Evaluate()
+	}
+}
+
+func (s *Service) ListRules() ([]Rule, error) {
+	return s.store.List()
+}
+
+func (s *Service) SaveRule(rule *Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	if rule.ID != 0 {
+		// keep silence state when editing a rule
+		existing, err := s.store.Get(rule.ID)
+		if err != nil {
+			return fmt.Errorf("unable to find rule %d: %w", rule.ID, err)
+		}
+		rule.SilencedUntil = existing.SilencedUntil
+	}
+
+	return s.store.Save(rule)
+}
+
+func (s *Service) DeleteRule(id uint) error {
+	return s.store.Delete(id)
+}
+
+// SilenceRule mutes notifications for the rule for the given duration,
+// a zero duration removes the silence
+func (s *Service) SilenceRule(id uint, duration time.Duration) error {
+	rule, err := s.store.Get(id)
+	if err != nil {
+		return fmt.Errorf("unable to find rule %d: %w", id, err)
+	}
+
+	rule.SilencedUntil = time.Time{}
+	if duration > 0 {
+		rule.SilencedUntil = time.Now().Add(duration)
+	}
+
+	return s.store.Save(rule)
+}
+
+func (s *Service) ListAlerts() []Alert {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Alert, 0, len(s.alerts))
+	for _, a := range s.alerts {
+		result = append(result, *a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Since.Before(result[j].Since)
+	})
+
+	return result
+}
+
+// Evaluate checks all enabled rules against every host
+// and sends notifications for alerts that fired or resolved
+func (s *Service) Evaluate() {
+	allRules, err := s.store.List()
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to list alert rules")
+		return
+	}
+
+	var rules []Rule
+	var restartWindow time.Duration
+	for _, r := range allRules {
+		if !r.Enable {
+			continue
+		}
+		rules = append(rules, r)
+		if r.Type == RuleRestarts {
+			restartWindow = max(restartWindow, r.Duration)
+		}
+	}
+
+	now := time.Now()
+	hosts := s.hosts()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	snapshots := make(map[string]*snapshot, len(hosts))
+	for host, srv := range hosts {
+		hostRules := rulesForHost(rules, host)
+		if len(hostRules) == 0 {
+			continue
+		}
+
+		wg.Go(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), evaluationTimeout)
+			defer cancel()
+
+			snap, err := newSnapshot(ctx, host, srv, hostRules)
+			if err != nil {
+				log.Warn().Err(err).Str("host", host).Msg("unable to evaluate alerts for host")
+				return
+			}
+
+			mu.Lock()
+			snapshots[host] = snap
+			mu.Unlock()
+		})
+	}
+	wg.Wait()
+
+	seenContainers := make(map[string]bool)
+	evaluatedHosts := make(map[string]bool, len(snapshots))
+	for host, snap := range snapshots {
+		evaluatedHosts[host] = true
+		for _, inspect := range snap.inspects {
+			seenContainers[restartKey(host, inspect.ID)] = true
+			s.restarts.record(host, inspect.ID, inspect.RestartCount, now, restartWindow)
+		}
+	}
+	s.restarts.prune(seenContainers, evaluatedHosts)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	for i := range rules {
+		rule := &rules[i]
+		for host, snap := range snapshots {
+			if rule.Host != "" && rule.Host != host {
+				continue
+			}
+
+			for _, obs := range s.evaluateRule(rule, snap, now) {
+				key := alertKey(rule.ID, host, obs.target)
+				seen[key] = true
+				s.transition(key, rule, host, obs, now)
+			}
+		}
+	}
+
+	// targets that disappeared or rules that were removed,
+	// alerts on hosts that could not be reached are kept as is
+	for key, a := range s.alerts {
+		if seen[key] {
+			continue
+		}
+		if _, ok := hosts[a.Host]; ok && !evaluatedHosts[a.Host] {
+			continue
+		}
+
+		if a.State == StateFiring {
+			s.notify(a, "RESOLVED", "alert condition no longer applies")
+		}
+		delete(s.alerts, key)
+	}
+}
+
+// transition moves an alert between pending, firing and resolved
+// must be called with the lock held
+func (s *Service) transition(key string, rule *Rule, host string, obs observation, now time.Time) {
+	a, exists := s.alerts[key]
+	if !obs.breached {
+		if exists {
+			if a.State == StateFiring {
+				a.Value = obs.value
+				s.notify(a, "RESOLVED", describe(rule, obs.value))
+			}
+			delete(s.alerts, key)
+		}
+		return
+	}
+
+	if !exists {
+		a = &Alert{
+			RuleID: rule.ID,
+			Host:   host,
+			Target: obs.target,
+			State:  StatePending,
+			Since:  now,
+		}
+		s.alerts[key] = a
+	}
+	a.RuleName = rule.Name
+	a.Value = obs.value
+	a.Silenced = rule.Silenced(now)
+
+	if a.State == StatePending && now.Sub(a.Since) >= rule.pendingFor() {
+		a.State = StateFiring
+		a.FiredAt = now
+		s.notify(a, "FIRING", describe(rule, obs.value))
+	}
+}
+
+func (s *Service) notify(a *Alert, status, description string) {
+	if a.Silenced {
+		log.Debug().Str("rule", a.RuleName).Str("target", a.Target).
+			Msg("alert is silenced, skipping notification")
+		return
+	}
+
+	subject := fmt.Sprintf("[%s] %s", status, a.RuleName)
+	body := fmt.Sprintf("host: %s\ntarget: %s\n%s", a.Host, a.Target, description)
+	notifications.Send(notifications.NewMessage(notifications.LevelAlert, subject, body))
+}
+
+func rulesForHost(rules []Rule, host string) []Rule {
+	var result []Rule
+	for _, r := range rules {
+		if r.Host == "" || r.Host == host {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func alertKey(ruleID uint, host, target string) string {
+	return fmt.Sprintf("%d/%s/%s", ruleID, host, target)
+}
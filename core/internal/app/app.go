@@ -6,13 +6,22 @@ import (
 	"strings"
 
 	"connectrpc.com/connect"
+	alertsrpc "github.com/RA341/dockman/generated/alerts/v1/v1connect"
+	auditrpc "github.com/RA341/dockman/generated/audit/v1/v1connect"
 	authrpc "github.com/RA341/dockman/generated/auth/v1/v1connect"
+	backuprpc "github.com/RA341/dockman/generated/backup/v1/v1connect"
+	cleanuprpc "github.com/RA341/dockman/generated/cleanup/v1/v1connect"
 	configrpc "github.com/RA341/dockman/generated/config/v1/v1connect"
 	dockerpc "github.com/RA341/dockman/generated/docker/v1/v1connect"
 	dockermanagerrpc "github.com/RA341/dockman/generated/docker_manager/v1/v1connect"
 	filesrpc "github.com/RA341/dockman/generated/files/v1/v1connect"
 	inforpc "github.com/RA341/dockman/generated/info/v1/v1connect"
+	notificationsrpc "github.com/RA341/dockman/generated/notifications/v1/v1connect"
+	"github.com/RA341/dockman/internal/alerts"
+	"github.com/RA341/dockman/internal/audit"
 	"github.com/RA341/dockman/internal/auth"
+	"github.com/RA341/dockman/internal/backup"
+	"github.com/RA341/dockman/internal/cleanup"
 	"github.com/RA341/dockman/internal/config"
 	"github.com/RA341/dockman/internal/database"
 	"github.com/RA341/dockman/internal/docker"
@@ -21,17 +30,25 @@ import (
 	"github.com/RA341/dockman/internal/git"
 	"github.com/RA341/dockman/internal/info"
 	"github.com/RA341/dockman/internal/lsp"
+	"github.com/RA341/dockman/internal/metrics"
+	"github.com/RA341/dockman/internal/notifications"
 	"github.com/RA341/dockman/internal/ssh"
 	"github.com/rs/zerolog/log"
 )
 
 type App struct {
+	Alerts        *alerts.Service
+	Audit         *audit.Service
 	Auth          *auth.Service
+	Backup        *backup.Service
+	Cleanup       *cleanup.Service
 	Config        *config.AppConfig
 	DockerManager *dm.Service
 	File          *files.Service
 	DB            *database.Service
 	Info          *info.Service
+	Metrics       *metrics.Service
+	Notifications *notifications.Service
 	SSH           *ssh.Service
 	UserConfigSrv *config.Service
 }
@@ -42,6 +59,10 @@ func NewApp(conf *config.AppConfig) (*App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse cookie expiry: %w", err)
 	}
+	driftInterval, err := conf.Drift.GetInterval()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse drift interval: %w", err)
+	}
 
 	dbSrv := database.NewService(conf.ConfigDir)
 	infoSrv := info.NewService(dbSrv.InfoDB)
@@ -53,6 +74,8 @@ func NewApp(conf *config.AppConfig) (*App, error) {
 		dbSrv.AuthDb,
 	)
 
+	notifSrv := notifications.InitNotificationService(dbSrv.NotifDB)
+
 	sshSrv := ssh.NewService(dbSrv.SshKeyDB, dbSrv.MachineDB)
 
 	dockerManagerSrv := dm.NewService(
@@ -70,8 +93,28 @@ func NewApp(conf *config.AppConfig) (*App, error) {
 		},
 	)
 
+	metricsSrv := metrics.NewService(dockerManagerSrv.ListServices, sshSrv)
+	auditSrv := audit.NewService(dbSrv.AuditDB, dockerManagerSrv.GetActiveClient, conf.Audit.IncludeReads)
+	alertSrv := alerts.NewService(dbSrv.AlertRuleDB, dockerManagerSrv.ListServices)
+	backupSrv := backup.NewService(
+		conf.BackupDir,
+		conf.ComposeRoot,
+		dbSrv.BackupDB,
+		dockerManagerSrv.GetService,
+		dockerManagerSrv.ListServices,
+		sshSrv,
+	)
+	cleanupSrv := cleanup.NewService(
+		dbSrv.CleanupDB,
+		dockerManagerSrv.GetService,
+		dockerManagerSrv.ListServices,
+	)
+	if driftInterval > 0 {
+		go dockerManagerSrv.StartDriftChecker(driftInterval)
+	}
+
 	fileSrv := files.NewService(
-		cr, conf.DockYaml,
+		cr, conf.DockYaml, conf.TemplateDir,
 		conf.Perms.PUID, conf.Perms.GID,
 		dockerManagerSrv.GetActiveClient,
 	)
@@ -89,10 +132,16 @@ func NewApp(conf *config.AppConfig) (*App, error) {
 	return &App{
 		Config:        conf,
 		Auth:          authSrv,
+		Backup:        backupSrv,
+		Cleanup:       cleanupSrv,
 		File:          fileSrv,
 		DockerManager: dockerManagerSrv,
 		DB:            dbSrv,
 		Info:          infoSrv,
+		Metrics:       metricsSrv,
+		Notifications: notifSrv,
+		Alerts:        alertSrv,
+		Audit:         auditSrv,
 		SSH:           sshSrv,
 		UserConfigSrv: userConfigSrv,
 	}, nil
@@ -111,10 +160,20 @@ func (a *App) Close() error {
 }
 
 func (a *App) registerApiRoutes(mux *http.ServeMux) {
-	authInterceptor := connect.WithInterceptors()
+	var interceptors []connect.Interceptor
 	if a.Config.Auth.Enable {
-		authInterceptor = connect.WithInterceptors(auth.NewInterceptor(a.Auth))
+		interceptors = append(interceptors, auth.NewInterceptor(a.Auth))
+	}
+
+	var fileHook files.ActionHook
+	var imageHook docker.ImageActionHook
+	if a.Config.Audit.Enable {
+		// runs after auth so the user is available in the context
+		interceptors = append(interceptors, audit.NewInterceptor(a.Audit))
+		fileHook = a.Audit.FileHook
+		imageHook = a.Audit.ImageHook
 	}
+	apiInterceptors := connect.WithInterceptors(interceptors...)
 
 	handlers := []func() (string, http.Handler){
 		// auth
@@ -123,28 +182,64 @@ func (a *App) registerApiRoutes(mux *http.ServeMux) {
 		},
 		// info
 		func() (string, http.Handler) {
-			return inforpc.NewInfoServiceHandler(info.NewConnectHandler(a.Info), authInterceptor)
+			return inforpc.NewInfoServiceHandler(info.NewConnectHandler(a.Info), apiInterceptors)
 		},
 		// user config
 		func() (string, http.Handler) {
-			return configrpc.NewConfigServiceHandler(config.NewConnectHandler(a.UserConfigSrv), authInterceptor)
+			return configrpc.NewConfigServiceHandler(config.NewConnectHandler(a.UserConfigSrv), apiInterceptors)
 		},
 		// files
 		func() (string, http.Handler) {
-			return filesrpc.NewFileServiceHandler(files.NewConnectHandler(a.File), authInterceptor)
+			return filesrpc.NewFileServiceHandler(files.NewConnectHandler(a.File), apiInterceptors)
 		},
 		func() (string, http.Handler) {
-			return a.registerHttpHandler("/api/file", files.NewFileHandler(a.File))
+			return a.registerHttpHandler("/api/file", files.NewFileHandler(a.File, fileHook))
 		},
 		// docker
 		func() (string, http.Handler) {
-			return dockerpc.NewDockerServiceHandler(docker.NewConnectHandler(a.DockerManager.GetService, a.Config.Updater.Addr),
-				authInterceptor,
+			return dockerpc.NewDockerServiceHandler(docker.NewConnectHandler(
+				a.DockerManager.GetService,
+				a.DockerManager.ListServices,
+				a.Config.Updater.Addr,
+				a.DockerManager.Reconnect,
+				a.File.List,
+			),
+				apiInterceptors,
 			)
 		},
+		func() (string, http.Handler) {
+			return a.registerHttpHandler("/api/docker/image", docker.NewImageHandler(
+				a.DockerManager.GetService,
+				a.DockerManager.ListServices,
+				imageHook,
+			))
+		},
+		// backup
+		func() (string, http.Handler) {
+			return backuprpc.NewBackupServiceHandler(backup.NewConnectHandler(a.Backup), apiInterceptors)
+		},
+		// cleanup
+		func() (string, http.Handler) {
+			return cleanuprpc.NewCleanupServiceHandler(cleanup.NewConnectHandler(a.Cleanup), apiInterceptors)
+		},
+		// notifications
+		func() (string, http.Handler) {
+			return notificationsrpc.NewNotificationServiceHandler(notifications.NewConnectHandler(a.Notifications), apiInterceptors)
+		},
+		// alerts
+		func() (string, http.Handler) {
+			return alertsrpc.NewAlertServiceHandler(alerts.NewConnectHandler(a.Alerts), apiInterceptors)
+		},
+		// audit
+		func() (string, http.Handler) {
+			return auditrpc.NewAuditServiceHandler(audit.NewConnectHandler(a.Audit), apiInterceptors)
+		},
+		func() (string, http.Handler) {
+			return a.registerHttpHandler("/api/audit", audit.NewExportHandler(a.Audit))
+		},
 		// git
 		//func() (string, http.Handler) {
-		//	return gitrpc.NewGitServiceHandler(git.NewConnectHandler(a.Git), authInterceptor)
+		//	return gitrpc.NewGitServiceHandler(git.NewConnectHandler(a.Git), apiInterceptors)
 		//},
 		//func() (string, http.Handler) {
 		//	return a.registerHttpHandler("/api/git", git.NewFileHandler(a.Git))
@@ -160,7 +255,7 @@ func (a *App) registerApiRoutes(mux *http.ServeMux) {
 		},
 		// host_manager
 		func() (string, http.Handler) {
-			return dockermanagerrpc.NewDockerManagerServiceHandler(dm.NewConnectHandler(a.DockerManager), authInterceptor)
+			return dockermanagerrpc.NewDockerManagerServiceHandler(dm.NewConnectHandler(a.DockerManager), apiInterceptors)
 		},
 		// lsp
 		func() (string, http.Handler) {
@@ -169,6 +264,13 @@ func (a *App) registerApiRoutes(mux *http.ServeMux) {
 		},
 	}
 
+	if a.Config.Metrics.Enable {
+		handlers = append(handlers, func() (string, http.Handler) {
+			// scraped by prometheus, uses its own token instead of cookie auth
+			return "/metrics", metrics.NewHandler(a.Metrics, a.Config.Metrics.Token)
+		})
+	}
+
 	for _, hand := range handlers {
 		path, handler := hand()
 		mux.Handle(path, handler)
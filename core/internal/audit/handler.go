@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/audit/v1"
+)
+
+const defaultQueryLimit = 100
+
+type Handler struct {
+	srv *Service
+}
+
+func NewConnectHandler(srv *Service) *Handler {
+	return &Handler{srv: srv}
+}
+
+func (h *Handler) Query(_ context.Context, req *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error) {
+	filter, err := FromProto(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	entries, total, err := h.srv.Query(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.QueryResponse{
+		Entries: toMap(entries, ToProto),
+		Total:   total,
+	}), nil
+}
+
+func ToProto(entry Entry) *v1.Entry {
+	return &v1.Entry{
+		Id:         uint64(entry.ID),
+		Time:       entry.CreatedAt.Format(time.RFC3339),
+		User:       entry.User,
+		Host:       entry.Host,
+		Procedure:  entry.Procedure,
+		Args:       entry.Args,
+		Result:     entry.Result,
+		Error:      entry.Error,
+		DurationMs: entry.Duration.Milliseconds(),
+	}
+}
+
+func FromProto(req *v1.QueryRequest) (Filter, error) {
+	filter := Filter{
+		User:       req.User,
+		Host:       req.Host,
+		Procedure:  req.Procedure,
+		FailedOnly: req.FailedOnly,
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultQueryLimit
+	}
+
+	var err error
+	if filter.From, err = parseTime(req.From); err != nil {
+		return Filter{}, fmt.Errorf("invalid from time: %w", err)
+	}
+	if filter.To, err = parseTime(req.To); err != nil {
+		return Filter{}, fmt.Errorf("invalid to time: %w", err)
+	}
+
+	return filter, nil
+}
+
+func parseTime(val string) (time.Time, error) {
+	if val == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, val)
+}
+
+func toMap[T any, Q any](input []T, mapper func(T) Q) []Q {
+	result := make([]Q, 0, len(input))
+	for _, t := range input {
+		result = append(result, mapper(t))
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	v1 "github.com/RA341/dockman/generated/audit/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// maxExportEntries limit for a single export
+const maxExportEntries = 100_000
+
+type ExportHandler struct {
+	srv *Service
+}
+
+func NewExportHandler(srv *Service) http.Handler {
+	hand := &ExportHandler{srv: srv}
+	return hand.register()
+}
+
+func (h *ExportHandler) register() http.Handler {
+	subMux := http.NewServeMux()
+	// accepts the same filters as the Query rpc as query params
+	// eg: /export/csv?user=admin&failedOnly=true
+	subMux.HandleFunc("GET /export/{format}", h.export)
+
+	return subMux
+}
+
+func (h *ExportHandler) export(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	failedOnly, _ := strconv.ParseBool(query.Get("failedOnly"))
+	filter, err := FromProto(&v1.QueryRequest{
+		User:       query.Get("user"),
+		Host:       query.Get("host"),
+		Procedure:  query.Get("procedure"),
+		FailedOnly: failedOnly,
+		From:       query.Get("from"),
+		To:         query.Get("to"),
+		Limit:      maxExportEntries,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entries, _, err := h.srv.Query(filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Error querying audit log")
+		http.Error(w, "Error querying audit log", http.StatusInternalServerError)
+		return
+	}
+
+	format := r.PathValue("format")
+	filename := fmt.Sprintf("dockman-audit-%s.%s", time.Now().Format("20060102-150405"), format)
+
+	switch format {
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		if err = writeJSON(w, entries); err != nil {
+			log.Error().Err(err).Msg("Error writing audit json export")
+		}
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		if err = writeCSV(w, entries); err != nil {
+			log.Error().Err(err).Msg("Error writing audit csv export")
+		}
+	default:
+		http.Error(w, "format must be csv or json", http.StatusBadRequest)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, entries []Entry) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(toMap(entries, ToProto))
+}
+
+func writeCSV(w http.ResponseWriter, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	header := []string{"id", "time", "user", "host", "procedure", "args", "result", "error", "durationMs"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		row := []string{
+			strconv.FormatUint(uint64(e.ID), 10),
+			e.CreatedAt.Format(time.RFC3339),
+			e.User,
+			e.Host,
+			e.Procedure,
+			e.Args,
+			e.Result,
+			e.Error,
+			strconv.FormatInt(e.Duration.Milliseconds(), 10),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
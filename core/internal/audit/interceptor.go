@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/RA341/dockman/internal/auth"
+)
+
+// Interceptor records rpc calls to the audit log,
+// must be placed after the auth interceptor to record the user
+type Interceptor struct {
+	srv *Service
+}
+
+func NewInterceptor(srv *Service) *Interceptor {
+	return &Interceptor{srv: srv}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if !i.srv.shouldRecord(isReadOnly(req.Spec())) {
+			return next(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		i.srv.record(ctx.Value(auth.KeyUserCtx), procedure, encodeArgs(req.Any()), err, time.Since(start))
+
+		return resp, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(
+		ctx context.Context,
+		conn connect.StreamingHandlerConn,
+	) error {
+		procedure := conn.Spec().Procedure
+		if !i.srv.shouldRecord(isReadOnly(conn.Spec())) {
+			return next(ctx, conn)
+		}
+
+		recConn := &recordingConn{StreamingHandlerConn: conn}
+
+		start := time.Now()
+		err := next(ctx, recConn)
+		i.srv.record(ctx.Value(auth.KeyUserCtx), procedure, encodeArgs(recConn.request), err, time.Since(start))
+
+		return err
+	}
+}
+
+func (*Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(
+		ctx context.Context,
+		spec connect.Spec,
+	) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// recordingConn captures the first message received on a stream,
+// for server streams this is the request
+type recordingConn struct {
+	connect.StreamingHandlerConn
+	request any
+}
+
+func (c *recordingConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil && c.request == nil {
+		c.request = msg
+	}
+	return err
+}
+
+// isReadOnly reports if a procedure is marked with
+// idempotency_level = NO_SIDE_EFFECTS in its proto
+func isReadOnly(spec connect.Spec) bool {
+	return spec.IdempotencyLevel == connect.IdempotencyNoSideEffects
+}
+
+func resultOf(err error) string {
+	if err == nil {
+		return ResultOK
+	}
+
+	return connect.CodeOf(err).String()
+}
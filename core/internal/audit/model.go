@@ -0,0 +1,46 @@
+package audit
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const ResultOK = "ok"
+
+type Entry struct {
+	gorm.Model
+	User      string `gorm:"index"`
+	Host      string `gorm:"index"`
+	Procedure string `gorm:"index;not null"`
+	// json encoded request with secrets redacted
+	Args   string
+	Result string `gorm:"not null"`
+	Error  string
+	// time taken to handle the request
+	Duration time.Duration
+}
+
+// TableName specifies the table name for the Entry model
+func (Entry) TableName() string {
+	return "audit_log"
+}
+
+// Filter for querying the audit log, empty fields are ignored
+type Filter struct {
+	User      string
+	Host      string
+	Procedure string
+	// only return failed requests
+	FailedOnly bool
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+type Store interface {
+	Save(entry *Entry) error
+	// Query returns entries newest first and the total number of matching entries
+	Query(filter Filter) ([]Entry, int64, error)
+}
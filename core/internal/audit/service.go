@@ -0,0 +1,173 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/auth"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// entryQueueSize max entries waiting to be written to the DB
+const entryQueueSize = 200
+
+// maxArgsSize requests larger than this are truncated before storing
+const maxArgsSize = 4 << 10
+
+const redacted = "*REDACTED*"
+
+// fields containing any of these are never stored,
+// file contents are large and often hold credentials
+var sensitiveFields = []string{"password", "token", "secret", "key", "usercmd", "contents"}
+
+// HostProvider returns the name of the currently active docker host
+type HostProvider func() string
+
+type Service struct {
+	store Store
+	host  HostProvider
+
+	// also record read only requests such as List and Get
+	includeReads bool
+
+	entries chan *Entry
+}
+
+func NewService(store Store, host HostProvider, includeReads bool) *Service {
+	srv := &Service{
+		store:        store,
+		host:         host,
+		includeReads: includeReads,
+		entries:      make(chan *Entry, entryQueueSize),
+	}
+	go srv.worker()
+
+	log.Debug().Msg("Audit service loaded successfully")
+	return srv
+}
+
+func (s *Service) Query(filter Filter) ([]Entry, int64, error) {
+	return s.store.Query(filter)
+}
+
+// FileHook records file loads and saves made over http
+func (s *Service) FileHook(r *http.Request, action, filename string, err error, duration time.Duration) {
+	if !s.shouldRecord(action == "Load") {
+		return
+	}
+
+	args, _ := json.Marshal(map[string]string{"filename": filename})
+	s.record(r.Context().Value(auth.KeyUserCtx), "/api/file/"+action, string(args), err, duration)
+}
+
+// ImageHook records image archive uploads made over http on the host they were loaded into
+func (s *Service) ImageHook(r *http.Request, action, host string, err error, duration time.Duration) {
+	args, _ := json.Marshal(map[string]string{"host": host})
+	s.recordOn(r.Context().Value(auth.KeyUserCtx), host, "/api/docker/image/"+action, string(args), err, duration)
+}
+
+// shouldRecord skips calls without side effects unless reads are included
+func (s *Service) shouldRecord(readOnly bool) bool {
+	return s.includeReads || !readOnly
+}
+
+func (s *Service) record(userVal any, procedure, args string, err error, duration time.Duration) {
+	s.recordOn(userVal, s.host(), procedure, args, err, duration)
+}
+
+func (s *Service) recordOn(userVal any, host, procedure, args string, err error, duration time.Duration) {
+	var username string
+	if user, ok := userVal.(*auth.User); ok && user != nil {
+		username = user.Username
+	}
+
+	entry := &Entry{
+		User:      username,
+		Host:      host,
+		Procedure: procedure,
+		Args:      args,
+		Result:    resultOf(err),
+		Duration:  duration,
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	select {
+	case s.entries <- entry:
+	default:
+		log.Warn().Str("procedure", procedure).Msg("audit queue is full, dropping entry")
+	}
+}
+
+// blocking function must be run a go routine
+func (s *Service) worker() {
+	for entry := range s.entries {
+		if err := s.store.Save(entry); err != nil {
+			log.Warn().Err(err).Str("procedure", entry.Procedure).Msg("unable to save audit entry")
+		}
+	}
+}
+
+// encodeArgs converts a request to json with sensitive fields removed
+func encodeArgs(msg any) string {
+	pm, ok := msg.(proto.Message)
+	if !ok || pm == nil {
+		return ""
+	}
+
+	raw, err := protojson.Marshal(pm)
+	if err != nil {
+		return ""
+	}
+
+	var decoded any
+	if err = json.Unmarshal(raw, &decoded); err != nil {
+		return ""
+	}
+
+	encoded, err := json.Marshal(redact(decoded))
+	if err != nil {
+		return ""
+	}
+
+	if len(encoded) > maxArgsSize {
+		return string(encoded[:maxArgsSize]) + "...(truncated)"
+	}
+	return string(encoded)
+}
+
+func redact(val any) any {
+	switch v := val.(type) {
+	case map[string]any:
+		for k, inner := range v {
+			if isSensitive(k) {
+				v[k] = redacted
+				continue
+			}
+			v[k] = redact(inner)
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+	for _, s := range sensitiveFields {
+		if strings.Contains(field, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/RA341/dockman/generated/docker/v1/v1connect"
+	v1 "github.com/RA341/dockman/generated/docker_manager/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsReadOnly(t *testing.T) {
+	require.True(t, isReadOnly(connect.Spec{
+		Procedure:        v1connect.DockerServiceContainerListProcedure,
+		IdempotencyLevel: connect.IdempotencyNoSideEffects,
+	}))
+
+	require.False(t, isReadOnly(connect.Spec{Procedure: v1connect.DockerServiceComposeStartProcedure}))
+	require.False(t, isReadOnly(connect.Spec{
+		Procedure:        "/example.v1.ExampleService/Put",
+		IdempotencyLevel: connect.IdempotencyIdempotent,
+	}))
+}
+
+func TestShouldRecord(t *testing.T) {
+	srv := &Service{}
+	require.True(t, srv.shouldRecord(false))
+	require.False(t, srv.shouldRecord(true))
+
+	srv.includeReads = true
+	require.True(t, srv.shouldRecord(true))
+}
+
+func TestEncodeArgsRedacts(t *testing.T) {
+	args := encodeArgs(&v1.Machine{
+		Name:     "remote",
+		Password: "hunter2",
+	})
+
+	require.Contains(t, args, "remote")
+	require.Contains(t, args, redacted)
+	require.NotContains(t, args, "hunter2")
+}
@@ -25,7 +25,7 @@ func NewHttpAuthMiddleware(srv *Service) func(next http.Handler) http.Handler {
 				return
 			}
 
-			r.WithContext(context.WithValue(r.Context(), KeyUserCtx, u))
+			r = r.WithContext(context.WithValue(r.Context(), KeyUserCtx, u))
 			next.ServeHTTP(w, r)
 		})
 	}
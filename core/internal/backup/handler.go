@@ -0,0 +1,224 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/backup/v1"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewConnectHandler(srv *Service) *Handler {
+	return &Handler{srv: srv}
+}
+
+func (h *Handler) VolumeBackup(ctx context.Context, req *connect.Request[v1.VolumeBackupRequest]) (*connect.Response[v1.Archive], error) {
+	archive, err := h.srv.BackupVolume(
+		ctx,
+		req.Msg.Host,
+		req.Msg.Volume,
+		fromRPCLocation(req.Msg.Location),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(toRPCArchive(archive)), nil
+}
+
+func (h *Handler) VolumeRestore(ctx context.Context, req *connect.Request[v1.VolumeRestoreRequest]) (*connect.Response[v1.Empty], error) {
+	err := h.srv.RestoreVolume(
+		ctx,
+		req.Msg.Host,
+		fromRPCLocation(req.Msg.Location),
+		req.Msg.Archive,
+		RestoreOptions{
+			Volume:         req.Msg.Volume,
+			StopContainers: req.Msg.StopContainers,
+			Clear:          req.Msg.Clear,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) ListArchives(_ context.Context, req *connect.Request[v1.Location]) (*connect.Response[v1.ListArchivesResponse], error) {
+	archives, err := h.srv.ListArchives(fromRPCLocation(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcArchives []*v1.Archive
+	for _, a := range archives {
+		rpcArchives = append(rpcArchives, toRPCArchive(a))
+	}
+
+	return connect.NewResponse(&v1.ListArchivesResponse{Archives: rpcArchives}), nil
+}
+
+func (h *Handler) DeleteArchive(_ context.Context, req *connect.Request[v1.DeleteArchiveRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.DeleteArchive(fromRPCLocation(req.Msg.Location), req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func fromRPCLocation(loc *v1.Location) Location {
+	if loc == nil {
+		return Location{}
+	}
+	return Location{Machine: loc.Machine, Dir: loc.Dir}
+}
+
+func toRPCArchive(a Archive) *v1.Archive {
+	return &v1.Archive{
+		Name:     a.Name,
+		Volume:   a.Volume,
+		Size:     a.Size,
+		Created:  a.Created.Format(time.RFC3339),
+		Location: toRPCLocation(a.Location),
+	}
+}
+
+func (h *Handler) ListSchedules(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListSchedulesResponse], error) {
+	schedules, err := h.srv.ListSchedules()
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcSchedules []*v1.Schedule
+	for _, sch := range schedules {
+		rpcSchedules = append(rpcSchedules, toRPCSchedule(sch))
+	}
+
+	return connect.NewResponse(&v1.ListSchedulesResponse{Schedules: rpcSchedules}), nil
+}
+
+func (h *Handler) SaveSchedule(_ context.Context, req *connect.Request[v1.Schedule]) (*connect.Response[v1.Empty], error) {
+	sch := fromRPCSchedule(req.Msg)
+	if err := h.srv.SaveSchedule(&sch); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) DeleteSchedule(_ context.Context, req *connect.Request[v1.DeleteScheduleRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.DeleteSchedule(uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) StackBackup(ctx context.Context, req *connect.Request[v1.StackBackupRequest]) (*connect.Response[v1.BackupRecord], error) {
+	record, err := h.srv.BackupStack(
+		ctx,
+		req.Msg.Stack,
+		req.Msg.Host,
+		fromRPCLocation(req.Msg.Location),
+		req.Msg.StopContainers,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(toRPCRecord(*record)), nil
+}
+
+func (h *Handler) ListStackBackups(_ context.Context, req *connect.Request[v1.ListStackBackupsRequest]) (*connect.Response[v1.ListStackBackupsResponse], error) {
+	records, err := h.srv.ListStackBackups(req.Msg.Stack)
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcRecords []*v1.BackupRecord
+	for _, r := range records {
+		rpcRecords = append(rpcRecords, toRPCRecord(r))
+	}
+
+	return connect.NewResponse(&v1.ListStackBackupsResponse{Records: rpcRecords}), nil
+}
+
+func (h *Handler) StackRestore(ctx context.Context, req *connect.Request[v1.StackRestoreRequest]) (*connect.Response[v1.Empty], error) {
+	err := h.srv.RestoreStack(ctx, uint(req.Msg.Id), StackRestoreOptions{
+		StopContainers: req.Msg.StopContainers,
+		Clear:          req.Msg.Clear,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) DeleteStackBackup(_ context.Context, req *connect.Request[v1.DeleteStackBackupRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.DeleteStackBackup(uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func toRPCLocation(loc Location) *v1.Location {
+	return &v1.Location{Machine: loc.Machine, Dir: loc.Dir}
+}
+
+func toRPCSchedule(sch Schedule) *v1.Schedule {
+	rpcSch := &v1.Schedule{
+		Id:                uint64(sch.ID),
+		Enable:            sch.Enable,
+		Stack:             sch.Stack,
+		Host:              sch.Host,
+		Location:          toRPCLocation(sch.Location),
+		IntervalInSeconds: int64(sch.Interval.Seconds()),
+		StopContainers:    sch.StopContainers,
+		KeepLast:          int32(sch.KeepLast),
+		KeepDaily:         int32(sch.KeepDaily),
+		KeepWeekly:        int32(sch.KeepWeekly),
+	}
+	if !sch.LastRun.IsZero() {
+		rpcSch.LastRun = sch.LastRun.Format(time.RFC3339)
+	}
+	return rpcSch
+}
+
+func fromRPCSchedule(sch *v1.Schedule) Schedule {
+	result := Schedule{
+		Enable:         sch.Enable,
+		Stack:          sch.Stack,
+		Host:           sch.Host,
+		Location:       fromRPCLocation(sch.Location),
+		Interval:       time.Duration(sch.IntervalInSeconds) * time.Second,
+		StopContainers: sch.StopContainers,
+		KeepLast:       int(sch.KeepLast),
+		KeepDaily:      int(sch.KeepDaily),
+		KeepWeekly:     int(sch.KeepWeekly),
+	}
+	result.ID = uint(sch.Id)
+	return result
+}
+
+func toRPCRecord(r Record) *v1.BackupRecord {
+	return &v1.BackupRecord{
+		Id:           uint64(r.ID),
+		ScheduleId:   uint64(r.ScheduleID),
+		Stack:        r.Stack,
+		Host:         r.Host,
+		Location:     toRPCLocation(r.Location),
+		Archive:      r.Archive,
+		Size:         r.Size,
+		Status:       string(r.Status),
+		Error:        r.Error,
+		StartedAt:    r.StartedAt.Format(time.RFC3339),
+		DurationInMs: r.Duration.Milliseconds(),
+	}
+}
@@ -0,0 +1,86 @@
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RA341/dockman/internal/scheduler"
+	"gorm.io/gorm"
+)
+
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusFailed  Status = "failed"
+)
+
+// Schedule periodic backup of a single stack
+type Schedule struct {
+	gorm.Model
+	Enable bool `gorm:"not null"`
+	// compose file relative to the compose root
+	Stack string `gorm:"not null"`
+	// docker host the stack runs on, an empty host is resolved to the active host when saved
+	Host     string
+	Location `gorm:"embedded"`
+	Interval time.Duration
+	// stop the stack while its data is archived
+	StopContainers bool
+	// retention, archives matching any rule are kept,
+	// with all rules at 0 nothing is deleted
+	KeepLast   int
+	KeepDaily  int
+	KeepWeekly int
+	LastRun    time.Time
+}
+
+func (s *Schedule) Due(now time.Time) bool {
+	return scheduler.Due(s.Enable, s.LastRun, s.Interval, now)
+}
+
+func (s *Schedule) Validate() error {
+	if s.Stack == "" {
+		return fmt.Errorf("stack is required")
+	}
+	if s.Interval < time.Minute {
+		return fmt.Errorf("interval must be at least 1m")
+	}
+	if s.KeepLast < 0 || s.KeepDaily < 0 || s.KeepWeekly < 0 {
+		return fmt.Errorf("retention counts must not be negative")
+	}
+	return nil
+}
+
+// Record a single stack backup run
+type Record struct {
+	gorm.Model
+	// 0 for manual backups
+	ScheduleID uint   `gorm:"index"`
+	Stack      string `gorm:"index;not null"`
+	// host the backup was taken from and is restored to
+	Host     string
+	Location `gorm:"embedded"`
+	// archive path relative to the location dir
+	Archive   string
+	Size      int64
+	Status    Status
+	Error     string
+	StartedAt time.Time
+	Duration  time.Duration
+}
+
+type Store interface {
+	SaveSchedule(schedule *Schedule) error
+	GetSchedule(id uint) (*Schedule, error)
+	ListSchedules() ([]Schedule, error)
+	DeleteSchedule(id uint) error
+
+	SaveRecord(record *Record) error
+	GetRecord(id uint) (*Record, error)
+	// ListRecords returns records newest first, an empty stack lists all
+	ListRecords(stack string) ([]Record, error)
+	// ListScheduleRecords returns the successful records of a schedule newest first
+	ListScheduleRecords(scheduleID uint) ([]Record, error)
+	DeleteRecord(id uint) error
+}
@@ -0,0 +1,201 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/RA341/dockman/internal/scheduler"
+	"github.com/rs/zerolog/log"
+)
+
+// ScheduleCheckInterval how often schedules are checked for due backups
+const ScheduleCheckInterval = time.Minute
+
+// max time a single scheduled backup may take
+const scheduledBackupTimeout = 2 * time.Hour
+
+// RunDueSchedules runs every enabled schedule whose interval has passed, one at a time
+func (s *Service) RunDueSchedules() {
+	schedules, err := s.store.ListSchedules()
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to list backup schedules")
+		return
+	}
+	scheduler.RunDue(schedules, s.saveLastRun, s.runSchedule)
+}
+
+func (s *Service) saveLastRun(sch *Schedule, now time.Time) error {
+	sch.LastRun = now
+	err := s.store.SaveSchedule(sch)
+	if err != nil {
+		log.Warn().Err(err).Uint("schedule", sch.ID).Msg("unable to save backup schedule")
+	}
+	return err
+}
+
+func (s *Service) runSchedule(sch *Schedule) {
+	ctx, cancel := context.WithTimeout(context.Background(), scheduledBackupTimeout)
+	defer cancel()
+
+	record := &Record{
+		ScheduleID: sch.ID,
+		Stack:      sch.Stack,
+		Host:       sch.Host,
+		Location:   sch.Location,
+	}
+	if err := s.runStackBackup(ctx, record, sch.StopContainers); err != nil {
+		return
+	}
+
+	if err := s.applyRetention(sch); err != nil {
+		log.Warn().Err(err).Uint("schedule", sch.ID).Msg("unable to apply backup retention")
+	}
+}
+
+func (s *Service) ListSchedules() ([]Schedule, error) {
+	return s.store.ListSchedules()
+}
+
+func (s *Service) SaveSchedule(sch *Schedule) error {
+	if err := sch.Validate(); err != nil {
+		return err
+	}
+	// pinned so switching the active host does not move the schedule
+	sch.Host = s.hosts.Name(sch.Host)
+
+	if sch.ID != 0 {
+		// keep the run state when editing a schedule
+		existing, err := s.store.GetSchedule(sch.ID)
+		if err != nil {
+			return fmt.Errorf("unable to find schedule %d: %w", sch.ID, err)
+		}
+		sch.LastRun = existing.LastRun
+	}
+
+	return s.store.SaveSchedule(sch)
+}
+
+// DeleteSchedule removes the schedule, its archives and history are kept
+func (s *Service) DeleteSchedule(id uint) error {
+	return s.store.DeleteSchedule(id)
+}
+
+func (s *Service) ListStackBackups(stack string) ([]Record, error) {
+	return s.store.ListRecords(stack)
+}
+
+// DeleteStackBackup removes the archive of a backup and its history entry
+func (s *Service) DeleteStackBackup(id uint) error {
+	record, err := s.store.GetRecord(id)
+	if err != nil {
+		return fmt.Errorf("unable to find backup %d: %w", id, err)
+	}
+	return s.deleteRecord(record)
+}
+
+func (s *Service) deleteRecord(record *Record) error {
+	if record.Archive != "" {
+		if err := s.remove(record.Location, record.Archive); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("unable to delete archive %s: %w", record.Archive, err)
+		}
+	}
+	return s.store.DeleteRecord(record.ID)
+}
+
+// applyRetention deletes the successful backups of a schedule that no retention rule keeps
+func (s *Service) applyRetention(sch *Schedule) error {
+	records, err := s.store.ListScheduleRecords(sch.ID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, record := range expired(records, sch.KeepLast, sch.KeepDaily, sch.KeepWeekly) {
+		if err = s.deleteRecord(&record); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// expired returns the records not kept by any rule, records must be sorted newest first.
+// last keeps the newest n backups, daily and weekly keep the newest backup of each of the
+// last n days or weeks that have one. With all rules at 0 nothing expires
+func expired(records []Record, last, daily, weekly int) []Record {
+	if last == 0 && daily == 0 && weekly == 0 {
+		return nil
+	}
+
+	keep := make([]bool, len(records))
+	for i := 0; i < len(records) && i < last; i++ {
+		keep[i] = true
+	}
+	keepPeriods(records, keep, daily, func(t time.Time) string {
+		return t.UTC().Format(time.DateOnly)
+	})
+	keepPeriods(records, keep, weekly, func(t time.Time) string {
+		year, week := t.UTC().ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	})
+
+	var result []Record
+	for i, record := range records {
+		if !keep[i] {
+			result = append(result, record)
+		}
+	}
+	return result
+}
+
+// keepPeriods marks the newest record of each of the first n periods
+func keepPeriods(records []Record, keep []bool, n int, period func(time.Time) string) {
+	seen := map[string]struct{}{}
+	for i, record := range records {
+		if len(seen) >= n {
+			return
+		}
+
+		p := period(record.StartedAt)
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		keep[i] = true
+	}
+}
+
+func notifyStackBackup(record *Record) {
+	source := "Manual"
+	if record.ScheduleID != 0 {
+		source = "Scheduled"
+	}
+
+	var subject, body string
+	if record.Status == StatusSuccess {
+		subject = fmt.Sprintf("Backup of %s completed", record.Stack)
+		body = fmt.Sprintf(
+			"%s backup of %s finished in %s\nArchive: %s (%.1f MiB)",
+			source, record.Stack, record.Duration.Round(time.Second), record.Archive, float64(record.Size)/(1<<20),
+		)
+	} else {
+		subject = fmt.Sprintf("Backup of %s failed", record.Stack)
+		body = fmt.Sprintf("%s backup of %s failed: %s", source, record.Stack, record.Error)
+	}
+
+	notifications.Send(notifications.NewMessage(notifications.LevelBackup, subject, body))
+}
+
+func notifyStackRestore(record *Record, err error) {
+	subject := fmt.Sprintf("Restore of %s completed", record.Stack)
+	body := fmt.Sprintf("%s was restored from %s", record.Stack, record.Archive)
+	if err != nil {
+		subject = fmt.Sprintf("Restore of %s failed", record.Stack)
+		body = fmt.Sprintf("Restoring %s from %s failed: %v", record.Stack, record.Archive, err)
+	}
+
+	notifications.Send(notifications.NewMessage(notifications.LevelBackup, subject, body))
+}
@@ -0,0 +1,387 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/scheduler"
+	"github.com/RA341/dockman/internal/ssh"
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+)
+
+// ArchiveExt extension of all volume archives, a zstd compressed tarball
+const ArchiveExt = ".tar.zst"
+
+// DefaultRemoteDir used when a remote location has no directory set, relative to the ssh user's home
+const DefaultRemoteDir = "dockman-backups"
+
+// timestamp layout embedded in archive names, sortable and safe in file names
+const archiveTimeLayout = "20060102T150405Z"
+
+type Service struct {
+	backupDir   string
+	composeRoot string
+	store       Store
+	hosts       docker.HostResolver
+	ssh         *ssh.Service
+}
+
+func NewService(
+	backupDir, composeRoot string,
+	store Store,
+	active docker.ServiceProvider,
+	hosts docker.HostsProvider,
+	sshSrv *ssh.Service,
+) *Service {
+	if err := os.MkdirAll(backupDir, 0755); err != nil {
+		log.Fatal().Err(err).Str("dir", backupDir).Msg("unable to create backup directory")
+	}
+
+	srv := &Service{
+		backupDir:   backupDir,
+		composeRoot: composeRoot,
+		store:       store,
+		hosts:       docker.NewHostResolver(active, hosts),
+		ssh:         sshSrv,
+	}
+	go scheduler.Start(ScheduleCheckInterval, srv.RunDueSchedules)
+
+	log.Debug().Msg("Backup service loaded successfully")
+	return srv
+}
+
+// Location where archives are stored,
+// an empty Machine is the local backup dir, otherwise Dir on that ssh machine
+type Location struct {
+	Machine string
+	Dir     string
+}
+
+type Archive struct {
+	Name    string
+	Volume  string
+	Size    int64
+	Created time.Time
+	Location
+}
+
+type RestoreOptions struct {
+	// volume to restore into, defaults to the volume the archive was taken from
+	Volume string
+	// stop containers using the volume during the restore and start the running ones again after
+	StopContainers bool
+	// remove existing volume contents before extracting
+	Clear bool
+}
+
+// BackupVolume streams the volume on host into a new archive at loc
+func (s *Service) BackupVolume(ctx context.Context, host, volumeName string, loc Location) (Archive, error) {
+	if err := validVolumeName(volumeName); err != nil {
+		return Archive{}, err
+	}
+	dock, err := s.hosts.Get(host)
+	if err != nil {
+		return Archive{}, err
+	}
+
+	created := time.Now().UTC()
+	name := archiveName(volumeName, created)
+
+	size, err := s.writeArchiveTo(loc, name, func(w io.Writer) error {
+		return dock.Container.VolumeExport(ctx, volumeName, w)
+	})
+	if err != nil {
+		return Archive{}, err
+	}
+
+	log.Info().Str("volume", volumeName).Str("archive", name).Msg("volume backup complete")
+	return Archive{
+		Name:     name,
+		Volume:   volumeName,
+		Size:     size,
+		Created:  created,
+		Location: loc,
+	}, nil
+}
+
+// RestoreVolume extracts the archive name at loc into a volume on host
+func (s *Service) RestoreVolume(ctx context.Context, host string, loc Location, name string, opts RestoreOptions) error {
+	if err := validArchiveName(name); err != nil {
+		return err
+	}
+
+	target := opts.Volume
+	if target == "" {
+		volume, _, err := parseArchiveName(name)
+		if err != nil {
+			return err
+		}
+		target = volume
+	}
+	if err := validVolumeName(target); err != nil {
+		return err
+	}
+
+	dock, err := s.hosts.Get(host)
+	if err != nil {
+		return err
+	}
+
+	if opts.StopContainers {
+		containers, err := dock.Container.VolumeContainers(ctx, target)
+		if err != nil {
+			return fmt.Errorf("failed to list containers using %s: %w", target, err)
+		}
+		restart, err := stopRunning(ctx, dock, containers)
+		if err != nil {
+			return fmt.Errorf("failed to stop containers using %s: %w", target, err)
+		}
+		defer startAgain(dock, restart, target)
+	}
+
+	src, err := s.open(loc, name)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(src)
+
+	dec, err := zstd.NewReader(src)
+	if err != nil {
+		return fmt.Errorf("failed to read archive %s: %w", name, err)
+	}
+	defer dec.Close()
+
+	if err = dock.Container.VolumeImport(ctx, target, dec, opts.Clear); err != nil {
+		return err
+	}
+
+	log.Info().Str("volume", target).Str("archive", name).Msg("volume restore complete")
+	return nil
+}
+
+// ListArchives lists archives at loc, newest first
+func (s *Service) ListArchives(loc Location) ([]Archive, error) {
+	var entries []os.FileInfo
+	if loc.Machine == "" {
+		dirEntries, err := os.ReadDir(s.backupDir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read backup directory: %w", err)
+		}
+		for _, e := range dirEntries {
+			info, err := e.Info()
+			if err != nil {
+				continue
+			}
+			entries = append(entries, info)
+		}
+	} else {
+		cli, dir, err := s.remote(loc)
+		if err != nil {
+			return nil, err
+		}
+		entries, err = cli.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s on %s: %w", dir, loc.Machine, err)
+		}
+	}
+
+	var archives []Archive
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ArchiveExt) {
+			continue
+		}
+		vol, created, err := parseArchiveName(e.Name())
+		if err != nil {
+			continue
+		}
+		archives = append(archives, Archive{
+			Name:     e.Name(),
+			Volume:   vol,
+			Size:     e.Size(),
+			Created:  created,
+			Location: loc,
+		})
+	}
+
+	sort.Slice(archives, func(i, j int) bool {
+		return archives[i].Created.After(archives[j].Created)
+	})
+	return archives, nil
+}
+
+func (s *Service) DeleteArchive(loc Location, name string) error {
+	if err := validArchiveName(name); err != nil {
+		return err
+	}
+	return s.remove(loc, name)
+}
+
+// remove deletes an archive, name is relative to the location dir
+func (s *Service) remove(loc Location, name string) error {
+	if loc.Machine == "" {
+		return os.Remove(filepath.Join(s.backupDir, filepath.FromSlash(name)))
+	}
+
+	cli, dir, err := s.remote(loc)
+	if err != nil {
+		return err
+	}
+	return cli.Remove(path.Join(dir, name))
+}
+
+// writeArchiveTo compresses everything written by export into a new archive at loc
+// and returns the archive size, partial archives are removed on failure
+func (s *Service) writeArchiveTo(loc Location, name string, export func(w io.Writer) error) (int64, error) {
+	dest, remove, err := s.create(loc, name)
+	if err != nil {
+		return 0, err
+	}
+
+	counter := &countingWriter{w: dest}
+	err = writeArchive(counter, export)
+	if closeErr := dest.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := remove(); rmErr != nil {
+			log.Warn().Err(rmErr).Str("archive", name).Msg("failed to remove incomplete archive")
+		}
+		return 0, err
+	}
+	return counter.n, nil
+}
+
+// create opens a new archive for writing, remove deletes it again on failure.
+// name is relative to the location dir and may contain a subdirectory
+func (s *Service) create(loc Location, name string) (io.WriteCloser, func() error, error) {
+	if loc.Machine == "" {
+		full := filepath.Join(s.backupDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			return nil, nil, fmt.Errorf("unable to create archive directory: %w", err)
+		}
+		file, err := os.Create(full)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to create archive: %w", err)
+		}
+		return file, func() error { return os.Remove(full) }, nil
+	}
+
+	cli, dir, err := s.remote(loc)
+	if err != nil {
+		return nil, nil, err
+	}
+	full := path.Join(dir, name)
+	file, err := cli.Create(full)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, func() error { return cli.Remove(full) }, nil
+}
+
+func (s *Service) open(loc Location, name string) (io.ReadCloser, error) {
+	if loc.Machine == "" {
+		file, err := os.Open(filepath.Join(s.backupDir, filepath.FromSlash(name)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to open archive: %w", err)
+		}
+		return file, nil
+	}
+
+	cli, dir, err := s.remote(loc)
+	if err != nil {
+		return nil, err
+	}
+	return cli.Open(path.Join(dir, name))
+}
+
+func (s *Service) remote(loc Location) (*ssh.SftpClient, string, error) {
+	mach, ok := s.ssh.Get(loc.Machine)
+	if !ok {
+		return nil, "", fmt.Errorf("machine %s is not connected", loc.Machine)
+	}
+
+	dir := loc.Dir
+	if dir == "" {
+		dir = DefaultRemoteDir
+	}
+	return mach.SftpClient, dir, nil
+}
+
+// writeArchive compresses everything written by export into w
+func writeArchive(w io.Writer, export func(w io.Writer) error) error {
+	enc, err := zstd.NewWriter(w)
+	if err != nil {
+		return err
+	}
+
+	if err = export(enc); err != nil {
+		_ = enc.Close()
+		return err
+	}
+	return enc.Close()
+}
+
+func archiveName(volumeName string, created time.Time) string {
+	return fmt.Sprintf("%s_%s%s", volumeName, created.UTC().Format(archiveTimeLayout), ArchiveExt)
+}
+
+// parseArchiveName splits an archive name into the volume and creation time,
+// volume names may contain underscores so the timestamp is taken from the end
+func parseArchiveName(name string) (string, time.Time, error) {
+	base, ok := strings.CutSuffix(name, ArchiveExt)
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("%s is not a %s archive", name, ArchiveExt)
+	}
+
+	idx := strings.LastIndex(base, "_")
+	if idx <= 0 {
+		return "", time.Time{}, fmt.Errorf("%s is not a volume archive", name)
+	}
+
+	created, err := time.Parse(archiveTimeLayout, base[idx+1:])
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%s has an invalid timestamp: %w", name, err)
+	}
+	return base[:idx], created, nil
+}
+
+// validArchiveName rejects anything that could escape the archive directory
+func validArchiveName(name string) error {
+	if name == "" || name != filepath.Base(name) || strings.ContainsAny(name, `/\`) || name == ".." {
+		return fmt.Errorf("invalid archive name %q", name)
+	}
+	if !strings.HasSuffix(name, ArchiveExt) {
+		return fmt.Errorf("%s is not a %s archive", name, ArchiveExt)
+	}
+	return nil
+}
+
+// validVolumeName rejects names the daemon would not accept,
+// the name becomes part of archive paths
+func validVolumeName(name string) error {
+	if !volumeNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid volume name %q", name)
+	}
+	return nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,93 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveNameRoundTrip(t *testing.T) {
+	created := time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC)
+	name := archiveName("my_app_data", created)
+	require.Equal(t, "my_app_data_20250314T092653Z.tar.zst", name)
+
+	vol, parsed, err := parseArchiveName(name)
+	require.NoError(t, err)
+	require.Equal(t, "my_app_data", vol)
+	require.True(t, created.Equal(parsed))
+
+	_, _, err = parseArchiveName("notes.txt")
+	require.Error(t, err)
+	_, _, err = parseArchiveName("data_yesterday.tar.zst")
+	require.Error(t, err)
+}
+
+func TestValidArchiveName(t *testing.T) {
+	require.NoError(t, validArchiveName("data_20250314T092653Z.tar.zst"))
+	require.Error(t, validArchiveName("../data_20250314T092653Z.tar.zst"))
+	require.Error(t, validArchiveName("sub/data_20250314T092653Z.tar.zst"))
+	require.Error(t, validArchiveName("data.tar.gz"))
+	require.Error(t, validArchiveName(""))
+}
+
+func TestValidVolumeName(t *testing.T) {
+	require.NoError(t, validVolumeName("app_data"))
+	require.NoError(t, validVolumeName("app.data-1"))
+	require.Error(t, validVolumeName("../data"))
+	require.Error(t, validVolumeName("sub/data"))
+	require.Error(t, validVolumeName(""))
+
+	srv := &Service{backupDir: t.TempDir()}
+	_, err := srv.BackupVolume(context.Background(), "", "../../etc", Location{})
+	require.ErrorContains(t, err, "invalid volume name")
+	err = srv.RestoreVolume(context.Background(), "", Location{}, "data_20250314T092653Z.tar.zst", RestoreOptions{Volume: "../data"})
+	require.ErrorContains(t, err, "invalid volume name")
+}
+
+func TestWriteArchive(t *testing.T) {
+	var buf bytes.Buffer
+	err := writeArchive(&buf, func(w io.Writer) error {
+		_, err := w.Write([]byte("tar contents"))
+		return err
+	})
+	require.NoError(t, err)
+
+	dec, err := zstd.NewReader(&buf)
+	require.NoError(t, err)
+	defer dec.Close()
+
+	out, err := io.ReadAll(dec)
+	require.NoError(t, err)
+	require.Equal(t, "tar contents", string(out))
+}
+
+func TestListArchivesLocal(t *testing.T) {
+	dir := t.TempDir()
+	srv := &Service{backupDir: dir}
+
+	older := archiveName("db", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer := archiveName("db", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+	for _, name := range []string{older, newer, "readme.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644))
+	}
+
+	archives, err := srv.ListArchives(Location{})
+	require.NoError(t, err)
+	require.Len(t, archives, 2)
+	require.Equal(t, newer, archives[0].Name)
+	require.Equal(t, "db", archives[0].Volume)
+
+	require.NoError(t, srv.DeleteArchive(Location{}, older))
+	require.Error(t, srv.DeleteArchive(Location{}, "readme.md"))
+
+	archives, err = srv.ListArchives(Location{})
+	require.NoError(t, err)
+	require.Len(t, archives, 1)
+}
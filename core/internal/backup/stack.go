@@ -0,0 +1,669 @@
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/docker/docker/api/types/container"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+)
+
+// stack archives are kept in this subdirectory of a location
+const stackArchiveDir = "stacks"
+
+// layout of a stack archive, the manifest is always the first entry
+const (
+	manifestName  = "manifest.json"
+	composePrefix = "compose"
+	bindsPrefix   = "binds"
+	volumesPrefix = "volumes"
+)
+
+// manifest describes the contents of a stack archive,
+// binds are relative to the compose root
+type manifest struct {
+	Stack   string    `json:"stack"`
+	Project string    `json:"project"`
+	Created time.Time `json:"created"`
+	Binds   []string  `json:"binds"`
+	Volumes []string  `json:"volumes"`
+}
+
+type StackRestoreOptions struct {
+	// stop the stack during the restore and start the running containers again after
+	StopContainers bool
+	// remove existing bind mount and volume contents before extracting
+	Clear bool
+}
+
+// BackupStack archives the compose folder, the bind mounts under the compose root
+// and the named volumes of a stack into a single archive at loc.
+// The run is recorded in the backup history whether it succeeds or not
+func (s *Service) BackupStack(ctx context.Context, stack, host string, loc Location, stopContainers bool) (*Record, error) {
+	record := &Record{
+		Stack:    stack,
+		Host:     host,
+		Location: loc,
+	}
+	err := s.runStackBackup(ctx, record, stopContainers)
+	return record, err
+}
+
+func (s *Service) runStackBackup(ctx context.Context, record *Record, stopContainers bool) error {
+	// recorded so the restore goes back to the same host
+	record.Host = s.hosts.Name(record.Host)
+	record.StartedAt = time.Now().UTC()
+	err := s.backupStack(ctx, record, stopContainers)
+	record.Duration = time.Since(record.StartedAt)
+
+	record.Status = StatusSuccess
+	if err != nil {
+		record.Status = StatusFailed
+		record.Error = err.Error()
+	}
+	if saveErr := s.store.SaveRecord(record); saveErr != nil {
+		log.Warn().Err(saveErr).Str("stack", record.Stack).Msg("unable to save backup record")
+	}
+
+	notifyStackBackup(record)
+	return err
+}
+
+func (s *Service) backupStack(ctx context.Context, record *Record, stopContainers bool) error {
+	dock, err := s.hosts.Get(record.Host)
+	if err != nil {
+		return err
+	}
+
+	project, err := dock.Compose.LoadProject(ctx, record.Stack)
+	if err != nil {
+		return err
+	}
+	src := dock.Compose.StackSources(project)
+
+	if stopContainers {
+		containers, err := dock.Compose.ComposeList(ctx, project, false)
+		if err != nil {
+			return err
+		}
+		restart, err := stopRunning(ctx, dock, containers)
+		if err != nil {
+			return fmt.Errorf("failed to stop %s: %w", record.Stack, err)
+		}
+		defer startAgain(dock, restart, record.Stack)
+	}
+
+	record.Archive = path.Join(stackArchiveDir, archiveName(stackArchiveBase(record.Stack), record.StartedAt))
+	record.Size, err = s.writeArchiveTo(record.Location, record.Archive, func(w io.Writer) error {
+		return s.writeStackArchive(ctx, dock, record, src, w)
+	})
+	if err != nil {
+		record.Archive = ""
+		return err
+	}
+
+	log.Info().Str("stack", record.Stack).Str("archive", record.Archive).Msg("stack backup complete")
+	return nil
+}
+
+func (s *Service) writeStackArchive(ctx context.Context, dock *docker.Service, record *Record, src docker.StackSources, w io.Writer) error {
+	man := manifest{
+		Stack:   record.Stack,
+		Project: src.Project,
+		Created: record.StartedAt,
+		Volumes: src.Volumes,
+	}
+	for _, bind := range src.Binds {
+		man.Binds = append(man.Binds, s.relToRoot(bind))
+	}
+
+	tw := tar.NewWriter(w)
+	if err := writeManifest(tw, man); err != nil {
+		return err
+	}
+	if err := s.addComposeFiles(tw, src); err != nil {
+		return fmt.Errorf("failed to archive compose files: %w", err)
+	}
+
+	for i, bind := range src.Binds {
+		err := appendExport(tw, path.Join(bindsPrefix, man.Binds[i]), func(w io.Writer) error {
+			return dock.Container.BindExport(ctx, bind, w)
+		})
+		if errors.Is(err, docker.ErrNotDirectory) {
+			// file binds inside the compose folder are already part of the compose files
+			log.Debug().Str("bind", bind).Msg("skipping file bind mount")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, vol := range src.Volumes {
+		prefix := path.Join(volumesPrefix, vol)
+		// root entry so empty volumes are recreated on restore
+		err := tw.WriteHeader(&tar.Header{
+			Typeflag: tar.TypeDir,
+			Name:     prefix + "/",
+			Mode:     0755,
+			ModTime:  record.StartedAt,
+		})
+		if err != nil {
+			return err
+		}
+		err = appendExport(tw, prefix, func(w io.Writer) error {
+			return dock.Container.VolumeExport(ctx, vol, w)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// addComposeFiles adds the compose folder, skipping bind mounts which are exported from the host,
+// stacks in the compose root only add their compose files
+func (s *Service) addComposeFiles(tw *tar.Writer, src docker.StackSources) error {
+	if src.Dir == "" {
+		for _, file := range src.ComposeFiles {
+			info, err := os.Stat(file)
+			if err != nil {
+				return err
+			}
+			if err = s.addLocalFile(tw, file, info); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return filepath.WalkDir(src.Dir, func(full string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && slices.Contains(src.Binds, full) {
+			return filepath.SkipDir
+		}
+		// symlinks and special files are not archived
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return s.addLocalFile(tw, full, info)
+	})
+}
+
+func (s *Service) addLocalFile(tw *tar.Writer, full string, info fs.FileInfo) error {
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = path.Join(composePrefix, s.relToRoot(full))
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+
+	if err = tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return nil
+	}
+
+	file, err := os.Open(full)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(file)
+
+	_, err = io.Copy(tw, file)
+	return err
+}
+
+// RestoreStack restores the compose files, bind mounts and volumes of a successful backup
+// onto the host it was taken from
+func (s *Service) RestoreStack(ctx context.Context, recordID uint, opts StackRestoreOptions) error {
+	record, err := s.store.GetRecord(recordID)
+	if err != nil {
+		return fmt.Errorf("unable to find backup %d: %w", recordID, err)
+	}
+	if record.Status != StatusSuccess || record.Archive == "" {
+		return fmt.Errorf("backup %d did not complete and cannot be restored", recordID)
+	}
+
+	err = s.restoreStack(ctx, record, opts)
+	notifyStackRestore(record, err)
+	return err
+}
+
+func (s *Service) restoreStack(ctx context.Context, record *Record, opts StackRestoreOptions) error {
+	if record.Host == "" {
+		return fmt.Errorf("backup %d does not record the host it was taken from", record.ID)
+	}
+	dock, err := s.hosts.Get(record.Host)
+	if err != nil {
+		return fmt.Errorf("unable to restore onto %s: %w", record.Host, err)
+	}
+
+	if opts.StopContainers {
+		// a deleted stack has nothing running, the restore brings its files back
+		project, err := dock.Compose.LoadProject(ctx, record.Stack)
+		if err != nil {
+			log.Warn().Err(err).Str("stack", record.Stack).Msg("unable to load stack, containers are not stopped")
+		} else {
+			containers, err := dock.Compose.ComposeList(ctx, project, false)
+			if err != nil {
+				return err
+			}
+			restart, err := stopRunning(ctx, dock, containers)
+			if err != nil {
+				return fmt.Errorf("failed to stop %s: %w", record.Stack, err)
+			}
+			defer startAgain(dock, restart, record.Stack)
+		}
+	}
+
+	src, err := s.open(record.Location, record.Archive)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(src)
+
+	dec, err := zstd.NewReader(src)
+	if err != nil {
+		return fmt.Errorf("failed to read archive %s: %w", record.Archive, err)
+	}
+	defer dec.Close()
+
+	if err = s.extractStackArchive(ctx, dock, dec, opts.Clear); err != nil {
+		return err
+	}
+
+	log.Info().Str("stack", record.Stack).Str("archive", record.Archive).Msg("stack restore complete")
+	return nil
+}
+
+func (s *Service) extractStackArchive(ctx context.Context, dock *docker.Service, r io.Reader, clear bool) error {
+	tr := tar.NewReader(r)
+	man, err := readManifest(tr)
+	if err != nil {
+		return err
+	}
+
+	var current *importStream
+	defer func() {
+		if current != nil {
+			_ = current.close()
+		}
+	}()
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		kind, key, inner, ok := man.route(hdr.Name)
+		if !ok {
+			log.Warn().Str("entry", hdr.Name).Msg("skipping unknown entry in stack archive")
+			continue
+		}
+
+		if kind == composePrefix {
+			if err = s.extractComposeFile(hdr, inner, tr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if current == nil || current.kind != kind || current.key != key {
+			if current != nil {
+				err = current.close()
+				current = nil
+				if err != nil {
+					return err
+				}
+			}
+			current = s.startImport(ctx, dock, kind, key, clear)
+		}
+
+		// the group root only makes sure the target exists
+		if inner == "" {
+			continue
+		}
+		hdr.Name = inner
+		if err = current.add(hdr, tr); err != nil {
+			return err
+		}
+	}
+
+	if current != nil {
+		err = current.close()
+		current = nil
+		return err
+	}
+	return nil
+}
+
+func (s *Service) startImport(ctx context.Context, dock *docker.Service, kind, key string, clear bool) *importStream {
+	return newImportStream(kind, key, func(r io.Reader) error {
+		if kind == volumesPrefix {
+			return dock.Container.VolumeImport(ctx, key, r, clear)
+		}
+
+		target := filepath.Join(s.composeRoot, filepath.FromSlash(key))
+		if !strings.HasPrefix(target, filepath.Clean(s.composeRoot)+string(filepath.Separator)) {
+			return fmt.Errorf("bind %s escapes the compose root", key)
+		}
+		return dock.Container.BindImport(ctx, target, r, clear)
+	})
+}
+
+// extractComposeFile writes a compose folder entry back under the compose root
+func (s *Service) extractComposeFile(hdr *tar.Header, rel string, r io.Reader) error {
+	target := filepath.Join(s.composeRoot, filepath.FromSlash(rel))
+	if !strings.HasPrefix(target, filepath.Clean(s.composeRoot)+string(filepath.Separator)) {
+		return fmt.Errorf("archive entry %s escapes the compose root", hdr.Name)
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		return os.MkdirAll(target, hdr.FileInfo().Mode().Perm())
+	case tar.TypeReg:
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, hdr.FileInfo().Mode().Perm())
+		if err != nil {
+			return err
+		}
+		defer fileutil.Close(file)
+
+		_, err = io.Copy(file, r)
+		return err
+	default:
+		return nil
+	}
+}
+
+// route splits an archive entry into its group and the path inside the group,
+// bind groups are matched against the manifest since bind paths contain slashes
+func (m manifest) route(name string) (kind, key, inner string, ok bool) {
+	kind, rest, found := strings.Cut(strings.TrimSuffix(name, "/"), "/")
+	if !found {
+		return "", "", "", false
+	}
+
+	switch kind {
+	case composePrefix:
+		return kind, "", rest, rest != ""
+	case volumesPrefix:
+		key, inner, _ = strings.Cut(rest, "/")
+		return kind, key, inner, slices.Contains(m.Volumes, key)
+	case bindsPrefix:
+		for _, bind := range m.Binds {
+			if rest == bind {
+				if len(bind) > len(key) {
+					key, inner = bind, ""
+				}
+				continue
+			}
+			if after, isChild := strings.CutPrefix(rest, bind+"/"); isChild && len(bind) > len(key) {
+				key, inner = bind, after
+			}
+		}
+		return kind, key, inner, key != ""
+	}
+	return "", "", "", false
+}
+
+func writeManifest(tw *tar.Writer, man manifest) error {
+	contents, err := json.MarshalIndent(man, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     manifestName,
+		Mode:     0644,
+		Size:     int64(len(contents)),
+		ModTime:  man.Created,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(contents)
+	return err
+}
+
+func readManifest(tr *tar.Reader) (manifest, error) {
+	var man manifest
+	hdr, err := tr.Next()
+	if err != nil {
+		return man, fmt.Errorf("failed to read archive: %w", err)
+	}
+	if hdr.Name != manifestName {
+		return man, fmt.Errorf("not a stack archive, missing %s", manifestName)
+	}
+
+	if err = json.NewDecoder(tr).Decode(&man); err != nil {
+		return man, fmt.Errorf("invalid stack manifest: %w", err)
+	}
+	if err = man.validate(); err != nil {
+		return man, fmt.Errorf("invalid stack manifest: %w", err)
+	}
+	return man, nil
+}
+
+// volumeNamePattern names accepted by the docker daemon
+var volumeNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+
+// validate rejects binds outside the compose root and invalid volume names,
+// the manifest comes from an archive that may have been tampered with
+func (m manifest) validate() error {
+	for _, bind := range m.Binds {
+		if !validBind(bind) {
+			return fmt.Errorf("bind %q is not inside the compose root", bind)
+		}
+	}
+	for _, vol := range m.Volumes {
+		if !volumeNamePattern.MatchString(vol) {
+			return fmt.Errorf("invalid volume name %q", vol)
+		}
+	}
+	return nil
+}
+
+// validBind checks that a bind is a clean path relative to the compose root without leaving it
+func validBind(bind string) bool {
+	return bind != "" && bind != "." && bind != ".." &&
+		path.Clean(bind) == bind &&
+		!path.IsAbs(bind) && !filepath.IsAbs(filepath.FromSlash(bind)) &&
+		!strings.HasPrefix(bind, "../")
+}
+
+// appendExport copies the tar stream written by export into tw with every entry moved under prefix
+func appendExport(tw *tar.Writer, prefix string, export func(w io.Writer) error) error {
+	pr, pw := io.Pipe()
+	exportErr := make(chan error, 1)
+	go func() {
+		err := export(pw)
+		_ = pw.CloseWithError(err)
+		exportErr <- err
+	}()
+
+	err := appendTar(tw, pr, prefix)
+	if err == nil {
+		// drain the padding after the end of archive marker
+		_, err = io.Copy(io.Discard, pr)
+	}
+	_ = pr.CloseWithError(err)
+
+	// the export error is more useful than the broken pipe it causes
+	if expErr := <-exportErr; expErr != nil {
+		return expErr
+	}
+	return err
+}
+
+func appendTar(tw *tar.Writer, r io.Reader, prefix string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		if name == "." || name == "" {
+			continue
+		}
+		hdr.Name = path.Join(prefix, name)
+		if hdr.Typeflag == tar.TypeDir {
+			hdr.Name += "/"
+		}
+		if hdr.Typeflag == tar.TypeLink {
+			hdr.Linkname = path.Join(prefix, strings.TrimPrefix(path.Clean(hdr.Linkname), "./"))
+		}
+
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err = io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// importStream feeds the entries of one bind mount or volume group to a running import
+type importStream struct {
+	kind, key string
+	pw        *io.PipeWriter
+	tw        *tar.Writer
+	done      chan error
+}
+
+func newImportStream(kind, key string, importFn func(r io.Reader) error) *importStream {
+	pr, pw := io.Pipe()
+	st := &importStream{
+		kind: kind,
+		key:  key,
+		pw:   pw,
+		tw:   tar.NewWriter(pw),
+		done: make(chan error, 1),
+	}
+	go func() {
+		err := importFn(pr)
+		_ = pr.CloseWithError(err)
+		st.done <- err
+	}()
+	return st
+}
+
+func (st *importStream) add(hdr *tar.Header, r io.Reader) error {
+	if err := st.tw.WriteHeader(hdr); err != nil {
+		return st.fail(err)
+	}
+	if _, err := io.Copy(st.tw, r); err != nil {
+		return st.fail(err)
+	}
+	return nil
+}
+
+// fail prefers the import error over the broken pipe it caused
+func (st *importStream) fail(err error) error {
+	_ = st.pw.CloseWithError(err)
+	if importErr := <-st.done; importErr != nil {
+		st.done <- importErr
+		return importErr
+	}
+	st.done <- err
+	return err
+}
+
+func (st *importStream) close() error {
+	err := st.tw.Close()
+	_ = st.pw.CloseWithError(err)
+	if importErr := <-st.done; importErr != nil {
+		return importErr
+	}
+	return err
+}
+
+func (s *Service) relToRoot(full string) string {
+	rel, err := filepath.Rel(s.composeRoot, full)
+	if err != nil {
+		return filepath.ToSlash(full)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// stackArchiveBase names stack archives after their folder,
+// e.g. media/jellyfin/compose.yaml -> media-jellyfin, web.yaml -> web
+func stackArchiveBase(stack string) string {
+	stack = filepath.ToSlash(stack)
+	dir, file := path.Split(stack)
+	base := strings.TrimSuffix(file, path.Ext(file))
+	if dir != "" && (base == "compose" || base == "docker-compose") {
+		base = ""
+	}
+
+	name := strings.Trim(path.Join(dir, base), "/")
+	return strings.ReplaceAll(name, "/", "-")
+}
+
+// stopRunning stops the running containers and returns their ids
+func stopRunning(ctx context.Context, dock *docker.Service, containers []container.Summary) ([]string, error) {
+	var running []string
+	for _, c := range containers {
+		if c.State == "running" {
+			running = append(running, c.ID)
+		}
+	}
+	if len(running) == 0 {
+		return nil, nil
+	}
+
+	if err := dock.Container.ContainersStop(ctx, running...); err != nil {
+		return nil, err
+	}
+	return running, nil
+}
+
+// startAgain uses its own context since the request may be gone by now,
+// containers must come back regardless
+func startAgain(dock *docker.Service, ids []string, target string) {
+	if len(ids) == 0 {
+		return
+	}
+	if err := dock.Container.ContainersStart(context.Background(), ids...); err != nil {
+		log.Error().Err(err).Str("target", target).Msg("failed to restart containers")
+	}
+}
@@ -0,0 +1,171 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackArchiveBase(t *testing.T) {
+	require.Equal(t, "media-jellyfin", stackArchiveBase("media/jellyfin/compose.yaml"))
+	require.Equal(t, "web", stackArchiveBase("web/docker-compose.yml"))
+	require.Equal(t, "web", stackArchiveBase("web.yaml"))
+	require.Equal(t, "compose", stackArchiveBase("compose.yaml"))
+	require.Equal(t, "apps-db", stackArchiveBase("apps/db.yml"))
+}
+
+func TestManifestRoute(t *testing.T) {
+	man := manifest{
+		Binds:   []string{"app/data", "app/data-cache"},
+		Volumes: []string{"app_db"},
+	}
+
+	tests := []struct {
+		name  string
+		kind  string
+		key   string
+		inner string
+		ok    bool
+	}{
+		{name: "compose/app/compose.yaml", kind: composePrefix, inner: "app/compose.yaml", ok: true},
+		{name: "binds/app/data/", kind: bindsPrefix, key: "app/data", ok: true},
+		{name: "binds/app/data/sub/file", kind: bindsPrefix, key: "app/data", inner: "sub/file", ok: true},
+		{name: "binds/app/data-cache/file", kind: bindsPrefix, key: "app/data-cache", inner: "file", ok: true},
+		{name: "binds/app/other/file", kind: bindsPrefix},
+		{name: "volumes/app_db/", kind: volumesPrefix, key: "app_db", ok: true},
+		{name: "volumes/app_db/pg/base", kind: volumesPrefix, key: "app_db", inner: "pg/base", ok: true},
+		{name: "volumes/unknown/file", kind: volumesPrefix, key: "unknown"},
+		{name: "random/file"},
+	}
+
+	for _, tt := range tests {
+		kind, key, inner, ok := man.route(tt.name)
+		require.Equal(t, tt.ok, ok, tt.name)
+		if !tt.ok {
+			continue
+		}
+		require.Equal(t, tt.kind, kind, tt.name)
+		require.Equal(t, tt.key, key, tt.name)
+		require.Equal(t, tt.inner, inner, tt.name)
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	require.NoError(t, manifest{Binds: []string{"app/data", "data"}, Volumes: []string{"app_db", "app.cache-1"}}.validate())
+
+	for _, bind := range []string{"", ".", "..", "../etc", "app/../../etc", "/etc", "app/./data", "app/data/"} {
+		require.Error(t, manifest{Binds: []string{bind}}.validate(), bind)
+	}
+	for _, vol := range []string{"", "a", "../db", "db/x", "-db"} {
+		require.Error(t, manifest{Volumes: []string{vol}}.validate(), vol)
+	}
+}
+
+func TestComposeFilesRoundTrip(t *testing.T) {
+	root := t.TempDir()
+	stackDir := filepath.Join(root, "app")
+	require.NoError(t, os.MkdirAll(filepath.Join(stackDir, "config"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(stackDir, "data"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(stackDir, "compose.yaml"), []byte("services: {}"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(stackDir, "config", "app.ini"), []byte("a=1"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(stackDir, "data", "big.db"), []byte("bind"), 0644))
+
+	srv := &Service{composeRoot: root}
+	src := docker.StackSources{
+		Dir:   stackDir,
+		Binds: []string{filepath.Join(stackDir, "data")},
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	require.NoError(t, writeManifest(tw, manifest{Stack: "app/compose.yaml", Created: time.Now()}))
+	require.NoError(t, srv.addComposeFiles(tw, src))
+	require.NoError(t, tw.Close())
+
+	// bind mounts are exported from the host, not the compose folder
+	var names []string
+	tr := tar.NewReader(bytes.NewReader(buf.Bytes()))
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+	}
+	require.Equal(t, []string{
+		manifestName,
+		"compose/app/",
+		"compose/app/compose.yaml",
+		"compose/app/config/",
+		"compose/app/config/app.ini",
+	}, names)
+
+	restoreRoot := t.TempDir()
+	restore := &Service{composeRoot: restoreRoot}
+	require.NoError(t, restore.extractStackArchive(t.Context(), nil, &buf, false))
+
+	contents, err := os.ReadFile(filepath.Join(restoreRoot, "app", "config", "app.ini"))
+	require.NoError(t, err)
+	require.Equal(t, "a=1", string(contents))
+}
+
+func TestAppendExport(t *testing.T) {
+	var inner bytes.Buffer
+	itw := tar.NewWriter(&inner)
+	require.NoError(t, itw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "./", Mode: 0755}))
+	require.NoError(t, itw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "./file", Mode: 0644, Size: 2}))
+	_, err := itw.Write([]byte("hi"))
+	require.NoError(t, err)
+	require.NoError(t, itw.Close())
+
+	var outer bytes.Buffer
+	tw := tar.NewWriter(&outer)
+	err = appendExport(tw, "volumes/db", func(w io.Writer) error {
+		_, err := io.Copy(w, &inner)
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	tr := tar.NewReader(&outer)
+	hdr, err := tr.Next()
+	require.NoError(t, err)
+	require.Equal(t, "volumes/db/file", hdr.Name)
+	_, err = tr.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestExpired(t *testing.T) {
+	start := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC) // monday
+	var records []Record
+	// two backups per day for 14 days, newest first
+	for i := 0; i < 28; i++ {
+		records = append(records, Record{
+			Archive:   "stacks/app.tar.zst",
+			StartedAt: start.Add(-time.Duration(i) * 12 * time.Hour),
+		})
+		records[i].ID = uint(i + 1)
+	}
+
+	require.Empty(t, expired(records, 0, 0, 0))
+	require.Len(t, expired(records, 3, 0, 0), 25)
+
+	// newest of each of the last 7 days
+	require.Len(t, expired(records, 0, 7, 0), 21)
+
+	// last 2 and the newest of 3 weeks, the first weekly pick is also the newest backup
+	dropped := expired(records, 2, 0, 3)
+	require.Len(t, dropped, 28-2-2)
+	for _, r := range dropped {
+		require.NotEqual(t, uint(1), r.ID)
+		require.NotEqual(t, uint(2), r.ID)
+	}
+}
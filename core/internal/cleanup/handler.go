@@ -0,0 +1,138 @@
+package cleanup
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/cleanup/v1"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewConnectHandler(srv *Service) *Handler {
+	return &Handler{srv: srv}
+}
+
+func (h *Handler) ListPolicies(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListPoliciesResponse], error) {
+	policies, err := h.srv.ListPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcPolicies []*v1.Policy
+	for _, policy := range policies {
+		rpcPolicies = append(rpcPolicies, toRPCPolicy(policy))
+	}
+
+	return connect.NewResponse(&v1.ListPoliciesResponse{Policies: rpcPolicies}), nil
+}
+
+func (h *Handler) SavePolicy(_ context.Context, req *connect.Request[v1.Policy]) (*connect.Response[v1.Empty], error) {
+	policy := fromRPCPolicy(req.Msg)
+	if err := h.srv.SavePolicy(&policy); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) DeletePolicy(_ context.Context, req *connect.Request[v1.DeletePolicyRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.DeletePolicy(uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) RunPolicy(ctx context.Context, req *connect.Request[v1.RunPolicyRequest]) (*connect.Response[v1.Report], error) {
+	report, err := h.srv.RunPolicy(ctx, uint(req.Msg.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(toRPCReport(*report)), nil
+}
+
+func (h *Handler) ListReports(_ context.Context, req *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	reports, err := h.srv.ListReports(uint(req.Msg.PolicyId), int(req.Msg.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcReports []*v1.Report
+	for _, report := range reports {
+		rpcReports = append(rpcReports, toRPCReport(report))
+	}
+
+	return connect.NewResponse(&v1.ListReportsResponse{Reports: rpcReports}), nil
+}
+
+func toRPCPolicy(p Policy) *v1.Policy {
+	rpcPolicy := &v1.Policy{
+		Id:                 uint64(p.ID),
+		Name:               p.Name,
+		Enable:             p.Enable,
+		Host:               p.Host,
+		IntervalInSeconds:  int64(p.Interval.Seconds()),
+		Containers:         p.Containers,
+		Images:             p.Images,
+		AllImages:          p.AllImages,
+		Volumes:            p.Volumes,
+		AllVolumes:         p.AllVolumes,
+		Networks:           p.Networks,
+		BuildCache:         p.BuildCache,
+		BuildCacheKeep:     p.BuildCacheKeep,
+		OlderThanInSeconds: int64(p.OlderThan.Seconds()),
+		Labels:             p.Labels,
+		Notify:             p.Notify,
+	}
+	if !p.LastRun.IsZero() {
+		rpcPolicy.LastRun = p.LastRun.Format(time.RFC3339)
+	}
+	return rpcPolicy
+}
+
+func fromRPCPolicy(p *v1.Policy) Policy {
+	result := Policy{
+		Name:           p.Name,
+		Enable:         p.Enable,
+		Host:           p.Host,
+		Interval:       time.Duration(p.IntervalInSeconds) * time.Second,
+		Containers:     p.Containers,
+		Images:         p.Images,
+		AllImages:      p.AllImages,
+		Volumes:        p.Volumes,
+		AllVolumes:     p.AllVolumes,
+		Networks:       p.Networks,
+		BuildCache:     p.BuildCache,
+		BuildCacheKeep: p.BuildCacheKeep,
+		OlderThan:      time.Duration(p.OlderThanInSeconds) * time.Second,
+		Labels:         p.Labels,
+		Notify:         p.Notify,
+	}
+	result.ID = uint(p.Id)
+	return result
+}
+
+func toRPCReport(r Report) *v1.Report {
+	return &v1.Report{
+		Id:                uint64(r.ID),
+		PolicyId:          uint64(r.PolicyID),
+		Policy:            r.Policy,
+		Host:              r.Host,
+		Manual:            r.Manual,
+		Status:            string(r.Status),
+		Error:             r.Error,
+		ContainersDeleted: int32(r.ContainersDeleted),
+		ImagesDeleted:     int32(r.ImagesDeleted),
+		VolumesDeleted:    int32(r.VolumesDeleted),
+		NetworksDeleted:   int32(r.NetworksDeleted),
+		CachesDeleted:     int32(r.CachesDeleted),
+		SpaceReclaimed:    r.SpaceReclaimed,
+		StartedAt:         r.StartedAt.Format(time.RFC3339),
+		DurationInMs:      r.Duration.Milliseconds(),
+	}
+}
@@ -0,0 +1,130 @@
+package cleanup
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/scheduler"
+	"gorm.io/gorm"
+)
+
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	// some object types could not be pruned
+	StatusPartial Status = "partial"
+	StatusFailed  Status = "failed"
+)
+
+// Policy periodic prune of unused objects on a single host
+type Policy struct {
+	gorm.Model
+	Name   string `gorm:"not null"`
+	Enable bool   `gorm:"not null"`
+	// docker host to prune, an empty host is resolved to the active host when saved
+	Host     string
+	Interval time.Duration
+
+	// stopped containers
+	Containers bool
+	Images     bool
+	// also tagged images without a container, otherwise only dangling images
+	AllImages bool
+	// volumes without a container
+	Volumes bool
+	// also named volumes, otherwise only anonymous volumes
+	AllVolumes bool
+	Networks   bool
+	BuildCache bool
+	// keep the most recently used build cache up to this many bytes
+	BuildCacheKeep int64
+
+	// only prune objects older than this, 0 prunes regardless of age
+	OlderThan time.Duration
+	// key or key=value, prefix with ! to skip matching objects, eg: !keep
+	Labels []string `gorm:"serializer:json"`
+
+	// send the report of scheduled runs as a notification
+	Notify  bool
+	LastRun time.Time
+}
+
+func (p *Policy) Due(now time.Time) bool {
+	return scheduler.Due(p.Enable, p.LastRun, p.Interval, now)
+}
+
+func (p *Policy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("policy name is required")
+	}
+	if p.Interval < time.Minute {
+		return fmt.Errorf("interval must be at least 1m")
+	}
+	if !p.Containers && !p.Images && !p.Volumes && !p.Networks && !p.BuildCache {
+		return fmt.Errorf("select at least one object type to prune")
+	}
+	if p.OlderThan < 0 || p.BuildCacheKeep < 0 {
+		return fmt.Errorf("age and size limits must not be negative")
+	}
+	// the daemon rejects these filters instead of ignoring them
+	if p.Volumes && p.OlderThan > 0 {
+		return fmt.Errorf("volumes can not be pruned by age, use a separate policy")
+	}
+	if p.BuildCache && len(p.Labels) > 0 {
+		return fmt.Errorf("build cache can not be pruned by label, use a separate policy")
+	}
+	return nil
+}
+
+func (p *Policy) pruneOptions() docker.PruneOptions {
+	opts := docker.PruneOptions{
+		Containers:     p.Containers,
+		Images:         p.Images,
+		AllImages:      p.AllImages,
+		Volumes:        p.Volumes,
+		AllVolumes:     p.AllVolumes,
+		Networks:       p.Networks,
+		BuildCache:     p.BuildCache,
+		BuildCacheKeep: p.BuildCacheKeep,
+		Labels:         p.Labels,
+	}
+	if p.OlderThan > 0 {
+		opts.Until = p.OlderThan.String()
+	}
+	return opts
+}
+
+// Report result of a single policy run
+type Report struct {
+	gorm.Model
+	PolicyID uint `gorm:"index"`
+	Policy   string
+	Host     string
+	// run from the rpc instead of the schedule
+	Manual            bool
+	Status            Status
+	Error             string
+	ContainersDeleted int
+	ImagesDeleted     int
+	VolumesDeleted    int
+	NetworksDeleted   int
+	CachesDeleted     int
+	SpaceReclaimed    uint64
+	StartedAt         time.Time
+	Duration          time.Duration
+}
+
+type Store interface {
+	SavePolicy(policy *Policy) error
+	GetPolicy(id uint) (*Policy, error)
+	ListPolicies() ([]Policy, error)
+	DeletePolicy(id uint) error
+
+	SaveReport(report *Report) error
+	// ListReports returns at most limit reports newest first, policy 0 lists all
+	ListReports(policyID uint, limit int) ([]Report, error)
+	// TrimReports deletes all but the newest keep reports of a policy
+	TrimReports(policyID uint, keep int) error
+}
@@ -0,0 +1,42 @@
+package cleanup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	valid := Policy{Name: "images", Interval: time.Hour, Images: true, OlderThan: 14 * 24 * time.Hour, Labels: []string{"!keep"}}
+	require.NoError(t, valid.Validate())
+
+	noType := valid
+	noType.Images = false
+	require.Error(t, noType.Validate())
+
+	short := valid
+	short.Interval = time.Second
+	require.Error(t, short.Validate())
+
+	volumesByAge := valid
+	volumesByAge.Volumes = true
+	require.Error(t, volumesByAge.Validate())
+
+	cacheByLabel := valid
+	cacheByLabel.BuildCache = true
+	require.Error(t, cacheByLabel.Validate())
+}
+
+func TestPruneOptions(t *testing.T) {
+	policy := Policy{Images: true, AllImages: true, OlderThan: 14 * 24 * time.Hour, Labels: []string{"!keep"}}
+	opts := policy.pruneOptions()
+	require.Equal(t, "336h0m0s", opts.Until)
+	require.True(t, opts.AllImages)
+	require.Equal(t, []string{"!keep"}, opts.Labels)
+
+	cache := Policy{BuildCache: true, BuildCacheKeep: 10 << 30}
+	opts = cache.pruneOptions()
+	require.Empty(t, opts.Until)
+	require.Equal(t, int64(10<<30), opts.BuildCacheKeep)
+}
@@ -0,0 +1,187 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/RA341/dockman/internal/scheduler"
+	"github.com/rs/zerolog/log"
+)
+
+// PolicyCheckInterval how often policies are checked for due runs
+const PolicyCheckInterval = time.Minute
+
+// max time a single prune run may take
+const pruneTimeout = 30 * time.Minute
+
+// reports kept per policy, older ones are deleted after each run
+const reportsPerPolicy = 100
+
+type Service struct {
+	store Store
+	hosts docker.HostResolver
+}
+
+func NewService(store Store, active docker.ServiceProvider, hosts docker.HostsProvider) *Service {
+	srv := &Service{
+		store: store,
+		hosts: docker.NewHostResolver(active, hosts),
+	}
+
+	go scheduler.Start(PolicyCheckInterval, srv.RunDuePolicies)
+
+	log.Debug().Msg("Cleanup service loaded successfully")
+	return srv
+}
+
+// RunDuePolicies runs every enabled policy whose interval has passed, one at a time
+func (s *Service) RunDuePolicies() {
+	policies, err := s.store.ListPolicies()
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to list cleanup policies")
+		return
+	}
+	scheduler.RunDue(policies, s.saveLastRun, s.runScheduled)
+}
+
+func (s *Service) saveLastRun(policy *Policy, now time.Time) error {
+	policy.LastRun = now
+	err := s.store.SavePolicy(policy)
+	if err != nil {
+		log.Warn().Err(err).Uint("policy", policy.ID).Msg("unable to save cleanup policy")
+	}
+	return err
+}
+
+func (s *Service) runScheduled(policy *Policy) {
+	ctx, cancel := context.WithTimeout(context.Background(), pruneTimeout)
+	defer cancel()
+
+	report := s.run(ctx, policy, false)
+	if policy.Notify {
+		notifyReport(report)
+	}
+}
+
+// RunPolicy runs a policy now, its schedule is not changed
+func (s *Service) RunPolicy(ctx context.Context, id uint) (*Report, error) {
+	policy, err := s.store.GetPolicy(id)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find policy %d: %w", id, err)
+	}
+	return s.run(ctx, policy, true), nil
+}
+
+// run prunes the host of the policy and saves the report
+func (s *Service) run(ctx context.Context, policy *Policy, manual bool) *Report {
+	report := &Report{
+		PolicyID:  policy.ID,
+		Policy:    policy.Name,
+		Host:      policy.Host,
+		Manual:    manual,
+		StartedAt: time.Now().UTC(),
+	}
+
+	result, err := s.prune(ctx, policy)
+	report.Duration = time.Since(report.StartedAt)
+	switch {
+	case err != nil:
+		report.Status = StatusFailed
+		report.Error = err.Error()
+	case len(result.Errors) > 0:
+		report.Status = StatusPartial
+		report.Error = strings.Join(result.Errors, "\n")
+	default:
+		report.Status = StatusSuccess
+	}
+	if result != nil {
+		report.ContainersDeleted = len(result.ContainersDeleted)
+		report.ImagesDeleted = len(result.ImagesDeleted)
+		report.VolumesDeleted = len(result.VolumesDeleted)
+		report.NetworksDeleted = len(result.NetworksDeleted)
+		report.CachesDeleted = len(result.CachesDeleted)
+		report.SpaceReclaimed = result.SpaceReclaimed
+	}
+
+	if err = s.store.SaveReport(report); err != nil {
+		log.Warn().Err(err).Uint("policy", policy.ID).Msg("unable to save cleanup report")
+	}
+	if err = s.store.TrimReports(policy.ID, reportsPerPolicy); err != nil {
+		log.Warn().Err(err).Uint("policy", policy.ID).Msg("unable to trim cleanup reports")
+	}
+	return report
+}
+
+func (s *Service) prune(ctx context.Context, policy *Policy) (*docker.PruneReport, error) {
+	// never fall back to the active host, it may not be the one the policy was made for
+	if policy.Host == "" {
+		return nil, fmt.Errorf("policy %s has no host, edit it to select one", policy.Name)
+	}
+	dock, err := s.hosts.Get(policy.Host)
+	if err != nil {
+		return nil, err
+	}
+	return dock.Container.Prune(ctx, policy.pruneOptions())
+}
+
+func (s *Service) ListPolicies() ([]Policy, error) {
+	return s.store.ListPolicies()
+}
+
+func (s *Service) SavePolicy(policy *Policy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	// pinned so switching the active host does not change what is pruned
+	policy.Host = s.hosts.Name(policy.Host)
+
+	if policy.ID != 0 {
+		// keep the run state when editing a policy
+		existing, err := s.store.GetPolicy(policy.ID)
+		if err != nil {
+			return fmt.Errorf("unable to find policy %d: %w", policy.ID, err)
+		}
+		policy.LastRun = existing.LastRun
+	}
+
+	return s.store.SavePolicy(policy)
+}
+
+// DeletePolicy removes the policy, its reports are kept
+func (s *Service) DeletePolicy(id uint) error {
+	return s.store.DeletePolicy(id)
+}
+
+func (s *Service) ListReports(policyID uint, limit int) ([]Report, error) {
+	if limit <= 0 {
+		limit = reportsPerPolicy
+	}
+	return s.store.ListReports(policyID, limit)
+}
+
+func notifyReport(report *Report) {
+	host := report.Host
+	var subject, body string
+	switch report.Status {
+	case StatusFailed:
+		subject = fmt.Sprintf("Cleanup %s failed", report.Policy)
+		body = fmt.Sprintf("Pruning %s failed: %s", host, report.Error)
+	default:
+		subject = fmt.Sprintf("Cleanup %s reclaimed %.1f MiB", report.Policy, float64(report.SpaceReclaimed)/(1<<20))
+		body = fmt.Sprintf(
+			"Pruned %s in %s\nContainers: %d\nImages: %d\nVolumes: %d\nNetworks: %d\nBuild cache: %d",
+			host, report.Duration.Round(time.Second),
+			report.ContainersDeleted, report.ImagesDeleted, report.VolumesDeleted,
+			report.NetworksDeleted, report.CachesDeleted,
+		)
+		if report.Status == StatusPartial {
+			body += "\nErrors:\n" + report.Error
+		}
+	}
+
+	notifications.Send(notifications.NewMessage(notifications.LevelCleanup, subject, body))
+}
@@ -25,9 +25,14 @@ type AppConfig struct {
 	ComposeRoot    string        `config:"flag=cr,env=COMPOSE_ROOT,default=/compose,usage=Root directory for compose files"`
 	ConfigDir      string        `config:"flag=conf,env=CONFIG,default=/config,usage=Directory to store dockman config"`
 	DockYaml       string        `config:"flag=dy,env=DOCK_YAML,default=,usage=Custom path for the .dockman.yml file"`
+	TemplateDir    string        `config:"flag=templates,env=TEMPLATE_DIR,default=,usage=Directory with custom stack templates defaults to <config>/templates"`
+	BackupDir      string        `config:"flag=backups,env=BACKUP_DIR,default=,usage=Directory to store volume backups defaults to <config>/backups"`
 	Perms          FilePerms     `config:""` // indicate to parse struct
 	Auth           AuthConfig    `config:""`
 	Updater        UpdaterConfig `config:""`
+	Metrics        MetricsConfig `config:""`
+	Audit          AuditConfig   `config:""`
+	Drift          DriftConfig   `config:""`
 	Log            Logger        `config:""`
 	UIFS           fs.FS         // UIFS has no 'config' tag, so it will be ignored
 }
@@ -52,6 +57,24 @@ type UpdaterConfig struct {
 	Addr string `config:"flag=upAddr,env=UPDATER_HOST,default=http://updater:8869,usage=URL for dockman updater eg: http://localhost:8869"`
 }
 
+type MetricsConfig struct {
+	Enable bool   `config:"flag=metrics,env=METRICS_ENABLE,default=false,usage=Expose prometheus metrics at /metrics"`
+	Token  string `config:"flag=metricsToken,env=METRICS_TOKEN,default=,usage=Bearer token required to scrape /metrics leave empty to disable,hide=true"`
+}
+
+type DriftConfig struct {
+	Interval string `config:"flag=driftInterval,env=DRIFT_INTERVAL,default=0s,usage=How often to check stacks for config drift and notify 0s to disable [ns|us|ms|s|m|h]"`
+}
+
+func (d DriftConfig) GetInterval() (time.Duration, error) {
+	return time.ParseDuration(d.Interval)
+}
+
+type AuditConfig struct {
+	Enable       bool `config:"flag=audit,env=AUDIT_ENABLE,default=true,usage=Record user actions to the audit log"`
+	IncludeReads bool `config:"flag=auditReads,env=AUDIT_READS,default=false,usage=Also record read only requests such as list and get"`
+}
+
 type Logger struct {
 	Level   string `config:"flag=logLevel,env=LOG_LEVEL,default=info,usage=disabled|debug|info|warn|error|fatal"`
 	Verbose bool   `config:"flag=logVerbose,env=LOG_VERBOSE,default=false,usage=show more info in logs"`
@@ -127,6 +150,14 @@ func defaultIfNotSet(config *AppConfig) {
 		config.Port = 8866
 	}
 
+	if config.TemplateDir == "" && config.ConfigDir != "" {
+		config.TemplateDir = filepath.Join(config.ConfigDir, "templates")
+	}
+
+	if config.BackupDir == "" && config.ConfigDir != "" {
+		config.BackupDir = filepath.Join(config.ConfigDir, "backups")
+	}
+
 	if config.LocalAddr == "0.0.0.0" {
 		ip, err := getLocalIP()
 		if err == nil {
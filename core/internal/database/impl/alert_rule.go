@@ -0,0 +1,35 @@
+package impl
+
+import (
+	"github.com/RA341/dockman/internal/alerts"
+	"gorm.io/gorm"
+)
+
+type AlertRuleDB struct {
+	db *gorm.DB
+}
+
+// NewAlertRuleDB creates a new instance of AlertRuleDB.
+func NewAlertRuleDB(db *gorm.DB) *AlertRuleDB {
+	return &AlertRuleDB{db: db}
+}
+
+func (a *AlertRuleDB) Save(rule *alerts.Rule) error {
+	return a.db.Save(rule).Error
+}
+
+func (a *AlertRuleDB) Get(id uint) (*alerts.Rule, error) {
+	var rule alerts.Rule
+	result := a.db.First(&rule, id)
+	return &rule, result.Error
+}
+
+func (a *AlertRuleDB) List() ([]alerts.Rule, error) {
+	var rules []alerts.Rule
+	result := a.db.Find(&rules)
+	return rules, result.Error
+}
+
+func (a *AlertRuleDB) Delete(id uint) error {
+	return a.db.Unscoped().Delete(&alerts.Rule{}, id).Error
+}
@@ -0,0 +1,53 @@
+package impl
+
+import (
+	"github.com/RA341/dockman/internal/audit"
+	"gorm.io/gorm"
+)
+
+type AuditDB struct {
+	db *gorm.DB
+}
+
+// NewAuditDB creates a new instance of AuditDB.
+func NewAuditDB(db *gorm.DB) *AuditDB {
+	return &AuditDB{db: db}
+}
+
+func (a *AuditDB) Save(entry *audit.Entry) error {
+	return a.db.Create(entry).Error
+}
+
+func (a *AuditDB) Query(filter audit.Filter) ([]audit.Entry, int64, error) {
+	query := a.db.Model(&audit.Entry{})
+	if filter.User != "" {
+		query = query.Where("user = ?", filter.User)
+	}
+	if filter.Host != "" {
+		query = query.Where("host = ?", filter.Host)
+	}
+	if filter.Procedure != "" {
+		query = query.Where("procedure LIKE ?", "%"+filter.Procedure+"%")
+	}
+	if filter.FailedOnly {
+		query = query.Where("result <> ?", audit.ResultOK)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("created_at <= ?", filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []audit.Entry
+	result := query.Order("created_at DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&entries)
+	return entries, total, result.Error
+}
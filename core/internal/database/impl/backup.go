@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"github.com/RA341/dockman/internal/backup"
+	"gorm.io/gorm"
+)
+
+type BackupDB struct {
+	db *gorm.DB
+}
+
+// NewBackupDB creates a new instance of BackupDB.
+func NewBackupDB(db *gorm.DB) *BackupDB {
+	return &BackupDB{db: db}
+}
+
+func (b *BackupDB) SaveSchedule(schedule *backup.Schedule) error {
+	return b.db.Save(schedule).Error
+}
+
+func (b *BackupDB) GetSchedule(id uint) (*backup.Schedule, error) {
+	var schedule backup.Schedule
+	result := b.db.First(&schedule, id)
+	return &schedule, result.Error
+}
+
+func (b *BackupDB) ListSchedules() ([]backup.Schedule, error) {
+	var schedules []backup.Schedule
+	result := b.db.Find(&schedules)
+	return schedules, result.Error
+}
+
+func (b *BackupDB) DeleteSchedule(id uint) error {
+	return b.db.Unscoped().Delete(&backup.Schedule{}, id).Error
+}
+
+func (b *BackupDB) SaveRecord(record *backup.Record) error {
+	return b.db.Save(record).Error
+}
+
+func (b *BackupDB) GetRecord(id uint) (*backup.Record, error) {
+	var record backup.Record
+	result := b.db.First(&record, id)
+	return &record, result.Error
+}
+
+func (b *BackupDB) ListRecords(stack string) ([]backup.Record, error) {
+	query := b.db.Order("started_at desc")
+	if stack != "" {
+		query = query.Where("stack = ?", stack)
+	}
+
+	var records []backup.Record
+	result := query.Find(&records)
+	return records, result.Error
+}
+
+func (b *BackupDB) ListScheduleRecords(scheduleID uint) ([]backup.Record, error) {
+	var records []backup.Record
+	result := b.db.
+		Where("schedule_id = ? AND status = ?", scheduleID, backup.StatusSuccess).
+		Order("started_at desc").
+		Find(&records)
+	return records, result.Error
+}
+
+func (b *BackupDB) DeleteRecord(id uint) error {
+	return b.db.Unscoped().Delete(&backup.Record{}, id).Error
+}
@@ -0,0 +1,62 @@
+package impl
+
+import (
+	"github.com/RA341/dockman/internal/cleanup"
+	"gorm.io/gorm"
+)
+
+type CleanupDB struct {
+	db *gorm.DB
+}
+
+// NewCleanupDB creates a new instance of CleanupDB.
+func NewCleanupDB(db *gorm.DB) *CleanupDB {
+	return &CleanupDB{db: db}
+}
+
+func (c *CleanupDB) SavePolicy(policy *cleanup.Policy) error {
+	return c.db.Save(policy).Error
+}
+
+func (c *CleanupDB) GetPolicy(id uint) (*cleanup.Policy, error) {
+	var policy cleanup.Policy
+	result := c.db.First(&policy, id)
+	return &policy, result.Error
+}
+
+func (c *CleanupDB) ListPolicies() ([]cleanup.Policy, error) {
+	var policies []cleanup.Policy
+	result := c.db.Find(&policies)
+	return policies, result.Error
+}
+
+func (c *CleanupDB) DeletePolicy(id uint) error {
+	return c.db.Unscoped().Delete(&cleanup.Policy{}, id).Error
+}
+
+func (c *CleanupDB) SaveReport(report *cleanup.Report) error {
+	return c.db.Save(report).Error
+}
+
+func (c *CleanupDB) ListReports(policyID uint, limit int) ([]cleanup.Report, error) {
+	query := c.db.Order("started_at desc").Limit(limit)
+	if policyID != 0 {
+		query = query.Where("policy_id = ?", policyID)
+	}
+
+	var reports []cleanup.Report
+	result := query.Find(&reports)
+	return reports, result.Error
+}
+
+func (c *CleanupDB) TrimReports(policyID uint, keep int) error {
+	newest := c.db.Model(&cleanup.Report{}).
+		Select("id").
+		Where("policy_id = ?", policyID).
+		Order("started_at desc").
+		Limit(keep)
+
+	return c.db.Unscoped().
+		Where("policy_id = ? AND id NOT IN (?)", policyID, newest).
+		Delete(&cleanup.Report{}).Error
+}
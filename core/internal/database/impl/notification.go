@@ -0,0 +1,41 @@
+package impl
+
+import (
+	"github.com/RA341/dockman/internal/notifications"
+	"gorm.io/gorm"
+)
+
+type NotificationDB struct {
+	db *gorm.DB
+}
+
+// NewNotificationDB creates a new instance of NotificationDB.
+func NewNotificationDB(db *gorm.DB) *NotificationDB {
+	return &NotificationDB{db: db}
+}
+
+func (n *NotificationDB) Save(notif *notifications.Notification) error {
+	return n.db.Save(notif).Error
+}
+
+func (n *NotificationDB) Get(id uint) (*notifications.Notification, error) {
+	var notif notifications.Notification
+	result := n.db.First(&notif, id)
+	return &notif, result.Error
+}
+
+func (n *NotificationDB) List() ([]notifications.Notification, error) {
+	var notifs []notifications.Notification
+	result := n.db.Find(&notifs)
+	return notifs, result.Error
+}
+
+func (n *NotificationDB) GetAllByLevel(level notifications.Level) ([]notifications.Notification, error) {
+	var notifs []notifications.Notification
+	result := n.db.Where("level = ?", level).Find(&notifs)
+	return notifs, result.Error
+}
+
+func (n *NotificationDB) Delete(id uint) error {
+	return n.db.Unscoped().Delete(&notifications.Notification{}, id).Error
+}
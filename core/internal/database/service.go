@@ -1,11 +1,16 @@
 package database
 
 import (
+	"github.com/RA341/dockman/internal/alerts"
+	"github.com/RA341/dockman/internal/audit"
 	"github.com/RA341/dockman/internal/auth"
+	"github.com/RA341/dockman/internal/backup"
+	"github.com/RA341/dockman/internal/cleanup"
 	"github.com/RA341/dockman/internal/config"
 	"github.com/RA341/dockman/internal/database/impl"
 	"github.com/RA341/dockman/internal/docker"
 	"github.com/RA341/dockman/internal/info"
+	"github.com/RA341/dockman/internal/notifications"
 	"github.com/RA341/dockman/internal/ssh"
 	"github.com/rs/zerolog/log"
 )
@@ -17,6 +22,11 @@ type Service struct {
 	UserConfigDB  *impl.UserConfigDB
 	ImageUpdateDB *impl.ImageUpdateDB
 	AuthDb        *impl.AuthDB
+	NotifDB       *impl.NotificationDB
+	AlertRuleDB   *impl.AlertRuleDB
+	AuditDB       *impl.AuditDB
+	BackupDB      *impl.BackupDB
+	CleanupDB     *impl.CleanupDB
 }
 
 func NewService(basepath string) *Service {
@@ -33,6 +43,13 @@ func NewService(basepath string) *Service {
 		&config.UserConfig{},
 		&docker.ImageUpdate{},
 		&auth.User{},
+		&notifications.Notification{},
+		&alerts.Rule{},
+		&audit.Entry{},
+		&backup.Schedule{},
+		&backup.Record{},
+		&cleanup.Policy{},
+		&cleanup.Report{},
 	}
 	if err = gormDB.AutoMigrate(tables...); err != nil {
 		log.Fatal().Err(err).Msg("failed to auto migrate DB")
@@ -44,6 +61,11 @@ func NewService(basepath string) *Service {
 	verMan := impl.NewVersionHistoryManager(gormDB)
 	imgMan := impl.NewImageUpdateDB(gormDB)
 	authDb := impl.NewAuthDB(gormDB)
+	notifDb := impl.NewNotificationDB(gormDB)
+	alertDb := impl.NewAlertRuleDB(gormDB)
+	auditDb := impl.NewAuditDB(gormDB)
+	backupDb := impl.NewBackupDB(gormDB)
+	cleanupDb := impl.NewCleanupDB(gormDB)
 
 	return &Service{
 		SshKeyDB:      keyman,
@@ -52,6 +74,11 @@ func NewService(basepath string) *Service {
 		UserConfigDB:  userMan,
 		ImageUpdateDB: imgMan,
 		AuthDb:        authDb,
+		NotifDB:       notifDb,
+		AlertRuleDB:   alertDb,
+		AuditDB:       auditDb,
+		BackupDB:      backupDb,
+		CleanupDB:     cleanupDb,
 	}
 }
 
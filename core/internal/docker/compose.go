@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"io"
 	"maps"
+	"os"
 	"path/filepath"
 	"reflect"
 	"slices"
-	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/RA341/dockman/pkg/fileutil"
 	"github.com/compose-spec/compose-go/v2/cli"
@@ -20,6 +21,8 @@ import (
 	"github.com/docker/compose/v2/pkg/compose"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/goccy/go-yaml"
 	"github.com/rs/zerolog/log"
 )
 
@@ -111,6 +114,30 @@ func (s *ComposeService) ComposePull(ctx context.Context, project *types.Project
 	return nil
 }
 
+type BuildOptions struct {
+	// always attempt to pull a newer version of the base images
+	Pull bool
+	// do not use cache when building the images
+	NoCache bool
+}
+
+func (s *ComposeService) ComposeBuild(ctx context.Context, project *types.Project, composeClient api.Service, opts BuildOptions, services ...string) error {
+	if err := s.syncer.Sync(ctx, project); err != nil {
+		return err
+	}
+
+	buildOpts := api.BuildOptions{
+		Pull:     opts.Pull,
+		NoCache:  opts.NoCache,
+		Services: services,
+		Progress: "plain",
+	}
+	if err := composeClient.Build(ctx, project, buildOpts); err != nil {
+		return fmt.Errorf("compose build operation failed: %w", err)
+	}
+	return nil
+}
+
 func (s *ComposeService) ComposeUpdate(ctx context.Context, project *types.Project, composeClient api.Service, services ...string) error {
 	beforeImages, err := s.getProjectImageDigests(ctx, project)
 	if err != nil {
@@ -169,6 +196,167 @@ func (s *ComposeService) ComposeStats(ctx context.Context, project *types.Projec
 	return result, nil
 }
 
+type StackState string
+
+const (
+	StackRunning     StackState = "running"
+	StackPartial     StackState = "partial"
+	StackStopped     StackState = "stopped"
+	StackNotDeployed StackState = "not_deployed"
+	// file could not be loaded as a compose project
+	StackInvalid StackState = "invalid"
+)
+
+type StackStatus struct {
+	Filename         string
+	StackName        string
+	State            StackState
+	ExpectedServices int
+	RunningServices  int
+	// services whose container runs a different image than the one currently tagged
+	DriftedServices   []string
+	UnhealthyServices []string
+	Error             string
+}
+
+// ComposeOverview returns the status of every compose file in the list,
+// files are relative to the compose root, non compose files are skipped
+func (s *ComposeService) ComposeOverview(ctx context.Context, files []string) ([]StackStatus, error) {
+	containers, err := s.containerService.ContainersList(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list containers: %w", err)
+	}
+
+	byProject := make(map[string][]container.Summary)
+	for _, c := range containers {
+		if project := c.Labels[api.ProjectLabel]; project != "" {
+			byProject[project] = append(byProject[project], c)
+		}
+	}
+
+	images := &imageIDCache{daemon: s.daemon, ids: map[string]string{}}
+	result := parallelLoop(files, func(file string) (StackStatus, bool) {
+		if !isComposeFile(filepath.Join(s.composeRoot, file)) {
+			return StackStatus{}, false
+		}
+
+		project, err := s.LoadProject(ctx, file)
+		if err != nil {
+			return StackStatus{Filename: file, State: StackInvalid, Error: err.Error()}, true
+		}
+
+		return stackStatus(ctx, file, project, byProject[project.Name], images), true
+	})
+
+	slices.SortFunc(result, func(a, b StackStatus) int {
+		return strings.Compare(a.Filename, b.Filename)
+	})
+	return result, nil
+}
+
+func stackStatus(
+	ctx context.Context,
+	file string,
+	project *types.Project,
+	containers []container.Summary,
+	images *imageIDCache,
+) StackStatus {
+	status := StackStatus{
+		Filename:         file,
+		StackName:        project.Name,
+		ExpectedServices: len(project.Services),
+	}
+
+	running := make(map[string]bool)
+	drifted := make(map[string]bool)
+	unhealthy := make(map[string]bool)
+	for _, c := range containers {
+		svcName := c.Labels[api.ServiceLabel]
+		if c.State != container.StateRunning {
+			continue
+		}
+		running[svcName] = true
+
+		if strings.Contains(c.Status, "(unhealthy)") {
+			unhealthy[svcName] = true
+		}
+
+		svc, ok := project.Services[svcName]
+		if !ok || svc.Image == "" {
+			continue
+		}
+		if currentID := images.get(ctx, svc.Image); currentID != "" && currentID != c.ImageID {
+			drifted[svcName] = true
+		}
+	}
+
+	for svcName := range project.Services {
+		if running[svcName] {
+			status.RunningServices++
+		}
+	}
+	status.DriftedServices = slices.Sorted(maps.Keys(drifted))
+	status.UnhealthyServices = slices.Sorted(maps.Keys(unhealthy))
+
+	switch {
+	case len(containers) == 0:
+		status.State = StackNotDeployed
+	case status.RunningServices == 0:
+		status.State = StackStopped
+	case status.RunningServices < status.ExpectedServices:
+		status.State = StackPartial
+	default:
+		status.State = StackRunning
+	}
+
+	return status
+}
+
+// imageIDCache resolves image references to local image ids once per overview
+type imageIDCache struct {
+	daemon *client.Client
+
+	mu  sync.Mutex
+	ids map[string]string
+}
+
+func (c *imageIDCache) get(ctx context.Context, ref string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if id, ok := c.ids[ref]; ok {
+		return id
+	}
+
+	var id string
+	if inspect, err := c.daemon.ImageInspect(ctx, ref); err == nil {
+		id = inspect.ID
+	}
+	c.ids[ref] = id
+	return id
+}
+
+// isComposeFile checks if the file is a yaml file with a top level services key
+func isComposeFile(path string) bool {
+	ext := filepath.Ext(path)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	var doc map[string]any
+	if err = yaml.Unmarshal(contents, &doc); err != nil {
+		return false
+	}
+
+	_, ok := doc["services"]
+	return ok
+}
+
 func (s *ComposeService) getProjectImageDigests(ctx context.Context, project *types.Project) (map[string]string, error) {
 	digests := make(map[string]string)
 
@@ -213,83 +401,67 @@ func (s *ComposeService) LoadComposeClient(outputStream io.Writer, inputStream i
 	return compose.NewComposeService(dockerCli), nil
 }
 
-func (s *ComposeService) ComposeValidate(ctx context.Context, shortName string) []error {
-	var errs []error
+// ProjectOption customizes how LoadProject loads a compose file
+type ProjectOption func(*projectConfig)
 
-	project, err := s.LoadProject(ctx, shortName)
+type projectConfig struct {
+	// additional compose files relative to the compose root, merged in order
+	extraFiles []string
+	// active profiles, if empty COMPOSE_PROFILES is used
+	profiles []string
+}
+
+// WithExtraFiles merges additional compose files on top of the main file,
+// paths are relative to the compose root
+func WithExtraFiles(files ...string) ProjectOption {
+	return func(c *projectConfig) { c.extraFiles = append(c.extraFiles, files...) }
+}
+
+// WithProfiles activates the given compose profiles
+func WithProfiles(profiles ...string) ProjectOption {
+	return func(c *projectConfig) { c.profiles = append(c.profiles, profiles...) }
+}
+
+func (s *ComposeService) LoadProject(ctx context.Context, shortName string, opts ...ProjectOption) (*types.Project, error) {
+	project, err := s.loadProjectConfig(ctx, shortName, opts...)
 	if err != nil {
-		return append(errs, err)
+		return nil, err
 	}
 
-	runningContainers, err := s.containerService.ContainersList(ctx)
+	// Ensure service environment variables
+	project, err = project.WithServicesEnvironmentResolved(true)
 	if err != nil {
-		return append(errs, err)
-	}
-
-	for svcName, svc := range project.Services {
-		for _, portConfig := range svc.Ports {
-			published, err := strconv.Atoi(portConfig.Published)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("invalid port %q in service %s: %w", portConfig.Published, svcName, err))
-				continue
-			}
-
-			// check running Containers using this port
-			conflicts := s.findConflictingContainers(runningContainers, svcName, uint16(published))
-			for _, c := range conflicts {
-				errs = append(errs, fmt.Errorf(
-					"service %q wants port %d, but container %q (id=%s) is already using it",
-					svcName, published, c.Names[0], c.ID[:12],
-				))
-			}
-		}
+		return nil, fmt.Errorf("failed to resolve services environment: %w", err)
 	}
 
-	return errs
+	return project.WithoutUnnecessaryResources(), nil
 }
 
-// findConflictingContainers returns containers using the given port but not matching the service name
-func (s *ComposeService) findConflictingContainers(containers []container.Summary, serviceName string, port uint16) []container.Summary {
-	var matches []container.Summary
-	for _, c := range containers {
-		for _, p := range c.Ports {
-			if p.PublicPort == port {
-				// container names have leading "/" -> strip when comparing
-				containerName := c.Names[0]
-				if len(containerName) > 0 && containerName[0] == '/' {
-					containerName = containerName[1:]
-				}
-
-				serviceLabel := c.Labels[api.ServiceLabel]
-				if serviceLabel != serviceName {
-					matches = append(matches, c)
-				}
-			}
-		}
+// loadProjectConfig loads the compose files without reading the service env files
+func (s *ComposeService) loadProjectConfig(ctx context.Context, shortName string, opts ...ProjectOption) (*types.Project, error) {
+	var conf projectConfig
+	for _, opt := range opts {
+		opt(&conf)
 	}
 
-	return matches
-}
-
-func (s *ComposeService) LoadProject(ctx context.Context, shortName string) (*types.Project, error) {
 	fullPath := filepath.Join(s.composeRoot, shortName)
 	// will be the parent dir of the compose file else equal to compose root
 	workingDir := filepath.Dir(fullPath)
 
-	var finalEnv []string
-	for _, file := range []string{
-		// Global .env
-		filepath.Join(s.composeRoot, ".env"),
-		// Subdirectory .env (will override global)
-		filepath.Join(workingDir, ".env"),
-	} {
-		if fileutil.FileExists(file) {
-			finalEnv = append(finalEnv, file)
-		}
+	finalEnv := s.envFiles(workingDir)
+
+	composeFiles, err := s.composeFiles(fullPath, conf.extraFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	profileOpt := cli.WithDefaultProfiles()
+	if len(conf.profiles) > 0 {
+		profileOpt = cli.WithProfiles(conf.profiles)
 	}
 
 	options, err := cli.NewProjectOptions(
-		[]string{fullPath},
+		composeFiles,
 		// important maintain this order to load .env properly
 		// highest 										lowest
 		// working-dir .env <- compose root .env <- os envs
@@ -299,7 +471,7 @@ func (s *ComposeService) LoadProject(ctx context.Context, shortName string) (*ty
 		// compose operations will take place in working dir
 		cli.WithWorkingDirectory(workingDir),
 		// other shit
-		cli.WithDefaultProfiles(),
+		profileOpt,
 		cli.WithResolvedPaths(true),
 	)
 	if err != nil {
@@ -312,13 +484,55 @@ func (s *ComposeService) LoadProject(ctx context.Context, shortName string) (*ty
 	}
 
 	addServiceLabels(project)
-	// Ensure service environment variables
-	project, err = project.WithServicesEnvironmentResolved(true)
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve services environment: %w", err)
+	return project, nil
+}
+
+// composeFiles returns the main compose file followed by its override file if one exists
+// e.g. compose.yaml -> compose.override.yaml, and then any extra files
+func (s *ComposeService) composeFiles(fullPath string, extraFiles []string) ([]string, error) {
+	files := []string{fullPath}
+
+	ext := filepath.Ext(fullPath)
+	base := strings.TrimSuffix(fullPath, ext)
+	for _, overrideExt := range []string{".yaml", ".yml"} {
+		override := base + ".override" + overrideExt
+		if fileutil.FileExists(override) {
+			files = append(files, override)
+			break
+		}
 	}
 
-	return project.WithoutUnnecessaryResources(), nil
+	for _, extra := range extraFiles {
+		extraPath := filepath.Join(s.composeRoot, extra)
+		if !strings.HasPrefix(extraPath, filepath.Clean(s.composeRoot)+string(filepath.Separator)) {
+			return nil, fmt.Errorf("compose file %q is outside the compose root", extra)
+		}
+		if !fileutil.FileExists(extraPath) {
+			return nil, fmt.Errorf("compose file %q does not exist", extra)
+		}
+		if !slices.Contains(files, extraPath) {
+			files = append(files, extraPath)
+		}
+	}
+
+	return files, nil
+}
+
+// envFiles returns the existing .env files used to interpolate a compose file,
+// ordered from lowest to highest precedence
+func (s *ComposeService) envFiles(workingDir string) []string {
+	var finalEnv []string
+	for _, file := range []string{
+		// Global .env
+		filepath.Join(s.composeRoot, ".env"),
+		// Subdirectory .env (will override global)
+		filepath.Join(workingDir, ".env"),
+	} {
+		if fileutil.FileExists(file) && !slices.Contains(finalEnv, file) {
+			finalEnv = append(finalEnv, file)
+		}
+	}
+	return finalEnv
 }
 
 // todo move to config flag
@@ -0,0 +1,142 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/compose-spec/compose-go/v2/dotenv"
+	"github.com/compose-spec/compose-go/v2/template"
+	"github.com/compose-spec/compose-go/v2/utils"
+	"github.com/goccy/go-yaml"
+)
+
+type VariableSource string
+
+const (
+	SourceRootEnv   VariableSource = "root_env"
+	SourceFolderEnv VariableSource = "folder_env"
+	SourceOSEnv     VariableSource = "os_env"
+	SourceDefault   VariableSource = "default"
+	// variable is not set anywhere and has no default
+	SourceUnset VariableSource = "unset"
+)
+
+type ConfigVariable struct {
+	Name   string
+	Value  string
+	Source VariableSource
+	// default value declared in the compose file e.g. ${VAR:-default}
+	Default string
+	// declared as ${VAR:?err}
+	Required bool
+}
+
+type RenderedConfig struct {
+	// fully interpolated and normalized compose file, same as docker compose config
+	YAML      string
+	Variables []ConfigVariable
+}
+
+// ComposeConfig renders the compose file the same way it is loaded for compose operations
+// and lists where each interpolated variable got its value from
+func (s *ComposeService) ComposeConfig(ctx context.Context, shortName string, opts ...ProjectOption) (*RenderedConfig, error) {
+	project, err := s.LoadProject(ctx, shortName, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := project.MarshalYAML()
+	if err != nil {
+		return nil, fmt.Errorf("unable to render compose file: %w", err)
+	}
+
+	// variables from the main, override and extra files
+	extracted := map[string]template.Variable{}
+	for _, file := range project.ComposeFiles {
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read compose file: %w", err)
+		}
+
+		var raw map[string]any
+		if err = yaml.Unmarshal(contents, &raw); err != nil {
+			return nil, fmt.Errorf("unable to parse compose file %s: %w", file, err)
+		}
+		maps.Copy(extracted, template.ExtractVariables(raw, template.DefaultPattern))
+	}
+
+	osEnv := utils.GetAsEqualsMap(os.Environ())
+	workingDir := filepath.Dir(filepath.Join(s.composeRoot, shortName))
+	rootEnv, folderEnv, err := s.readEnvFiles(workingDir, osEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make([]ConfigVariable, 0, len(extracted))
+	for _, name := range slices.Sorted(maps.Keys(extracted)) {
+		variables = append(variables, resolveVariable(extracted[name], rootEnv, folderEnv, osEnv))
+	}
+
+	return &RenderedConfig{
+		YAML:      string(rendered),
+		Variables: variables,
+	}, nil
+}
+
+// readEnvFiles parses the compose root and folder .env files separately,
+// the folder env is empty if the compose file is in the compose root
+func (s *ComposeService) readEnvFiles(workingDir string, osEnv map[string]string) (root, folder map[string]string, err error) {
+	root = map[string]string{}
+	folder = map[string]string{}
+
+	for _, file := range s.envFiles(workingDir) {
+		env, err := dotenv.GetEnvFromFile(osEnv, []string{file})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read env file %s: %w", file, err)
+		}
+
+		if filepath.Dir(file) == filepath.Clean(s.composeRoot) {
+			root = env
+		} else {
+			folder = env
+		}
+	}
+
+	return root, folder, nil
+}
+
+// resolveVariable follows the precedence used by LoadProject
+// folder .env > compose root .env > os env > default
+func resolveVariable(v template.Variable, rootEnv, folderEnv, osEnv map[string]string) ConfigVariable {
+	result := ConfigVariable{
+		Name:     v.Name,
+		Default:  v.DefaultValue,
+		Required: v.Required,
+	}
+
+	for _, src := range []struct {
+		source VariableSource
+		env    map[string]string
+	}{
+		{SourceFolderEnv, folderEnv},
+		{SourceRootEnv, rootEnv},
+		{SourceOSEnv, osEnv},
+	} {
+		if val, ok := src.env[v.Name]; ok {
+			result.Value = val
+			result.Source = src.source
+			return result
+		}
+	}
+
+	result.Source = SourceUnset
+	if v.DefaultValue != "" {
+		result.Value = v.DefaultValue
+		result.Source = SourceDefault
+	}
+	return result
+}
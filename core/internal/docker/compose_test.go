@@ -1,10 +1,15 @@
 package docker
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/RA341/dockman/pkg/logger"
 	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
 	"github.com/stretchr/testify/require"
 )
 
@@ -45,3 +50,202 @@ func TestTrimDockman(t *testing.T) {
 	res = comp.withoutDockman(&proj)
 	require.ElementsMatch(t, []string{}, res)
 }
+
+func TestStackStatus(t *testing.T) {
+	proj := &types.Project{
+		Name: "media",
+		Services: map[string]types.ServiceConfig{
+			"web": {Name: "web"},
+			"db":  {Name: "db"},
+		},
+	}
+	cont := func(service string, state container.ContainerState, status string) container.Summary {
+		return container.Summary{
+			State:  state,
+			Status: status,
+			Labels: map[string]string{api.ServiceLabel: service, api.ProjectLabel: "media"},
+		}
+	}
+
+	st := stackStatus(context.Background(), "media/compose.yml", proj, nil, nil)
+	require.Equal(t, StackNotDeployed, st.State)
+
+	st = stackStatus(context.Background(), "media/compose.yml", proj, []container.Summary{
+		cont("web", container.StateExited, "Exited (0)"),
+	}, nil)
+	require.Equal(t, StackStopped, st.State)
+
+	st = stackStatus(context.Background(), "media/compose.yml", proj, []container.Summary{
+		cont("web", container.StateRunning, "Up 2 minutes (unhealthy)"),
+	}, nil)
+	require.Equal(t, StackPartial, st.State)
+	require.Equal(t, 1, st.RunningServices)
+	require.Equal(t, []string{"web"}, st.UnhealthyServices)
+
+	st = stackStatus(context.Background(), "media/compose.yml", proj, []container.Summary{
+		cont("web", container.StateRunning, "Up 2 minutes"),
+		cont("db", container.StateRunning, "Up 2 minutes"),
+	}, nil)
+	require.Equal(t, StackRunning, st.State)
+	require.Equal(t, 2, st.ExpectedServices)
+}
+
+func TestDiffService(t *testing.T) {
+	val := "2"
+	svc := types.ServiceConfig{
+		Name:        "app",
+		Image:       "nginx:1.27",
+		Environment: types.MappingWithEquals{"WORKERS": &val},
+		Restart:     "unless-stopped",
+	}
+	project := &types.Project{Services: types.Services{"app": svc}}
+
+	inspect := container.InspectResponse{
+		ContainerJSONBase: &container.ContainerJSONBase{
+			HostConfig: &container.HostConfig{
+				RestartPolicy: container.RestartPolicy{Name: "unless-stopped"},
+			},
+		},
+		Config: &container.Config{
+			Image: "nginx:1.25",
+			Env:   []string{"WORKERS=4", "PATH=/usr/bin"},
+		},
+	}
+
+	diffs := diffService(project, svc, inspect)
+	require.Equal(t, []FieldDiff{
+		{Field: "image", Expected: "nginx:1.27", Actual: "nginx:1.25"},
+		{Field: "environment.WORKERS", Expected: "2", Actual: "4"},
+	}, diffs)
+}
+
+func TestPlanContainer(t *testing.T) {
+	svc := types.ServiceConfig{Name: "web", Image: "nginx"}
+	running := func(hash, image string) container.Summary {
+		return container.Summary{
+			Names: []string{"/stack-web-1"},
+			State: container.StateRunning,
+			Labels: map[string]string{
+				api.ConfigHashLabel:  hash,
+				api.ImageDigestLabel: image,
+			},
+		}
+	}
+
+	require.Equal(t, PlanUnchanged, planContainer(svc, running("abc", "sha256:1"), "abc", "sha256:1").Action)
+	require.Equal(t, PlanRecreate, planContainer(svc, running("old", "sha256:1"), "abc", "sha256:1").Action)
+	require.Equal(t, PlanRecreate, planContainer(svc, running("abc", "sha256:1"), "abc", "sha256:2").Action)
+	require.Equal(t, PlanRecreate, planContainer(svc, running("abc", "sha256:1"), "abc", "").Action)
+
+	stopped := running("abc", "sha256:1")
+	stopped.State = container.StateExited
+	planned := planContainer(svc, stopped, "abc", "sha256:1")
+	require.Equal(t, PlanStart, planned.Action)
+	require.Equal(t, "stack-web-1", planned.ContainerName)
+}
+
+func TestPlanServiceScale(t *testing.T) {
+	scale := 1
+	svc := types.ServiceConfig{Name: "web", Image: "nginx", Scale: &scale}
+	replica := func(num string) container.Summary {
+		return container.Summary{
+			Names: []string{"/stack-web-" + num},
+			State: container.StateRunning,
+			Labels: map[string]string{
+				api.ConfigHashLabel:      "abc",
+				api.ImageDigestLabel:     "sha256:1",
+				api.ContainerNumberLabel: num,
+			},
+		}
+	}
+
+	planned := planService(svc, []container.Summary{replica("3"), replica("1")}, "abc", "sha256:1")
+	require.Len(t, planned, 2)
+	require.Equal(t, PlanUnchanged, planned[0].Action)
+	require.Equal(t, "stack-web-1", planned[0].ContainerName)
+	require.Equal(t, PlanRemove, planned[1].Action)
+	require.Equal(t, "stack-web-3", planned[1].ContainerName)
+
+	scale = 2
+	planned = planService(svc, []container.Summary{replica("1")}, "abc", "sha256:1")
+	require.Len(t, planned, 2)
+	require.Equal(t, PlanCreate, planned[1].Action)
+}
+
+func TestComposeConfigVariables(t *testing.T) {
+	root := t.TempDir()
+	stack := filepath.Join(root, "app")
+	require.NoError(t, os.MkdirAll(stack, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".env"), []byte("TAG=1.25\nPORT=80\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(stack, ".env"), []byte("PORT=8080\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(stack, "compose.yaml"), []byte(`
+services:
+  web:
+    image: nginx:${TAG}
+    ports:
+      - "${PORT}:80"
+    environment:
+      MODE: ${MODE:-prod}
+      EXTRA: ${DOCKMAN_TEST_UNSET_VAR}
+`), 0o644))
+
+	comp := NewComposeService(&dependencies{composeRoot: root}, nil)
+	rendered, err := comp.ComposeConfig(context.Background(), "app/compose.yaml")
+	require.NoError(t, err)
+	require.Contains(t, rendered.YAML, "nginx:1.25")
+
+	sources := map[string]VariableSource{}
+	for _, v := range rendered.Variables {
+		sources[v.Name] = v.Source
+	}
+	require.Equal(t, map[string]VariableSource{
+		"TAG":                    SourceRootEnv,
+		"PORT":                   SourceFolderEnv,
+		"MODE":                   SourceDefault,
+		"DOCKMAN_TEST_UNSET_VAR": SourceUnset,
+	}, sources)
+}
+
+func TestLoadProjectOverridesAndProfiles(t *testing.T) {
+	root := t.TempDir()
+	stack := filepath.Join(root, "app")
+	require.NoError(t, os.MkdirAll(stack, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(stack, "compose.yaml"), []byte(`
+services:
+  web:
+    image: nginx:1.25
+  debug:
+    image: busybox
+    profiles: [debug]
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(stack, "compose.override.yaml"), []byte(`
+services:
+  web:
+    image: nginx:1.27
+`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(stack, "compose.prod.yaml"), []byte(`
+services:
+  web:
+    restart: always
+`), 0o644))
+
+	comp := NewComposeService(&dependencies{composeRoot: root}, nil)
+
+	project, err := comp.LoadProject(context.Background(), "app/compose.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "nginx:1.27", project.Services["web"].Image)
+	require.NotContains(t, project.ServiceNames(), "debug")
+
+	project, err = comp.LoadProject(context.Background(), "app/compose.yaml",
+		WithExtraFiles("app/compose.prod.yaml"),
+		WithProfiles("debug"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "always", project.Services["web"].Restart)
+	require.Contains(t, project.ServiceNames(), "debug")
+
+	_, err = comp.LoadProject(context.Background(), "app/compose.yaml", WithExtraFiles("../outside.yaml"))
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/docker/compose/v2/pkg/api"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
@@ -88,6 +90,10 @@ func (s *ContainerService) containerListByIDs(ctx context.Context, containerID .
 	return list, nil
 }
 
+func (s *ContainerService) ContainerInspect(ctx context.Context, containerID string) (container.InspectResponse, error) {
+	return s.daemon.ContainerInspect(ctx, containerID)
+}
+
 func (s *ContainerService) ContainersStart(ctx context.Context, containerId ...string) error {
 	for _, cont := range containerId {
 		err := s.daemon.ContainerStart(ctx, cont, container.StartOptions{})
@@ -678,6 +684,11 @@ func (s *ContainerService) ImageUpdateAvailable(ctx context.Context, imageName s
 	return localDigest != remoteDigest, remoteDigest, nil
 }
 
+// ImageUpdatesAvailable returns the stored image updates for this host keyed by image id
+func (s *ContainerService) ImageUpdatesAvailable(imageIds ...string) (map[string]ImageUpdate, error) {
+	return s.imageUpdateStore.GetUpdateAvailable(s.hostname, imageIds...)
+}
+
 func (s *ContainerService) ImagePull(ctx context.Context, imageTag string) error {
 	log.Info().Msg("Pulling latest image")
 
@@ -710,15 +721,7 @@ func (s *ContainerService) ImagePruneUntagged(ctx context.Context) (image.PruneR
 		return prune, err
 	}
 
-	deletedIDs := ToMap(prune.ImagesDeleted, func(t image.DeleteResponse) string {
-		return t.Deleted
-	})
-
-	err = s.imageUpdateStore.Delete(deletedIDs...)
-	if err != nil {
-		log.Warn().Err(err).Msg("failed to cleanup image update db")
-	}
-
+	s.forgetImageUpdates(prune.ImagesDeleted)
 	return prune, nil
 }
 
@@ -756,8 +759,67 @@ func (s *ContainerService) NetworksList(ctx context.Context) ([]network.Inspect,
 	return result, nil
 }
 
-func (s *ContainerService) NetworksCreate(ctx context.Context, name string) (network.CreateResponse, error) {
-	return s.daemon.NetworkCreate(ctx, name, network.CreateOptions{})
+// NetworksCreate creates the network and returns it
+func (s *ContainerService) NetworksCreate(ctx context.Context, name string, opts network.CreateOptions) (network.Inspect, error) {
+	if name == "" {
+		return network.Inspect{}, fmt.Errorf("network name is empty")
+	}
+	if opts.IPAM != nil {
+		for _, conf := range opts.IPAM.Config {
+			if err := validateIPAM(conf); err != nil {
+				return network.Inspect{}, err
+			}
+		}
+	}
+
+	created, err := s.daemon.NetworkCreate(ctx, name, opts)
+	if err != nil {
+		return network.Inspect{}, fmt.Errorf("failed to create network %s: %w", name, err)
+	}
+	if created.Warning != "" {
+		log.Warn().Str("network", name).Msg(created.Warning)
+	}
+
+	return s.daemon.NetworkInspect(ctx, created.ID, network.InspectOptions{})
+}
+
+// validateIPAM checks that the gateway and ip range are inside the subnet
+func validateIPAM(conf network.IPAMConfig) error {
+	if conf.Subnet == "" {
+		if conf.Gateway != "" || conf.IPRange != "" {
+			return fmt.Errorf("a subnet is required when setting a gateway or ip range")
+		}
+		return nil
+	}
+
+	_, subnet, err := net.ParseCIDR(conf.Subnet)
+	if err != nil {
+		return fmt.Errorf("invalid subnet %q: %w", conf.Subnet, err)
+	}
+
+	if conf.Gateway != "" {
+		gateway := net.ParseIP(conf.Gateway)
+		if gateway == nil {
+			return fmt.Errorf("invalid gateway %q", conf.Gateway)
+		}
+		if !subnet.Contains(gateway) {
+			return fmt.Errorf("gateway %s is not in subnet %s", conf.Gateway, conf.Subnet)
+		}
+	}
+
+	if conf.IPRange != "" {
+		rangeIP, ipRange, err := net.ParseCIDR(conf.IPRange)
+		if err != nil {
+			return fmt.Errorf("invalid ip range %q: %w", conf.IPRange, err)
+		}
+		rangeOnes, _ := ipRange.Mask.Size()
+		subnetOnes, _ := subnet.Mask.Size()
+		if !subnet.Contains(rangeIP) || rangeOnes < subnetOnes {
+			return fmt.Errorf("ip range %s is not in subnet %s", conf.IPRange, conf.Subnet)
+		}
+	}
+
+	return nil
 }
 
 func (s *ContainerService) NetworksDelete(ctx context.Context, networkID string) error {
@@ -867,10 +929,38 @@ func (s *ContainerService) VolumesList(ctx context.Context) ([]VolumeInfo, error
 	return volumes, nil
 }
 
-func (s *ContainerService) VolumesCreate(ctx context.Context, name string) (volume.Volume, error) {
-	return s.daemon.VolumeCreate(ctx, volume.CreateOptions{
-		Name: name,
-	})
+// VolumesCreate creates a volume, remote shares can be mounted using the local driver
+// e.g. driver opts type=nfs o=addr=10.0.0.2,rw device=:/exports/data
+func (s *ContainerService) VolumesCreate(ctx context.Context, opts volume.CreateOptions) (volume.Volume, error) {
+	if err := validateVolumeOpts(opts); err != nil {
+		return volume.Volume{}, err
+	}
+
+	vol, err := s.daemon.VolumeCreate(ctx, opts)
+	if err != nil {
+		return volume.Volume{}, fmt.Errorf("failed to create volume %s: %w", opts.Name, err)
+	}
+	return vol, nil
+}
+
+// validateVolumeOpts catches incomplete network share options of the local driver,
+// docker only reports them when the volume is first mounted
+func validateVolumeOpts(opts volume.CreateOptions) error {
+	if opts.Driver != "" && opts.Driver != "local" {
+		return nil
+	}
+
+	switch fsType := opts.DriverOpts["type"]; fsType {
+	case "nfs", "nfs4", "cifs":
+		if opts.DriverOpts["device"] == "" {
+			return fmt.Errorf("driver opt 'device' is required for %s volumes", fsType)
+		}
+		if !strings.Contains(opts.DriverOpts["o"], "addr=") {
+			return fmt.Errorf("driver opt 'o' must contain addr=<server> for %s volumes", fsType)
+		}
+	}
+
+	return nil
 }
 
 func (s *ContainerService) VolumesDelete(ctx context.Context, volumeName string, force bool) error {
@@ -932,6 +1022,62 @@ func (s *ContainerService) VolumesPrune(ctx context.Context) error {
 	return err
 }
 
+////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
+// Event stuff
+
+// EventTypes object types streamed by Events
+var EventTypes = []events.Type{
+	events.ContainerEventType,
+	events.ImageEventType,
+	events.NetworkEventType,
+	events.VolumeEventType,
+}
+
+// Events streams daemon events of the given types,
+// since is a unix timestamp, optionally with nanoseconds eg: 1700000000.000000001,
+// used to replay events after a reconnect, can be empty
+func (s *ContainerService) Events(ctx context.Context, since string, eventTypes ...events.Type) (<-chan events.Message, <-chan error) {
+	if len(eventTypes) == 0 {
+		eventTypes = EventTypes
+	}
+
+	filterArgs := filters.NewArgs()
+	for _, t := range eventTypes {
+		filterArgs.Add("type", string(t))
+	}
+
+	return s.daemon.Events(ctx, events.ListOptions{
+		Since:   since,
+		Filters: filterArgs,
+	})
+}
+
+// EventLabels returns the labels of the object that caused the event,
+// container events carry their labels in the attributes,
+// networks and volumes are inspected since their events do not
+func (s *ContainerService) EventLabels(ctx context.Context, msg events.Message) map[string]string {
+	attrs := msg.Actor.Attributes
+	if _, ok := attrs[api.ProjectLabel]; ok || msg.Type == events.ContainerEventType {
+		return attrs
+	}
+
+	switch msg.Type {
+	case events.NetworkEventType:
+		netI, err := s.daemon.NetworkInspect(ctx, msg.Actor.ID, network.InspectOptions{})
+		if err == nil {
+			return netI.Labels
+		}
+	case events.VolumeEventType:
+		vol, err := s.daemon.VolumeInspect(ctx, msg.Actor.ID)
+		if err == nil {
+			return vol.Labels
+		}
+	}
+
+	// object was removed or has no labels
+	return attrs
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 // utils
 
@@ -0,0 +1,234 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+)
+
+// containerListLimit max bytes read from the archive api to list a single directory,
+// the api always returns the whole subtree so it is only used when exec is not possible
+const containerListLimit = 32 << 20
+
+// containerExecOutputLimit max bytes of output read from a listing exec
+const containerExecOutputLimit = 8 << 20
+
+// containerLinkTargetLimit max symlinks resolved per listing, one stat call each
+const containerLinkTargetLimit = 200
+
+// ContainerListDir lists the direct children of dir inside a container, directories first.
+// Running containers are listed with find and stat, stopped containers and images without them
+// fall back to the archive api, truncated is set when the directory was too large for it
+func (s *ContainerService) ContainerListDir(ctx context.Context, containerID, dir string) (entries []FileEntry, truncated bool, err error) {
+	dir = cleanRootPath(dir)
+
+	stat, err := s.daemon.ContainerStatPath(ctx, containerID, dir)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to stat %s: %w", dir, err)
+	}
+	// symlinked directories report the link itself, the trailing /. below follows it
+	if !stat.Mode.IsDir() && stat.Mode&fs.ModeSymlink == 0 {
+		return nil, false, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	entries, truncated, err = s.execListDir(ctx, containerID, dir)
+	if err == nil {
+		return entries, truncated, nil
+	}
+	log.Debug().Err(err).Str("container", containerID).Msg("unable to list directory with exec, using the archive api")
+
+	// the trailing /. archives the directory contents without the directory name as prefix
+	reader, _, err := s.daemon.CopyFromContainer(ctx, containerID, strings.TrimSuffix(dir, "/")+"/.")
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+	defer fileutil.Close(reader)
+
+	return listArchiveDir(reader, dir, containerListLimit)
+}
+
+// execListDir lists dir with find and stat inside a running container, same as VolumeListDir
+func (s *ContainerService) execListDir(ctx context.Context, containerID, dir string) (entries []FileEntry, truncated bool, err error) {
+	out, truncated, err := s.execOutput(ctx, containerID,
+		"find", strings.TrimSuffix(dir, "/")+"/.", "-mindepth", "1", "-maxdepth", "1",
+		"-exec", "stat", "-c", "%f %s %Y %u %g %n", "{}", "+",
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entries = parseStatOutput(out, "")
+	resolved := 0
+	for i := range entries {
+		if entries[i].Mode&fs.ModeSymlink == 0 || resolved >= containerLinkTargetLimit {
+			continue
+		}
+		resolved++
+		if linkStat, err := s.daemon.ContainerStatPath(ctx, containerID, entries[i].Path); err == nil {
+			entries[i].LinkTarget = linkStat.LinkTarget
+		}
+	}
+
+	sortEntries(entries)
+	return entries, truncated, nil
+}
+
+// execOutput runs cmd in a running container and returns its stdout, truncated is set if it was cut off.
+// A failing command is only an error if it printed nothing, eg: find with some unreadable entries
+func (s *ContainerService) execOutput(ctx context.Context, containerID string, cmd ...string) (out string, truncated bool, err error) {
+	created, err := s.daemon.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create exec instance: %w", err)
+	}
+
+	resp, err := s.daemon.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return "", false, fmt.Errorf("failed to attach to exec instance: %w", err)
+	}
+	defer resp.Close()
+
+	var stdout, stderr strings.Builder
+	limited := &io.LimitedReader{R: resp.Reader, N: containerExecOutputLimit}
+	if _, err = stdcopy.StdCopy(&stdout, &stderr, limited); err != nil {
+		return "", false, fmt.Errorf("failed to read exec output: %w", err)
+	}
+	if limited.N <= 0 {
+		// drop the partial last line, the command may still be running
+		out = stdout.String()
+		return out[:strings.LastIndex(out, "\n")+1], true, nil
+	}
+
+	inspect, err := s.daemon.ContainerExecInspect(ctx, created.ID)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to inspect exec instance: %w", err)
+	}
+	if inspect.ExitCode != 0 && stdout.Len() == 0 {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = fmt.Sprintf("exec exited with status %d", inspect.ExitCode)
+		}
+		return "", false, errors.New(msg)
+	}
+	return stdout.String(), false, nil
+}
+
+// listArchiveDir collects the top level entries of the tar stream r, reading at most limit bytes
+func listArchiveDir(r io.Reader, dir string, limit int64) (entries []FileEntry, truncated bool, err error) {
+	limited := &io.LimitedReader{R: r, N: limit}
+	tr := tar.NewReader(limited)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if limited.N <= 0 {
+				truncated = true
+				break
+			}
+			return nil, false, fmt.Errorf("failed to read %s: %w", dir, err)
+		}
+
+		name := strings.TrimSuffix(strings.TrimPrefix(hdr.Name, "./"), "/")
+		// nested entries and the directory itself
+		if name == "" || name == "." || strings.Contains(name, "/") {
+			continue
+		}
+
+		entry := FileEntry{
+			Name:    name,
+			Path:    path.Join(dir, name),
+			Size:    hdr.Size,
+			Mode:    hdr.FileInfo().Mode(),
+			ModTime: hdr.ModTime,
+			UID:     hdr.Uid,
+			GID:     hdr.Gid,
+		}
+		if hdr.Typeflag == tar.TypeSymlink {
+			entry.LinkTarget = hdr.Linkname
+		}
+		entries = append(entries, entry)
+	}
+
+	sortEntries(entries)
+	return entries, truncated, nil
+}
+
+// ContainerCopyFrom returns a tar archive of a file or directory inside a container,
+// the caller must close the reader
+func (s *ContainerService) ContainerCopyFrom(ctx context.Context, containerID, srcPath string) (io.ReadCloser, container.PathStat, error) {
+	srcPath = cleanRootPath(srcPath)
+	reader, stat, err := s.daemon.CopyFromContainer(ctx, containerID, srcPath)
+	if err != nil {
+		return nil, container.PathStat{}, fmt.Errorf("failed to read %s: %w", srcPath, err)
+	}
+	return reader, stat, nil
+}
+
+// ContainerWriteFile creates or replaces a file inside a container, the parent directory must exist.
+// The file is owned by the user the container runs as
+func (s *ContainerService) ContainerWriteFile(ctx context.Context, containerID, filePath string, contents []byte, mode fs.FileMode) error {
+	filePath = cleanRootPath(filePath)
+	if filePath == "/" {
+		return fmt.Errorf("a file name is required")
+	}
+	if len(contents) > FileSizeLimit {
+		return ErrFileTooLarge
+	}
+	if mode == 0 {
+		mode = 0644
+	}
+
+	parent := path.Dir(filePath)
+	stat, err := s.daemon.ContainerStatPath(ctx, containerID, parent)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", parent, err)
+	}
+	if !stat.Mode.IsDir() && stat.Mode&fs.ModeSymlink == 0 {
+		return fmt.Errorf("%s is not a directory", parent)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err = tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path.Base(filePath),
+		Mode:     int64(mode.Perm()),
+		Size:     int64(len(contents)),
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = tw.Write(contents); err != nil {
+		return err
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+
+	err = s.daemon.CopyToContainer(ctx, containerID, parent, &buf, container.CopyToContainerOptions{
+		// chown to the container user instead of root
+		CopyUIDGID: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", filePath, err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListArchiveDir(t *testing.T) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, hdr := range []*tar.Header{
+		{Typeflag: tar.TypeDir, Name: "./", Mode: 0755},
+		{Typeflag: tar.TypeReg, Name: "./hosts", Mode: 0644, Size: 4},
+		{Typeflag: tar.TypeDir, Name: "./ssl/", Mode: 0755},
+		{Typeflag: tar.TypeReg, Name: "./ssl/cert.pem", Mode: 0600},
+		{Typeflag: tar.TypeSymlink, Name: "./mtab", Linkname: "/proc/mounts", Mode: 0777},
+	} {
+		require.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Size > 0 {
+			_, err := tw.Write([]byte("1234"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	archive := buf.Bytes()
+
+	entries, truncated, err := listArchiveDir(bytes.NewReader(archive), "/etc", int64(len(archive)))
+	require.NoError(t, err)
+	require.False(t, truncated)
+	require.Len(t, entries, 3)
+	require.Equal(t, "ssl", entries[0].Name)
+	require.True(t, entries[0].IsDir())
+	require.Equal(t, "/etc/hosts", entries[1].Path)
+	require.Equal(t, int64(4), entries[1].Size)
+	require.Equal(t, "/proc/mounts", entries[2].LinkTarget)
+
+	_, truncated, err = listArchiveDir(bytes.NewReader(archive), "/etc", 1024)
+	require.NoError(t, err)
+	require.True(t, truncated)
+}
+
+func TestParseExecListing(t *testing.T) {
+	// find is started on dir/. so symlinked directories are followed
+	out := "41ed 4096 1700000000 0 0 /etc/./ssl\n" +
+		"a1ff 12 1700000000 0 0 /etc/./mtab\n"
+
+	entries := parseStatOutput(out, "")
+	require.Len(t, entries, 2)
+	require.Equal(t, "ssl", entries[0].Name)
+	require.Equal(t, "/etc/ssl", entries[0].Path)
+	require.True(t, entries[0].IsDir())
+	require.Equal(t, "/etc/mtab", entries[1].Path)
+	require.NotZero(t, entries[1].Mode&fs.ModeSymlink)
+}
@@ -0,0 +1,36 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIPAM(t *testing.T) {
+	require.NoError(t, validateIPAM(network.IPAMConfig{}))
+	require.NoError(t, validateIPAM(network.IPAMConfig{
+		Subnet: "172.30.0.0/16", Gateway: "172.30.0.1", IPRange: "172.30.5.0/24",
+	}))
+	require.NoError(t, validateIPAM(network.IPAMConfig{Subnet: "fd00:dead:beef::/48", Gateway: "fd00:dead:beef::1"}))
+
+	require.Error(t, validateIPAM(network.IPAMConfig{Gateway: "172.30.0.1"}))
+	require.Error(t, validateIPAM(network.IPAMConfig{Subnet: "172.30.0.0"}))
+	require.Error(t, validateIPAM(network.IPAMConfig{Subnet: "172.30.0.0/16", Gateway: "10.0.0.1"}))
+	require.Error(t, validateIPAM(network.IPAMConfig{Subnet: "172.30.0.0/16", IPRange: "172.0.0.0/8"}))
+}
+
+func TestValidateVolumeOpts(t *testing.T) {
+	require.NoError(t, validateVolumeOpts(volume.CreateOptions{Name: "data"}))
+	require.NoError(t, validateVolumeOpts(volume.CreateOptions{
+		DriverOpts: map[string]string{"type": "nfs", "o": "addr=10.0.0.2,rw", "device": ":/exports/data"},
+	}))
+	require.Error(t, validateVolumeOpts(volume.CreateOptions{
+		DriverOpts: map[string]string{"type": "cifs", "device": "//nas/share"},
+	}))
+	// options of other drivers are not checked
+	require.NoError(t, validateVolumeOpts(volume.CreateOptions{
+		Driver: "rclone", DriverOpts: map[string]string{"type": "nfs"},
+	}))
+}
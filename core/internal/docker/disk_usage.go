@@ -0,0 +1,320 @@
+package docker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/build"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/rs/zerolog/log"
+)
+
+// DefaultUsageTopN number of largest items listed per category if none is requested
+const DefaultUsageTopN = 10
+
+type UsageItem struct {
+	ID   string
+	Name string
+	Size int64
+	// removed by a prune
+	Reclaimable bool
+}
+
+type UsageCategory struct {
+	Count int
+	// images used by a container, running containers, volumes with a container, cache in use
+	Active      int
+	Total       int64
+	Reclaimable int64
+	// largest items first
+	Top []UsageItem
+}
+
+// DiskUsage space used by the daemon, computed the same way as docker system df
+type DiskUsage struct {
+	Images     UsageCategory
+	Containers UsageCategory
+	Volumes    UsageCategory
+	BuildCache UsageCategory
+}
+
+// DiskUsage returns the space used by every object type with the topN largest items of each
+func (s *ContainerService) DiskUsage(ctx context.Context, topN int) (*DiskUsage, error) {
+	du, err := s.daemon.DiskUsage(ctx, types.DiskUsageOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk usage data: %w", err)
+	}
+	if topN <= 0 {
+		topN = DefaultUsageTopN
+	}
+	return summarizeDiskUsage(du, topN), nil
+}
+
+func summarizeDiskUsage(du types.DiskUsage, topN int) *DiskUsage {
+	result := &DiskUsage{}
+
+	// layers shared between images are only counted once
+	result.Images.Total = du.LayersSize
+	var usedImages int64
+	var images []UsageItem
+	for _, img := range du.Images {
+		if img == nil {
+			continue
+		}
+		inUse := img.Containers > 0
+		if inUse {
+			result.Images.Active++
+			if img.Size != -1 && img.SharedSize != -1 {
+				usedImages += img.Size - img.SharedSize
+			}
+		}
+		name := img.ID
+		if len(img.RepoTags) > 0 {
+			name = img.RepoTags[0]
+		}
+		images = append(images, UsageItem{ID: img.ID, Name: name, Size: img.Size, Reclaimable: !inUse})
+	}
+	result.Images.Count = len(images)
+	result.Images.Reclaimable = max(result.Images.Total-usedImages, 0)
+	result.Images.Top = topUsage(images, topN)
+
+	var containers []UsageItem
+	for _, c := range du.Containers {
+		if c == nil {
+			continue
+		}
+		running := c.State == "running"
+		if running {
+			result.Containers.Active++
+		} else {
+			result.Containers.Reclaimable += c.SizeRw
+		}
+		result.Containers.Total += c.SizeRw
+		containers = append(containers, UsageItem{ID: c.ID, Name: containerName(*c), Size: c.SizeRw, Reclaimable: !running})
+	}
+	result.Containers.Count = len(containers)
+	result.Containers.Top = topUsage(containers, topN)
+
+	var volumes []UsageItem
+	for _, vol := range du.Volumes {
+		if vol == nil || vol.UsageData == nil {
+			continue
+		}
+		// -1 if the size could not be computed
+		size := max(vol.UsageData.Size, 0)
+		unused := vol.UsageData.RefCount == 0
+		if unused {
+			result.Volumes.Reclaimable += size
+		} else {
+			result.Volumes.Active++
+		}
+		result.Volumes.Total += size
+		volumes = append(volumes, UsageItem{ID: vol.Name, Name: vol.Name, Size: size, Reclaimable: unused})
+	}
+	result.Volumes.Count = len(volumes)
+	result.Volumes.Top = topUsage(volumes, topN)
+
+	var caches []UsageItem
+	for _, bc := range du.BuildCache {
+		if bc == nil {
+			continue
+		}
+		if bc.InUse {
+			result.BuildCache.Active++
+		}
+		// shared records also belong to images and are not freed by a prune
+		if !bc.Shared {
+			result.BuildCache.Total += bc.Size
+		}
+		reclaimable := !bc.InUse && !bc.Shared
+		if reclaimable {
+			result.BuildCache.Reclaimable += bc.Size
+		}
+		caches = append(caches, UsageItem{
+			ID:          bc.ID,
+			Name:        cmp.Or(bc.Description, bc.Type),
+			Size:        bc.Size,
+			Reclaimable: reclaimable,
+		})
+	}
+	result.BuildCache.Count = len(caches)
+	result.BuildCache.Top = topUsage(caches, topN)
+
+	return result
+}
+
+// topUsage returns the n largest items
+func topUsage(items []UsageItem, n int) []UsageItem {
+	slices.SortStableFunc(items, func(a, b UsageItem) int {
+		return cmp.Compare(b.Size, a.Size)
+	})
+	return items[:min(n, len(items))]
+}
+
+type PruneOptions struct {
+	// stopped containers
+	Containers bool
+	Images     bool
+	// also remove tagged images without a container, otherwise only dangling images
+	AllImages bool
+	// volumes without a container
+	Volumes bool
+	// also remove named volumes, otherwise only anonymous volumes
+	AllVolumes bool
+	// networks without a container
+	Networks   bool
+	BuildCache bool
+	// keep the most recently used build cache up to this many bytes, 0 removes all unused cache
+	BuildCacheKeep int64
+
+	// only prune objects created before this timestamp or duration relative to now, eg: 24h.
+	// volumes do not support it and are skipped if set
+	Until string
+	// label filters, key or key=value, prefix with ! to exclude matching objects.
+	// not supported by the build cache
+	Labels []string
+}
+
+type PruneReport struct {
+	ContainersDeleted []string
+	ImagesDeleted     []string
+	VolumesDeleted    []string
+	NetworksDeleted   []string
+	CachesDeleted     []string
+	SpaceReclaimed    uint64
+	// objects types that were skipped because a filter does not apply to them
+	Skipped []string
+	// failures of single object types, the others are still pruned
+	Errors []string
+}
+
+// Prune removes unused objects of the selected types, a failing type does not stop the others
+// and is reported in PruneReport.Errors
+func (s *ContainerService) Prune(ctx context.Context, opts PruneOptions) (*PruneReport, error) {
+	base, err := pruneFilters(opts.Until, opts.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PruneReport{}
+	fail := func(err error) {
+		report.Errors = append(report.Errors, err.Error())
+	}
+
+	if opts.Containers {
+		res, err := s.daemon.ContainersPrune(ctx, base.Clone())
+		if err != nil {
+			fail(fmt.Errorf("failed to prune containers: %w", err))
+		}
+		report.ContainersDeleted = res.ContainersDeleted
+		report.SpaceReclaimed += res.SpaceReclaimed
+	}
+
+	// containers go first so their images and volumes become unused
+	if opts.Images {
+		args := base.Clone()
+		args.Add("dangling", fmt.Sprint(!opts.AllImages))
+		res, err := s.daemon.ImagesPrune(ctx, args)
+		if err != nil {
+			fail(fmt.Errorf("failed to prune images: %w", err))
+		}
+		var deletedIDs []string
+		for _, del := range res.ImagesDeleted {
+			if del.Deleted != "" {
+				deletedIDs = append(deletedIDs, del.Deleted)
+			}
+		}
+		report.ImagesDeleted = deletedIDs
+		report.SpaceReclaimed += res.SpaceReclaimed
+		s.forgetImageUpdates(res.ImagesDeleted)
+	}
+
+	if opts.Volumes {
+		if opts.Until != "" {
+			report.Skipped = append(report.Skipped, "volumes")
+		} else {
+			args := base.Clone()
+			if opts.AllVolumes {
+				args.Add("all", "true")
+			}
+			res, err := s.daemon.VolumesPrune(ctx, args)
+			if err != nil {
+				fail(fmt.Errorf("failed to prune volumes: %w", err))
+			}
+			report.VolumesDeleted = res.VolumesDeleted
+			report.SpaceReclaimed += res.SpaceReclaimed
+		}
+	}
+
+	if opts.Networks {
+		res, err := s.daemon.NetworksPrune(ctx, base.Clone())
+		if err != nil {
+			fail(fmt.Errorf("failed to prune networks: %w", err))
+		}
+		report.NetworksDeleted = res.NetworksDeleted
+	}
+
+	if opts.BuildCache {
+		if len(opts.Labels) > 0 {
+			report.Skipped = append(report.Skipped, "build cache")
+		} else {
+			args := filters.NewArgs()
+			if opts.Until != "" {
+				args.Add("until", opts.Until)
+			}
+			res, err := s.daemon.BuildCachePrune(ctx, build.CachePruneOptions{
+				All:          true,
+				Filters:      args,
+				MaxUsedSpace: opts.BuildCacheKeep,
+				// daemons older than api 1.48 only know keep-storage
+				KeepStorage: opts.BuildCacheKeep,
+			})
+			if err != nil {
+				fail(fmt.Errorf("failed to prune build cache: %w", err))
+			} else {
+				report.CachesDeleted = res.CachesDeleted
+				report.SpaceReclaimed += res.SpaceReclaimed
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// pruneFilters builds the until and label filters shared by every prune call
+func pruneFilters(until string, labels []string) (filters.Args, error) {
+	args := filters.NewArgs()
+	if until != "" {
+		args.Add("until", until)
+	}
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		key := "label"
+		if rest, ok := strings.CutPrefix(label, "!"); ok {
+			key, label = "label!", rest
+		}
+		if label == "" || strings.HasPrefix(label, "=") {
+			return filters.Args{}, fmt.Errorf("invalid label filter %q", label)
+		}
+		args.Add(key, label)
+	}
+	return args, nil
+}
+
+// forgetImageUpdates removes stored update info of deleted images
+func (s *ContainerService) forgetImageUpdates(deleted []image.DeleteResponse) {
+	if s.imageUpdateStore == nil {
+		return
+	}
+	deletedIDs := ToMap(deleted, func(t image.DeleteResponse) string {
+		return t.Deleted
+	})
+	if err := s.imageUpdateStore.Delete(deletedIDs...); err != nil {
+		log.Warn().Err(err).Msg("failed to cleanup image update db")
+	}
+}
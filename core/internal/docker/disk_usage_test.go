@@ -0,0 +1,66 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/build"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeDiskUsage(t *testing.T) {
+	du := types.DiskUsage{
+		LayersSize: 1000,
+		Images: []*image.Summary{
+			{ID: "sha256:a", RepoTags: []string{"app:latest"}, Size: 600, SharedSize: 100, Containers: 1},
+			{ID: "sha256:b", Size: 400, SharedSize: 100},
+		},
+		Containers: []*container.Summary{
+			{ID: "c1", Names: []string{"/web"}, State: "running", SizeRw: 10},
+			{ID: "c2", Names: []string{"/old"}, State: "exited", SizeRw: 30},
+		},
+		Volumes: []*volume.Volume{
+			{Name: "db", UsageData: &volume.UsageData{Size: 50, RefCount: 1}},
+			{Name: "stale", UsageData: &volume.UsageData{Size: 70}},
+			{Name: "unknown", UsageData: &volume.UsageData{Size: -1}},
+		},
+		BuildCache: []*build.CacheRecord{
+			{ID: "b1", Size: 5, InUse: true},
+			{ID: "b2", Size: 20, Description: "RUN make"},
+			{ID: "b3", Size: 40, Shared: true},
+		},
+	}
+
+	usage := summarizeDiskUsage(du, 1)
+
+	require.Equal(t, UsageCategory{
+		Count: 2, Active: 1, Total: 1000, Reclaimable: 500,
+		Top: []UsageItem{{ID: "sha256:a", Name: "app:latest", Size: 600}},
+	}, usage.Images)
+	require.Equal(t, UsageCategory{
+		Count: 2, Active: 1, Total: 40, Reclaimable: 30,
+		Top: []UsageItem{{ID: "c2", Name: "old", Size: 30, Reclaimable: true}},
+	}, usage.Containers)
+	require.Equal(t, int64(120), usage.Volumes.Total)
+	require.Equal(t, int64(70), usage.Volumes.Reclaimable)
+	require.Equal(t, 3, usage.Volumes.Count)
+	require.Equal(t, int64(25), usage.BuildCache.Total)
+	require.Equal(t, int64(20), usage.BuildCache.Reclaimable)
+	require.Equal(t, "b3", usage.BuildCache.Top[0].ID)
+}
+
+func TestPruneFilters(t *testing.T) {
+	args, err := pruneFilters("24h", []string{"env=dev", "!keep"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"24h"}, args.Get("until"))
+	require.Equal(t, []string{"env=dev"}, args.Get("label"))
+	require.Equal(t, []string{"keep"}, args.Get("label!"))
+
+	_, err = pruneFilters("", []string{"!"})
+	require.Error(t, err)
+	_, err = pruneFilters("", []string{"=value"})
+	require.Error(t, err)
+}
@@ -0,0 +1,304 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/compose/v2/pkg/compose"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+)
+
+type DriftState string
+
+const (
+	DriftInSync DriftState = "in_sync"
+	// running container was created from a different config
+	DriftChanged DriftState = "drifted"
+	// service has no container
+	DriftMissing DriftState = "missing"
+	// container belongs to a service that was removed from the file
+	DriftOrphan DriftState = "orphan"
+)
+
+type FieldDiff struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+type ServiceDrift struct {
+	Service       string
+	ContainerName string
+	State         DriftState
+	ExpectedHash  string
+	ActualHash    string
+	Diffs         []FieldDiff
+}
+
+// ComposeDrift compares the config-hash label of the deployed containers
+// with the current compose file and lists the fields that changed
+func (s *ComposeService) ComposeDrift(ctx context.Context, shortName string, opts ...ProjectOption) ([]ServiceDrift, error) {
+	project, err := s.LoadProject(ctx, shortName, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := s.ComposeList(ctx, project, true)
+	if err != nil {
+		return nil, err
+	}
+
+	byService := make(map[string][]container.Summary)
+	for _, c := range containers {
+		svcName := c.Labels[api.ServiceLabel]
+		byService[svcName] = append(byService[svcName], c)
+	}
+
+	var result []ServiceDrift
+	for _, svcName := range slices.Sorted(maps.Keys(project.Services)) {
+		svc := project.Services[svcName]
+		expectedHash, err := compose.ServiceHash(svc)
+		if err != nil {
+			return nil, fmt.Errorf("unable to hash service %s: %w", svcName, err)
+		}
+
+		svcContainers := byService[svcName]
+		if len(svcContainers) == 0 {
+			result = append(result, ServiceDrift{
+				Service:      svcName,
+				State:        DriftMissing,
+				ExpectedHash: expectedHash,
+			})
+			continue
+		}
+
+		for _, c := range svcContainers {
+			drift := ServiceDrift{
+				Service:       svcName,
+				ContainerName: containerName(c),
+				State:         DriftInSync,
+				ExpectedHash:  expectedHash,
+				ActualHash:    c.Labels[api.ConfigHashLabel],
+			}
+
+			if drift.ActualHash != expectedHash {
+				drift.State = DriftChanged
+				inspect, err := s.daemon.ContainerInspect(ctx, c.ID)
+				if err != nil {
+					return nil, fmt.Errorf("unable to inspect container %s: %w", drift.ContainerName, err)
+				}
+				drift.Diffs = diffService(project, svc, inspect)
+			}
+
+			result = append(result, drift)
+		}
+	}
+
+	for svcName, svcContainers := range byService {
+		if _, ok := project.Services[svcName]; ok {
+			continue
+		}
+		for _, c := range svcContainers {
+			result = append(result, ServiceDrift{
+				Service:       svcName,
+				ContainerName: containerName(c),
+				State:         DriftOrphan,
+				ActualHash:    c.Labels[api.ConfigHashLabel],
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// ListComposeFiles lists compose files in the compose root and its direct subfolders,
+// paths are relative to the compose root
+func (s *ComposeService) ListComposeFiles() ([]string, error) {
+	entries, err := os.ReadDir(s.composeRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files in compose root: %w", err)
+	}
+
+	var result []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() {
+			if isComposeFile(filepath.Join(s.composeRoot, name)) {
+				result = append(result, name)
+			}
+			continue
+		}
+		// skip .git, .dockman.remote etc
+		if strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		subEntries, err := os.ReadDir(filepath.Join(s.composeRoot, name))
+		if err != nil {
+			continue
+		}
+		for _, sub := range subEntries {
+			rel := filepath.Join(name, sub.Name())
+			if !sub.IsDir() && isComposeFile(filepath.Join(s.composeRoot, rel)) {
+				result = append(result, rel)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// diffService compares the fields of a service that users commonly change
+// against the container created from it
+func diffService(project *types.Project, svc types.ServiceConfig, inspect container.InspectResponse) []FieldDiff {
+	var diffs []FieldDiff
+	add := func(field, expected, actual string) {
+		if expected != actual {
+			diffs = append(diffs, FieldDiff{Field: field, Expected: expected, Actual: actual})
+		}
+	}
+
+	cfg := inspect.Config
+	if cfg == nil {
+		cfg = &container.Config{}
+	}
+	hostCfg := inspect.HostConfig
+	if hostCfg == nil {
+		hostCfg = &container.HostConfig{}
+	}
+
+	add("image", svc.Image, cfg.Image)
+	if svc.Command != nil {
+		add("command", strings.Join(svc.Command, " "), strings.Join(cfg.Cmd, " "))
+	}
+	if svc.Entrypoint != nil {
+		add("entrypoint", strings.Join(svc.Entrypoint, " "), strings.Join(cfg.Entrypoint, " "))
+	}
+	if svc.User != "" {
+		add("user", svc.User, cfg.User)
+	}
+	if svc.Restart != "" {
+		add("restart", svc.Restart, string(hostCfg.RestartPolicy.Name))
+	}
+
+	actualEnv := make(map[string]string, len(cfg.Env))
+	for _, e := range cfg.Env {
+		k, v, _ := strings.Cut(e, "=")
+		actualEnv[k] = v
+	}
+	for _, key := range slices.Sorted(maps.Keys(svc.Environment)) {
+		val := svc.Environment[key]
+		if val == nil {
+			continue
+		}
+		actual, ok := actualEnv[key]
+		if !ok {
+			actual = "<unset>"
+		}
+		add("environment."+key, *val, actual)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(svc.Labels)) {
+		actual, ok := cfg.Labels[key]
+		if !ok {
+			actual = "<unset>"
+		}
+		add("labels."+key, svc.Labels[key], actual)
+	}
+
+	add("ports", strings.Join(expectedPorts(svc), ","), strings.Join(actualPorts(hostCfg), ","))
+	add("volumes", strings.Join(expectedMounts(svc), ","), strings.Join(actualMounts(inspect), ","))
+	add("networks", strings.Join(expectedNetworks(project, svc), ","), strings.Join(actualNetworks(inspect), ","))
+
+	return diffs
+}
+
+func expectedPorts(svc types.ServiceConfig) []string {
+	var ports []string
+	for _, p := range svc.Ports {
+		if p.Published == "" {
+			continue
+		}
+		ports = append(ports, fmt.Sprintf("%s:%d/%s", p.Published, p.Target, p.Protocol))
+	}
+	slices.Sort(ports)
+	return slices.Compact(ports)
+}
+
+func actualPorts(hostCfg *container.HostConfig) []string {
+	var ports []string
+	for port, bindings := range hostCfg.PortBindings {
+		for _, b := range bindings {
+			if b.HostPort == "" {
+				continue
+			}
+			ports = append(ports, fmt.Sprintf("%s:%s/%s", b.HostPort, port.Port(), port.Proto()))
+		}
+	}
+	slices.Sort(ports)
+	return slices.Compact(ports)
+}
+
+func expectedMounts(svc types.ServiceConfig) []string {
+	var mounts []string
+	for _, v := range svc.Volumes {
+		mounts = append(mounts, v.Target)
+	}
+	slices.Sort(mounts)
+	return mounts
+}
+
+func actualMounts(inspect container.InspectResponse) []string {
+	var mounts []string
+	for _, m := range inspect.Mounts {
+		// anonymous volumes declared by the image are not part of the compose file
+		if m.Type == mount.TypeVolume && isAnonymousVolume(m.Name) && inspect.Config != nil {
+			if _, declared := inspect.Config.Volumes[m.Destination]; declared {
+				continue
+			}
+		}
+		mounts = append(mounts, m.Destination)
+	}
+	slices.Sort(mounts)
+	return mounts
+}
+
+func expectedNetworks(project *types.Project, svc types.ServiceConfig) []string {
+	if svc.NetworkMode != "" {
+		return nil
+	}
+
+	var networks []string
+	for name := range svc.Networks {
+		netName := name
+		if netConf, ok := project.Networks[name]; ok && netConf.Name != "" {
+			netName = netConf.Name
+		}
+		networks = append(networks, netName)
+	}
+	slices.Sort(networks)
+	return networks
+}
+
+func actualNetworks(inspect container.InspectResponse) []string {
+	if inspect.NetworkSettings == nil || (inspect.HostConfig != nil && !inspect.HostConfig.NetworkMode.IsUserDefined() && !inspect.HostConfig.NetworkMode.IsDefault()) {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(inspect.NetworkSettings.Networks))
+}
+
+func containerName(c container.Summary) string {
+	if len(c.Names) == 0 {
+		return c.ID[:12]
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
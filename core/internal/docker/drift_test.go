@@ -0,0 +1,30 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListComposeFiles(t *testing.T) {
+	root := t.TempDir()
+	compose := []byte("services:\n  web:\n    image: nginx\n")
+
+	write := func(rel string, contents []byte) {
+		full := filepath.Join(root, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(full), 0o755))
+		require.NoError(t, os.WriteFile(full, contents, 0o644))
+	}
+	write("compose.yaml", compose)
+	write("app/compose.yaml", compose)
+	write("app/notes.yaml", []byte("key: value\n"))
+	// compose roots of other hosts are checked on their own host
+	write(".dockman.remote/remote/compose.yaml", compose)
+
+	comp := NewComposeService(&dependencies{composeRoot: root}, nil)
+	files, err := comp.ListComposeFiles()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"compose.yaml", filepath.Join("app", "compose.yaml")}, files)
+}
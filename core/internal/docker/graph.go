@@ -0,0 +1,267 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+type NodeKind string
+
+const (
+	NodeStack   NodeKind = "stack"
+	NodeService NodeKind = "service"
+	// external network or volume not created by any stack
+	NodeExternal NodeKind = "external"
+)
+
+type EdgeKind string
+
+const (
+	// service -> service it depends on
+	EdgeDependsOn EdgeKind = "depends_on"
+	// stack -> service it contains
+	EdgeContains EdgeKind = "contains"
+	// stack -> stack or external node that provides the network
+	EdgeNetwork EdgeKind = "network"
+	// stack -> stack or external node that provides the volume
+	EdgeVolume EdgeKind = "volume"
+	// stacks binding the same host path, has no direction
+	EdgeSharedPath EdgeKind = "shared_path"
+)
+
+type GraphNode struct {
+	// stack nodes use the compose file, services use file:service
+	ID    string
+	Kind  NodeKind
+	Label string
+	// set if the stack could not be loaded
+	Error string
+}
+
+type GraphEdge struct {
+	From string
+	To   string
+	Kind EdgeKind
+	// network, volume or path name the edge is based on
+	Label string
+}
+
+type StackGraph struct {
+	Nodes []GraphNode
+	Edges []GraphEdge
+	// compose files ordered so that stacks providing networks and volumes start first,
+	// stop order is the reverse
+	StartOrder []string
+	// set instead of StartOrder if the stacks depend on each other in a cycle
+	OrderError string
+}
+
+// StackGraph loads every compose file and links stacks through
+// depends_on, external networks and volumes and shared bind mounts
+func (s *ComposeService) StackGraph(ctx context.Context, files []string) (*StackGraph, error) {
+	projects := make(map[string]*types.Project, len(files))
+	loadErrs := make(map[string]error)
+	for _, file := range files {
+		if !isComposeFile(filepath.Join(s.composeRoot, file)) {
+			continue
+		}
+
+		project, err := s.LoadProject(ctx, file)
+		if err != nil {
+			loadErrs[file] = err
+			continue
+		}
+		projects[file] = project
+	}
+
+	graph := buildGraph(projects)
+	for _, file := range slices.Sorted(maps.Keys(loadErrs)) {
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:    file,
+			Kind:  NodeStack,
+			Label: file,
+			Error: loadErrs[file].Error(),
+		})
+	}
+
+	return graph, nil
+}
+
+// resource is a network or volume declared by a stack
+type resource struct {
+	stack    string
+	name     string
+	external bool
+}
+
+func buildGraph(projects map[string]*types.Project) *StackGraph {
+	graph := &StackGraph{}
+	files := slices.Sorted(maps.Keys(projects))
+
+	// real resource name -> stack that creates it
+	networkOwners := map[string]string{}
+	volumeOwners := map[string]string{}
+	var networks, volumes []resource
+	binds := map[string][]string{}
+
+	for _, file := range files {
+		project := projects[file]
+		graph.Nodes = append(graph.Nodes, GraphNode{ID: file, Kind: NodeStack, Label: project.Name})
+
+		for _, svcName := range slices.Sorted(maps.Keys(project.Services)) {
+			svc := project.Services[svcName]
+			svcID := file + ":" + svcName
+			graph.Nodes = append(graph.Nodes, GraphNode{ID: svcID, Kind: NodeService, Label: svcName})
+			graph.Edges = append(graph.Edges, GraphEdge{From: file, To: svcID, Kind: EdgeContains})
+
+			for _, dep := range slices.Sorted(maps.Keys(svc.DependsOn)) {
+				graph.Edges = append(graph.Edges, GraphEdge{
+					From: svcID, To: file + ":" + dep, Kind: EdgeDependsOn, Label: svc.DependsOn[dep].Condition,
+				})
+			}
+
+			for _, vol := range svc.Volumes {
+				if vol.Type != types.VolumeTypeBind || vol.Source == "" {
+					continue
+				}
+				binds[file] = append(binds[file], filepath.Clean(vol.Source))
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(project.Networks)) {
+			netConf := project.Networks[key]
+			networks = append(networks, resource{stack: file, name: netConf.Name, external: bool(netConf.External)})
+			if !bool(netConf.External) {
+				networkOwners[netConf.Name] = file
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(project.Volumes)) {
+			volConf := project.Volumes[key]
+			volumes = append(volumes, resource{stack: file, name: volConf.Name, external: bool(volConf.External)})
+			if !bool(volConf.External) {
+				volumeOwners[volConf.Name] = file
+			}
+		}
+	}
+
+	// stack -> stacks it needs running first
+	requires := map[string]map[string]struct{}{}
+	externalNodes := map[string]struct{}{}
+	link := func(resources []resource, owners map[string]string, kind EdgeKind) {
+		for _, res := range resources {
+			if !res.external {
+				continue
+			}
+
+			owner, ok := owners[res.name]
+			if !ok {
+				owner = string(kind) + ":" + res.name
+				if _, seen := externalNodes[owner]; !seen {
+					externalNodes[owner] = struct{}{}
+					graph.Nodes = append(graph.Nodes, GraphNode{ID: owner, Kind: NodeExternal, Label: res.name})
+				}
+			} else if owner != res.stack {
+				if requires[res.stack] == nil {
+					requires[res.stack] = map[string]struct{}{}
+				}
+				requires[res.stack][owner] = struct{}{}
+			}
+
+			graph.Edges = append(graph.Edges, GraphEdge{From: res.stack, To: owner, Kind: kind, Label: res.name})
+		}
+	}
+	link(networks, networkOwners, EdgeNetwork)
+	link(volumes, volumeOwners, EdgeVolume)
+
+	for i, a := range files {
+		for _, b := range files[i+1:] {
+			for _, path := range sharedPaths(binds[a], binds[b]) {
+				graph.Edges = append(graph.Edges, GraphEdge{From: a, To: b, Kind: EdgeSharedPath, Label: path})
+			}
+		}
+	}
+
+	order, err := startOrder(files, requires)
+	if err != nil {
+		graph.OrderError = err.Error()
+	}
+	graph.StartOrder = order
+
+	return graph
+}
+
+// sharedPaths returns paths bound by both stacks,
+// a path is shared if it is equal to or inside a path of the other stack
+func sharedPaths(a, b []string) []string {
+	var shared []string
+	for _, pa := range a {
+		for _, pb := range b {
+			switch {
+			case isSubPath(pa, pb):
+				shared = append(shared, pa)
+			case isSubPath(pb, pa):
+				shared = append(shared, pb)
+			}
+		}
+	}
+	slices.Sort(shared)
+	return slices.Compact(shared)
+}
+
+// isSubPath checks if child is equal to or inside parent
+func isSubPath(child, parent string) bool {
+	return child == parent || strings.HasPrefix(child, parent+string(filepath.Separator))
+}
+
+// startOrder sorts stacks topologically so every stack starts after the stacks it requires,
+// stacks without requirements keep alphabetical order
+func startOrder(files []string, requires map[string]map[string]struct{}) ([]string, error) {
+	remaining := make(map[string]int, len(files))
+	dependents := map[string][]string{}
+	for _, file := range files {
+		remaining[file] = len(requires[file])
+		for dep := range requires[file] {
+			dependents[dep] = append(dependents[dep], file)
+		}
+	}
+
+	var ready []string
+	for _, file := range files {
+		if remaining[file] == 0 {
+			ready = append(ready, file)
+		}
+	}
+
+	order := make([]string, 0, len(files))
+	for len(ready) > 0 {
+		slices.Sort(ready)
+		next := ready[0]
+		ready = ready[1:]
+		order = append(order, next)
+
+		for _, dependent := range dependents[next] {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(order) != len(files) {
+		var cycle []string
+		for _, file := range files {
+			if remaining[file] > 0 {
+				cycle = append(cycle, file)
+			}
+		}
+		return nil, fmt.Errorf("stacks have a circular dependency: %s", strings.Join(cycle, ", "))
+	}
+
+	return order, nil
+}
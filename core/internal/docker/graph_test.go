@@ -0,0 +1,75 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGraph(t *testing.T) {
+	projects := map[string]*types.Project{
+		"proxy/compose.yaml": {
+			Name: "proxy",
+			Services: types.Services{
+				"traefik": {Name: "traefik"},
+			},
+			Networks: types.Networks{"web": {Name: "web"}},
+		},
+		"app/compose.yaml": {
+			Name: "app",
+			Services: types.Services{
+				"api": {
+					Name:      "api",
+					DependsOn: types.DependsOnConfig{"db": {Condition: types.ServiceConditionHealthy}},
+					Volumes: []types.ServiceVolumeConfig{
+						{Type: types.VolumeTypeBind, Source: "/srv/data/uploads", Target: "/uploads"},
+					},
+				},
+				"db": {Name: "db"},
+			},
+			Networks: types.Networks{
+				"web":    {Name: "web", External: true},
+				"shared": {Name: "shared", External: true},
+			},
+		},
+		"backup/compose.yaml": {
+			Name: "backup",
+			Services: types.Services{
+				"restic": {
+					Name: "restic",
+					Volumes: []types.ServiceVolumeConfig{
+						{Type: types.VolumeTypeBind, Source: "/srv/data", Target: "/data"},
+					},
+				},
+			},
+		},
+	}
+
+	graph := buildGraph(projects)
+
+	require.Empty(t, graph.OrderError)
+	require.Equal(t, []string{"backup/compose.yaml", "proxy/compose.yaml", "app/compose.yaml"}, graph.StartOrder)
+
+	require.Contains(t, graph.Edges, GraphEdge{
+		From: "app/compose.yaml:api", To: "app/compose.yaml:db", Kind: EdgeDependsOn, Label: types.ServiceConditionHealthy,
+	})
+	require.Contains(t, graph.Edges, GraphEdge{
+		From: "app/compose.yaml", To: "proxy/compose.yaml", Kind: EdgeNetwork, Label: "web",
+	})
+	require.Contains(t, graph.Edges, GraphEdge{
+		From: "app/compose.yaml", To: "network:shared", Kind: EdgeNetwork, Label: "shared",
+	})
+	require.Contains(t, graph.Edges, GraphEdge{
+		From: "app/compose.yaml", To: "backup/compose.yaml", Kind: EdgeSharedPath, Label: "/srv/data/uploads",
+	})
+	require.Contains(t, graph.Nodes, GraphNode{ID: "network:shared", Kind: NodeExternal, Label: "shared"})
+}
+
+func TestStartOrderCycle(t *testing.T) {
+	_, err := startOrder([]string{"a", "b"}, map[string]map[string]struct{}{
+		"a": {"b": {}},
+		"b": {"a": {}},
+	})
+	require.ErrorContains(t, err, "circular")
+}
@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"maps"
 	"net"
 	"net/http"
@@ -23,8 +25,11 @@ import (
 	"github.com/compose-spec/compose-go/v2/types"
 	"github.com/docker/compose/v2/pkg/api"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/rs/zerolog/log"
 )
@@ -32,18 +37,39 @@ import (
 // ServiceProvider use a closure instead of passing a concrete Service to change hosts on demand
 type ServiceProvider func() *Service
 
+// ReconnectFunc re-establishes the connection to the named docker host and returns a service for it
+type ReconnectFunc func(host string) (*Service, error)
+
+// ComposeFilesProvider lists the files in the compose root of the active host grouped by directory
+type ComposeFilesProvider func() (map[string][]string, error)
+
+// HostsProvider returns a docker service for every connected host keyed by host name
+type HostsProvider func() map[string]*Service
+
 type Handler struct {
-	srv  ServiceProvider
-	addr string
+	srv       ServiceProvider
+	hosts     HostsProvider
+	addr      string
+	reconnect ReconnectFunc
+	files     ComposeFilesProvider
 
 	// store input channels for a running exec channel
 	execSessions syncmap.Map[string, chan string]
 }
 
-func NewConnectHandler(srv ServiceProvider, host string) *Handler {
+func NewConnectHandler(
+	srv ServiceProvider,
+	hosts HostsProvider,
+	host string,
+	reconnect ReconnectFunc,
+	files ComposeFilesProvider,
+) *Handler {
 	return &Handler{
-		srv:  srv,
-		addr: host,
+		srv:       srv,
+		hosts:     hosts,
+		addr:      host,
+		reconnect: reconnect,
+		files:     files,
 	}
 }
 
@@ -62,7 +88,7 @@ func (h *Handler) container() *ContainerService {
 func (h *Handler) ComposeStart(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
 	return h.executeComposeStreamCommand(
 		ctx,
-		req.Msg.GetFilename(),
+		req.Msg,
 		responseStream,
 		h.compose().ComposeUp,
 		req.Msg.GetSelectedServices()...,
@@ -72,7 +98,7 @@ func (h *Handler) ComposeStart(ctx context.Context, req *connect.Request[v1.Comp
 func (h *Handler) ComposeStop(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
 	return h.executeComposeStreamCommand(
 		ctx,
-		req.Msg.GetFilename(),
+		req.Msg,
 		responseStream,
 		h.compose().ComposeStop,
 		req.Msg.GetSelectedServices()...,
@@ -82,7 +108,7 @@ func (h *Handler) ComposeStop(ctx context.Context, req *connect.Request[v1.Compo
 func (h *Handler) ComposeRemove(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
 	return h.executeComposeStreamCommand(
 		ctx,
-		req.Msg.GetFilename(),
+		req.Msg,
 		responseStream,
 		h.compose().ComposeDown,
 		req.Msg.GetSelectedServices()...,
@@ -92,17 +118,34 @@ func (h *Handler) ComposeRemove(ctx context.Context, req *connect.Request[v1.Com
 func (h *Handler) ComposeRestart(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
 	return h.executeComposeStreamCommand(
 		ctx,
-		req.Msg.GetFilename(),
+		req.Msg,
 		responseStream,
 		h.compose().ComposeRestart,
 		req.Msg.GetSelectedServices()...,
 	)
 }
 
+func (h *Handler) ComposeBuild(ctx context.Context, req *connect.Request[v1.ComposeBuildRequest], responseStream *connect.ServerStream[v1.LogsMessage]) error {
+	opts := BuildOptions{
+		Pull:    req.Msg.GetPull(),
+		NoCache: req.Msg.GetNoCache(),
+	}
+
+	return h.executeComposeStreamCommand(
+		ctx,
+		req.Msg.GetFile(),
+		responseStream,
+		func(ctx context.Context, project *types.Project, composeClient api.Service, services ...string) error {
+			return h.compose().ComposeBuild(ctx, project, composeClient, opts, services...)
+		},
+		req.Msg.GetFile().GetSelectedServices()...,
+	)
+}
+
 func (h *Handler) ComposeUpdate(ctx context.Context, req *connect.Request[v1.ComposeFile], responseStream *connect.ServerStream[v1.LogsMessage]) error {
 	err := h.executeComposeStreamCommand(
 		ctx,
-		req.Msg.GetFilename(),
+		req.Msg,
 		responseStream,
 		h.compose().ComposeUpdate,
 		req.Msg.GetSelectedServices()...,
@@ -117,17 +160,213 @@ func (h *Handler) ComposeUpdate(ctx context.Context, req *connect.Request[v1.Com
 }
 
 func (h *Handler) ComposeValidate(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeValidateResponse], error) {
-	errs := h.compose().ComposeValidate(ctx, req.Msg.Filename)
-	toMap := ToMap(errs, func(t error) string {
-		return t.Error()
-	})
+	findings := h.compose().ComposeValidate(ctx, req.Msg.Filename, projectOptions(req.Msg)...)
+
+	var errs []string
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			errs = append(errs, f.Error())
+		}
+	}
+
 	return connect.NewResponse(&v1.ComposeValidateResponse{
-		Errs: toMap,
+		Errs: errs,
+		Findings: ToMap(findings, func(f ValidationFinding) *v1.ValidationFinding {
+			return &v1.ValidationFinding{
+				Severity: string(f.Severity),
+				Check:    string(f.Check),
+				Service:  f.Service,
+				Message:  f.Message,
+			}
+		}),
 	}), nil
 }
 
+func (h *Handler) ComposeDrift(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeDriftResponse], error) {
+	drift, err := h.compose().ComposeDrift(ctx, req.Msg.Filename, projectOptions(req.Msg)...)
+	if err != nil {
+		return nil, err
+	}
+
+	drifted := false
+	services := ToMap(drift, func(d ServiceDrift) *v1.ServiceDrift {
+		if d.State != DriftInSync {
+			drifted = true
+		}
+		return toRPCServiceDrift(d)
+	})
+
+	return connect.NewResponse(&v1.ComposeDriftResponse{
+		Drifted:  drifted,
+		Services: services,
+	}), nil
+}
+
+func (h *Handler) ComposePlan(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposePlanResponse], error) {
+	plan, err := h.compose().ComposePlan(ctx, req.Msg.Filename, req.Msg.SelectedServices, projectOptions(req.Msg)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ComposePlanResponse{
+		HasChanges: plan.HasChanges(),
+		Containers: ToMap(plan.Containers, func(c PlannedContainer) *v1.PlannedContainer {
+			return &v1.PlannedContainer{
+				Service:       c.Service,
+				ContainerName: c.ContainerName,
+				Action:        string(c.Action),
+				Reason:        c.Reason,
+			}
+		}),
+		PullImages:     plan.PullImages,
+		CreateNetworks: plan.CreateNetworks,
+		CreateVolumes:  plan.CreateVolumes,
+	}), nil
+}
+
+func (h *Handler) ComposeConfig(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ComposeConfigResponse], error) {
+	rendered, err := h.compose().ComposeConfig(ctx, req.Msg.Filename, projectOptions(req.Msg)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ComposeConfigResponse{
+		Yaml: rendered.YAML,
+		Variables: ToMap(rendered.Variables, func(v ConfigVariable) *v1.ConfigVariable {
+			return &v1.ConfigVariable{
+				Name:     v.Name,
+				Value:    v.Value,
+				Source:   string(v.Source),
+				Default:  v.Default,
+				Required: v.Required,
+			}
+		}),
+	}), nil
+}
+
+func (h *Handler) ComposeOverview(ctx context.Context, _ *connect.Request[v1.Empty]) (*connect.Response[v1.ComposeOverviewResponse], error) {
+	files, err := h.listFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	stacks, err := h.compose().ComposeOverview(ctx, files)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ComposeOverviewResponse{
+		Stacks: ToMap(stacks, func(st StackStatus) *v1.StackStatus {
+			return &v1.StackStatus{
+				Filename:          st.Filename,
+				StackName:         st.StackName,
+				State:             string(st.State),
+				ExpectedServices:  int32(st.ExpectedServices),
+				RunningServices:   int32(st.RunningServices),
+				DriftedServices:   st.DriftedServices,
+				UnhealthyServices: st.UnhealthyServices,
+				Error:             st.Error,
+			}
+		}),
+	}), nil
+}
+
+func (h *Handler) StackGraph(ctx context.Context, _ *connect.Request[v1.Empty]) (*connect.Response[v1.StackGraphResponse], error) {
+	graph, err := h.stackGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.StackGraphResponse{
+		Nodes: ToMap(graph.Nodes, func(n GraphNode) *v1.GraphNode {
+			return &v1.GraphNode{
+				Id:    n.ID,
+				Kind:  string(n.Kind),
+				Label: n.Label,
+				Error: n.Error,
+			}
+		}),
+		Edges: ToMap(graph.Edges, func(e GraphEdge) *v1.GraphEdge {
+			return &v1.GraphEdge{
+				From:  e.From,
+				To:    e.To,
+				Kind:  string(e.Kind),
+				Label: e.Label,
+			}
+		}),
+		StartOrder: graph.StartOrder,
+		OrderError: graph.OrderError,
+	}), nil
+}
+
+// ComposeStartAll starts every stack, stacks providing external networks and volumes first
+func (h *Handler) ComposeStartAll(ctx context.Context, _ *connect.Request[v1.Empty], responseStream *connect.ServerStream[v1.LogsMessage]) error {
+	order, err := h.stackStartOrder(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range order {
+		if err = responseStream.Send(&v1.LogsMessage{Message: fmt.Sprintf("==> starting %s\n", file)}); err != nil {
+			return err
+		}
+
+		// later stacks may need this one, so stop on the first failure
+		err = h.executeComposeStreamCommand(ctx, &v1.ComposeFile{Filename: file}, responseStream, h.compose().ComposeUp)
+		if err != nil {
+			return fmt.Errorf("unable to start %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// ComposeStopAll stops every stack in the reverse start order
+func (h *Handler) ComposeStopAll(ctx context.Context, _ *connect.Request[v1.Empty], responseStream *connect.ServerStream[v1.LogsMessage]) error {
+	order, err := h.stackStartOrder(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, file := range slices.Backward(order) {
+		if err = responseStream.Send(&v1.LogsMessage{Message: fmt.Sprintf("==> stopping %s\n", file)}); err != nil {
+			return err
+		}
+
+		err = h.executeComposeStreamCommand(ctx, &v1.ComposeFile{Filename: file}, responseStream, h.compose().ComposeStop)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to stop %s: %w", file, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (h *Handler) stackGraph(ctx context.Context) (*StackGraph, error) {
+	files, err := h.listFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	return h.compose().StackGraph(ctx, files)
+}
+
+// stackStartOrder returns the compose files in start order
+func (h *Handler) stackStartOrder(ctx context.Context) ([]string, error) {
+	graph, err := h.stackGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if graph.OrderError != "" {
+		return nil, errors.New(graph.OrderError)
+	}
+
+	return graph.StartOrder, nil
+}
+
 func (h *Handler) ComposeList(ctx context.Context, req *connect.Request[v1.ComposeFile]) (*connect.Response[v1.ListResponse], error) {
-	project, err := h.compose().LoadProject(ctx, req.Msg.GetFilename())
+	project, err := h.compose().LoadProject(ctx, req.Msg.GetFilename(), projectOptions(req.Msg)...)
 	if err != nil {
 		return nil, err
 	}
@@ -191,6 +430,22 @@ func (h *Handler) ContainerStart(ctx context.Context, req *connect.Request[v1.Co
 	return connect.NewResponse(&v1.LogsMessage{}), nil
 }
 
+func (h *Handler) ContainerImport(ctx context.Context, req *connect.Request[v1.ContainerImportRequest]) (*connect.Response[v1.ContainerImportResponse], error) {
+	if req.Msg.Filename == "" {
+		return nil, fmt.Errorf("filename is empty")
+	}
+
+	yaml, err := h.compose().ImportContainers(ctx, req.Msg.Filename, req.Msg.ContainerIds...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ContainerImportResponse{
+		Filename: req.Msg.Filename,
+		Yaml:     yaml,
+	}), nil
+}
+
 func (h *Handler) ContainerStop(ctx context.Context, req *connect.Request[v1.ContainerRequest]) (*connect.Response[v1.LogsMessage], error) {
 	err := h.container().ContainersStop(ctx, req.Msg.ContainerIds...)
 	if err != nil {
@@ -243,7 +498,7 @@ func (h *Handler) ContainerStats(ctx context.Context, req *connect.Request[v1.St
 	var err error
 	if file != nil {
 		// file was passed load it from context
-		project, err := h.compose().LoadProject(ctx, file.Filename)
+		project, err := h.compose().LoadProject(ctx, file.Filename, projectOptions(file)...)
 		if err != nil {
 			return nil, err
 		}
@@ -311,6 +566,80 @@ func (h *Handler) ContainerLogs(ctx context.Context, req *connect.Request[v1.Con
 	return nil
 }
 
+func (h *Handler) ContainerListFiles(ctx context.Context, req *connect.Request[v1.ContainerListFilesRequest]) (*connect.Response[v1.ContainerListFilesResponse], error) {
+	if req.Msg.GetContainerID() == "" {
+		return nil, fmt.Errorf("container id is required")
+	}
+
+	entries, truncated, err := h.container().ContainerListDir(ctx, req.Msg.ContainerID, req.Msg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.ContainerListFilesResponse{
+		Files:     toRPCFileEntries(entries),
+		Truncated: truncated,
+	}), nil
+}
+
+func (h *Handler) ContainerDownload(ctx context.Context, req *connect.Request[v1.ContainerFileRequest], stream *connect.ServerStream[v1.ArchiveChunk]) error {
+	if req.Msg.GetContainerID() == "" {
+		return fmt.Errorf("container id is required")
+	}
+
+	reader, stat, err := h.container().ContainerCopyFrom(ctx, req.Msg.ContainerID, req.Msg.Path)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(reader)
+
+	name := stat.Name
+	if name == "" || name == "/" {
+		name = "root"
+	}
+	return sendArchive(reader, name+".tar", stream)
+}
+
+// sendArchive streams r in chunks, the file name is only sent with the first chunk
+func sendArchive(r io.Reader, filename string, stream *connect.ServerStream[v1.ArchiveChunk]) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			chunk := &v1.ArchiveChunk{Filename: filename, Data: buf[:n]}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			filename = ""
+		}
+		if errors.Is(readErr, io.EOF) {
+			return nil
+		}
+		if readErr != nil {
+			return readErr
+		}
+	}
+}
+
+func (h *Handler) ContainerUpload(ctx context.Context, req *connect.Request[v1.ContainerUploadRequest]) (*connect.Response[v1.Empty], error) {
+	if req.Msg.GetContainerID() == "" {
+		return nil, fmt.Errorf("container id is required")
+	}
+
+	err := h.container().ContainerWriteFile(
+		ctx,
+		req.Msg.ContainerID,
+		req.Msg.Path,
+		req.Msg.Contents,
+		fs.FileMode(req.Msg.Mode).Perm(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
 func (h *Handler) ContainerExecOutput(ctx context.Context, req *connect.Request[v1.ContainerExecRequest], stream *connect.ServerStream[v1.LogsMessage]) error {
 	if req.Msg.GetContainerID() == "" {
 		return fmt.Errorf("container id is required")
@@ -493,6 +822,178 @@ func (h *Handler) ImagePruneUnused(ctx context.Context, req *connect.Request[v1.
 	return connect.NewResponse(&response), nil
 }
 
+func (h *Handler) DiskUsage(ctx context.Context, req *connect.Request[v1.DiskUsageRequest]) (*connect.Response[v1.DiskUsageResponse], error) {
+	srv, err := h.host(req.Msg.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := srv.Container.DiskUsage(ctx, int(req.Msg.GetTopN()))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.DiskUsageResponse{
+		Images:     toRPCUsageCategory(usage.Images),
+		Containers: toRPCUsageCategory(usage.Containers),
+		Volumes:    toRPCUsageCategory(usage.Volumes),
+		BuildCache: toRPCUsageCategory(usage.BuildCache),
+	}), nil
+}
+
+func toRPCUsageCategory(cat UsageCategory) *v1.UsageCategory {
+	return &v1.UsageCategory{
+		Count:       int64(cat.Count),
+		Active:      int64(cat.Active),
+		Total:       cat.Total,
+		Reclaimable: cat.Reclaimable,
+		Top: ToMap(cat.Top, func(item UsageItem) *v1.UsageItem {
+			return &v1.UsageItem{
+				Id:          item.ID,
+				Name:        item.Name,
+				Size:        item.Size,
+				Reclaimable: item.Reclaimable,
+			}
+		}),
+	}
+}
+
+func (h *Handler) Prune(ctx context.Context, req *connect.Request[v1.PruneRequest]) (*connect.Response[v1.PruneResponse], error) {
+	srv, err := h.host(req.Msg.GetHost())
+	if err != nil {
+		return nil, err
+	}
+
+	msg := req.Msg
+	report, err := srv.Container.Prune(ctx, PruneOptions{
+		Containers: msg.Containers,
+		Images:     msg.Images,
+		AllImages:  msg.AllImages,
+		Volumes:    msg.Volumes,
+		AllVolumes: msg.AllVolumes,
+		Networks:   msg.Networks,
+		BuildCache: msg.BuildCache,
+		Until:      msg.Until,
+		Labels:     msg.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.PruneResponse{
+		ContainersDeleted: report.ContainersDeleted,
+		ImagesDeleted:     report.ImagesDeleted,
+		VolumesDeleted:    report.VolumesDeleted,
+		NetworksDeleted:   report.NetworksDeleted,
+		CachesDeleted:     report.CachesDeleted,
+		SpaceReclaimed:    report.SpaceReclaimed,
+		Skipped:           report.Skipped,
+		Errors:            report.Errors,
+	}), nil
+}
+
+func (h *Handler) ImageBuild(ctx context.Context, req *connect.Request[v1.ImageBuildRequest], responseStream *connect.ServerStream[v1.ImageProgress]) error {
+	opts := ImageBuildOptions{
+		Dir:        req.Msg.GetDir(),
+		Dockerfile: req.Msg.GetDockerfile(),
+		Tags:       req.Msg.GetTags(),
+		BuildArgs:  req.Msg.GetBuildArgs(),
+		Target:     req.Msg.GetTarget(),
+		Pull:       req.Msg.GetPull(),
+		NoCache:    req.Msg.GetNoCache(),
+	}
+	return h.compose().ImageBuild(ctx, opts, sendProgress(responseStream))
+}
+
+func (h *Handler) ImagePush(ctx context.Context, req *connect.Request[v1.ImagePushRequest], responseStream *connect.ServerStream[v1.ImageProgress]) error {
+	if req.Msg.GetImage() == "" {
+		return fmt.Errorf("image is required")
+	}
+
+	auth := RegistryAuth{
+		Username: req.Msg.GetUsername(),
+		Password: req.Msg.GetPassword(),
+	}
+	return h.container().ImagePush(ctx, req.Msg.GetImage(), auth, sendProgress(responseStream))
+}
+
+func (h *Handler) ImageSave(ctx context.Context, req *connect.Request[v1.ImageSaveRequest], stream *connect.ServerStream[v1.ArchiveChunk]) error {
+	reader, err := h.container().ImageSave(ctx, req.Msg.GetImages()...)
+	if err != nil {
+		return err
+	}
+	defer fileutil.Close(reader)
+
+	return sendArchive(reader, imageArchiveName(req.Msg.GetImages()), stream)
+}
+
+func (h *Handler) ImageTransfer(ctx context.Context, req *connect.Request[v1.ImageTransferRequest], responseStream *connect.ServerStream[v1.ImageProgress]) error {
+	if len(req.Msg.GetImages()) == 0 {
+		return fmt.Errorf("at least one image is required")
+	}
+
+	src, err := h.host(req.Msg.GetSourceHost())
+	if err != nil {
+		return err
+	}
+	dst, err := h.host(req.Msg.GetTargetHost())
+	if err != nil {
+		return err
+	}
+	// services are created per call, compare the resolved names
+	if src.Hostname() == dst.Hostname() {
+		return fmt.Errorf("source and target host are the same")
+	}
+
+	return ImageTransfer(ctx, src.Container, dst.Container, req.Msg.GetImages(), sendProgress(responseStream))
+}
+
+// host returns the service of a connected host, empty name is the active host
+func (h *Handler) host(name string) (*Service, error) {
+	if name == "" {
+		return h.srv(), nil
+	}
+	srv, ok := h.hosts()[name]
+	if !ok {
+		return nil, fmt.Errorf("host %s is not connected", name)
+	}
+	return srv, nil
+}
+
+// imageArchiveName derives a file name from the first image, eg: nginx_1.27.tar
+func imageArchiveName(refs []string) string {
+	if len(refs) == 0 {
+		return "images.tar"
+	}
+	name := strings.NewReplacer("/", "_", ":", "_", "@", "_").Replace(refs[0])
+	if len(refs) > 1 {
+		name += fmt.Sprintf("-and-%d-more", len(refs)-1)
+	}
+	return name + ".tar"
+}
+
+func sendProgress(responseStream *connect.ServerStream[v1.ImageProgress]) ProgressFunc {
+	return func(msg jsonmessage.JSONMessage) error {
+		rpcMsg := &v1.ImageProgress{
+			Id:     msg.ID,
+			Status: msg.Status,
+			Stream: msg.Stream,
+		}
+		if msg.Progress != nil {
+			rpcMsg.Progress = msg.Progress.String()
+			rpcMsg.Current = msg.Progress.Current
+			rpcMsg.Total = msg.Progress.Total
+		}
+		if msg.Error != nil {
+			rpcMsg.Error = msg.Error.Message
+		}
+		if msg.Aux != nil {
+			rpcMsg.Aux = string(*msg.Aux)
+		}
+		return responseStream.Send(rpcMsg)
+	}
+}
+
 ////////////////////////////////////////////
 // 				Volume Actions 			  //
 ////////////////////////////////////////////
@@ -505,22 +1006,25 @@ func (h *Handler) VolumeList(ctx context.Context, _ *connect.Request[v1.ListVolu
 
 	var rpcVolumes []*v1.Volume
 	for _, vol := range volumes {
-
-		rpcVolumes = append(rpcVolumes, &v1.Volume{
-			Name:               vol.Name,
-			ContainerID:        vol.ContainerID,
-			Size:               safeGetSize(vol),
-			CreatedAt:          vol.CreatedAt,
-			Labels:             getVolumeProjectNameFromLabel(vol.Labels),
-			MountPoint:         vol.Mountpoint,
-			ComposePath:        h.getComposeFilePath(vol.ComposePath),
-			ComposeProjectName: vol.ComposeProjectName,
-		})
+		rpcVolumes = append(rpcVolumes, h.toRPCVolume(vol))
 	}
 
 	return connect.NewResponse(&v1.ListVolumesResponse{Volumes: rpcVolumes}), nil
 }
 
+func (h *Handler) toRPCVolume(vol VolumeInfo) *v1.Volume {
+	return &v1.Volume{
+		Name:               vol.Name,
+		ContainerID:        vol.ContainerID,
+		Size:               safeGetSize(vol),
+		CreatedAt:          vol.CreatedAt,
+		Labels:             getVolumeProjectNameFromLabel(vol.Labels),
+		MountPoint:         vol.Mountpoint,
+		ComposePath:        h.getComposeFilePath(vol.ComposePath),
+		ComposeProjectName: vol.ComposeProjectName,
+	}
+}
+
 func safeGetSize(vol VolumeInfo) int64 {
 	if vol.UsageData == nil {
 		return 0
@@ -544,9 +1048,20 @@ func getVolumeProjectNameFromLabel(labels map[string]string) string {
 	return ""
 }
 
-func (h *Handler) VolumeCreate(_ context.Context, _ *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error) {
-	//TODO implement me
-	return nil, fmt.Errorf(" implement me VolumeCreate")
+func (h *Handler) VolumeCreate(ctx context.Context, req *connect.Request[v1.CreateVolumeRequest]) (*connect.Response[v1.CreateVolumeResponse], error) {
+	vol, err := h.container().VolumesCreate(ctx, volume.CreateOptions{
+		Name:       req.Msg.Name,
+		Driver:     req.Msg.Driver,
+		DriverOpts: req.Msg.DriverOpts,
+		Labels:     req.Msg.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CreateVolumeResponse{
+		Volume: h.toRPCVolume(VolumeInfo{Volume: &vol}),
+	}), nil
 }
 
 func (h *Handler) VolumeDelete(ctx context.Context, req *connect.Request[v1.DeleteVolumeRequest]) (*connect.Response[v1.DeleteVolumeResponse], error) {
@@ -564,6 +1079,68 @@ func (h *Handler) VolumeDelete(ctx context.Context, req *connect.Request[v1.Dele
 	return connect.NewResponse(&v1.DeleteVolumeResponse{}), err
 }
 
+func (h *Handler) VolumeListFiles(ctx context.Context, req *connect.Request[v1.VolumeListFilesRequest]) (*connect.Response[v1.VolumeListFilesResponse], error) {
+	entries, err := h.container().VolumeListDir(ctx, req.Msg.Volume, req.Msg.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.VolumeListFilesResponse{Files: toRPCFileEntries(entries)}), nil
+}
+
+func toRPCFileEntries(entries []FileEntry) []*v1.FileEntry {
+	files := make([]*v1.FileEntry, 0, len(entries))
+	for _, e := range entries {
+		files = append(files, &v1.FileEntry{
+			Name:       e.Name,
+			Path:       e.Path,
+			Size:       e.Size,
+			IsDir:      e.IsDir(),
+			Mode:       e.Mode.String(),
+			ModTime:    e.ModTime.Format(time.RFC3339),
+			Uid:        int32(e.UID),
+			Gid:        int32(e.GID),
+			LinkTarget: e.LinkTarget,
+		})
+	}
+	return files
+}
+
+func (h *Handler) VolumeReadFile(ctx context.Context, req *connect.Request[v1.VolumeFileRequest]) (*connect.Response[v1.VolumeFileContents], error) {
+	contents, err := h.container().VolumeReadFile(ctx, req.Msg.Volume, req.Msg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.VolumeFileContents{
+		Path:     cleanRootPath(req.Msg.Path),
+		Contents: contents,
+	}), nil
+}
+
+func (h *Handler) VolumeWriteFile(ctx context.Context, req *connect.Request[v1.VolumeWriteFileRequest]) (*connect.Response[v1.Empty], error) {
+	err := h.container().VolumeWriteFile(
+		ctx,
+		req.Msg.Volume,
+		req.Msg.Path,
+		req.Msg.Contents,
+		fs.FileMode(req.Msg.Mode).Perm(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) VolumeDeleteFile(ctx context.Context, req *connect.Request[v1.VolumeDeleteFileRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.container().VolumeDeleteFile(ctx, req.Msg.Volume, req.Msg.Path, req.Msg.Recursive); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
 ////////////////////////////////////////////
 // 				Network Actions 		  //
 ////////////////////////////////////////////
@@ -576,25 +1153,29 @@ func (h *Handler) NetworkList(ctx context.Context, _ *connect.Request[v1.ListNet
 
 	var rpcNetworks []*v1.Network
 	for _, netI := range networks {
-		rpcNetworks = append(rpcNetworks, &v1.Network{
-			Id:             netI.ID,
-			Name:           netI.Name,
-			CreatedAt:      netI.Created.Format(time.RFC3339),
-			Subnet:         getSubnet(netI),
-			Scope:          netI.Scope,
-			Driver:         netI.Driver,
-			EnableIpv4:     netI.EnableIPv4,
-			EnableIpv6:     netI.EnableIPv6,
-			Internal:       netI.Internal,
-			Attachable:     netI.Attachable,
-			ComposeProject: netI.Labels[api.ProjectLabel],
-			ContainerIds:   slices.Collect(maps.Keys(netI.Containers)),
-		})
+		rpcNetworks = append(rpcNetworks, toRPCNetwork(netI))
 	}
 
 	return connect.NewResponse(&v1.ListNetworksResponse{Networks: rpcNetworks}), nil
 }
 
+func toRPCNetwork(netI network.Inspect) *v1.Network {
+	return &v1.Network{
+		Id:             netI.ID,
+		Name:           netI.Name,
+		CreatedAt:      netI.Created.Format(time.RFC3339),
+		Subnet:         getSubnet(netI),
+		Scope:          netI.Scope,
+		Driver:         netI.Driver,
+		EnableIpv4:     netI.EnableIPv4,
+		EnableIpv6:     netI.EnableIPv6,
+		Internal:       netI.Internal,
+		Attachable:     netI.Attachable,
+		ComposeProject: netI.Labels[api.ProjectLabel],
+		ContainerIds:   slices.Collect(maps.Keys(netI.Containers)),
+	}
+}
+
 func getSubnet(netI network.Inspect) string {
 	if len(netI.IPAM.Config) == 0 {
 		return "-----"
@@ -602,9 +1183,42 @@ func getSubnet(netI network.Inspect) string {
 	return netI.IPAM.Config[0].Subnet
 }
 
-func (h *Handler) NetworkCreate(_ context.Context, _ *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error) {
-	//TODO implement me
-	return nil, fmt.Errorf(" implement me NetworkCreate")
+func (h *Handler) NetworkCreate(ctx context.Context, req *connect.Request[v1.CreateNetworkRequest]) (*connect.Response[v1.CreateNetworkResponse], error) {
+	msg := req.Msg
+
+	var ipamConfigs []network.IPAMConfig
+	if msg.Subnet != "" || msg.Gateway != "" || msg.IpRange != "" {
+		ipamConfigs = append(ipamConfigs, network.IPAMConfig{
+			Subnet:  msg.Subnet,
+			Gateway: msg.Gateway,
+			IPRange: msg.IpRange,
+		})
+	}
+	if msg.Ipv6Subnet != "" || msg.Ipv6Gateway != "" {
+		ipamConfigs = append(ipamConfigs, network.IPAMConfig{
+			Subnet:  msg.Ipv6Subnet,
+			Gateway: msg.Ipv6Gateway,
+		})
+	}
+
+	opts := network.CreateOptions{
+		Driver:     msg.Driver,
+		Options:    msg.Options,
+		Internal:   msg.Internal,
+		Attachable: msg.Attachable,
+		EnableIPv6: &msg.EnableIpv6,
+		Labels:     msg.Labels,
+	}
+	if len(ipamConfigs) > 0 {
+		opts.IPAM = &network.IPAM{Config: ipamConfigs}
+	}
+
+	created, err := h.container().NetworksCreate(ctx, msg.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.CreateNetworkResponse{Network: toRPCNetwork(created)}), nil
 }
 
 func (h *Handler) NetworkDelete(ctx context.Context, req *connect.Request[v1.DeleteNetworkRequest]) (*connect.Response[v1.DeleteNetworkResponse], error) {
@@ -623,6 +1237,213 @@ func (h *Handler) NetworkDelete(ctx context.Context, req *connect.Request[v1.Del
 	return connect.NewResponse(&v1.DeleteNetworkResponse{}), nil
 }
 
+func (h *Handler) NetworkTopology(ctx context.Context, _ *connect.Request[v1.Empty]) (*connect.Response[v1.NetworkTopologyResponse], error) {
+	topology, err := h.container().NetworksTopology(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcNodes []*v1.NetworkNode
+	for _, node := range topology {
+		var endpoints []*v1.NetworkEndpoint
+		for _, ep := range node.Endpoints {
+			endpoints = append(endpoints, &v1.NetworkEndpoint{
+				ContainerId:    ep.ContainerID,
+				ContainerName:  ep.ContainerName,
+				State:          ep.State,
+				Ipv4Address:    ep.IPv4Address,
+				Ipv6Address:    ep.IPv6Address,
+				MacAddress:     ep.MacAddress,
+				Aliases:        ep.Aliases,
+				ComposeProject: ep.ComposeProject,
+				ComposeService: ep.ComposeService,
+			})
+		}
+
+		rpcNodes = append(rpcNodes, &v1.NetworkNode{
+			Network:   toRPCNetwork(node.Network),
+			Endpoints: endpoints,
+			Unused:    node.Unused,
+			Builtin:   node.Builtin,
+		})
+	}
+
+	return connect.NewResponse(&v1.NetworkTopologyResponse{Networks: rpcNodes}), nil
+}
+
+func (h *Handler) NetworkConnect(ctx context.Context, req *connect.Request[v1.NetworkConnectRequest]) (*connect.Response[v1.Empty], error) {
+	msg := req.Msg
+	err := h.container().NetworksConnect(ctx, msg.NetworkId, msg.ContainerId, msg.Aliases, msg.Ipv4Address, msg.Ipv6Address)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) NetworkDisconnect(ctx context.Context, req *connect.Request[v1.NetworkDisconnectRequest]) (*connect.Response[v1.Empty], error) {
+	msg := req.Msg
+	if err := h.container().NetworksDisconnect(ctx, msg.NetworkId, msg.ContainerId, msg.Force); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+////////////////////////////////////////////
+// 				Events 			  		  //
+////////////////////////////////////////////
+
+const (
+	eventsMinBackoff = time.Second
+	eventsMaxBackoff = 30 * time.Second
+)
+
+func (h *Handler) Events(ctx context.Context, req *connect.Request[v1.EventsRequest], responseStream *connect.ServerStream[v1.DockerEvent]) error {
+	eventTypes := ToMap(req.Msg.GetTypes(), func(t string) events.Type {
+		return events.Type(t)
+	})
+
+	// the stream stays on the host it was started on, even if the active host is switched
+	cli := h.container()
+	var cursor eventCursor
+	backoff := eventsMinBackoff
+	for {
+		healthy, err := h.streamEvents(ctx, cli, &cursor, req.Msg.GetStackName(), responseStream, eventTypes...)
+		dropped := time.Now()
+		if healthy {
+			// stream was healthy for a while
+			backoff = eventsMinBackoff
+		}
+		if ctx.Err() != nil {
+			// client disconnected
+			return nil
+		}
+
+		var sendErr *eventSendError
+		if errors.As(err, &sendErr) {
+			return sendErr.err
+		}
+
+		log.Warn().Err(err).Str("host", cli.hostname).Str("retry", backoff.String()).
+			Msg("docker event stream dropped, reconnecting")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, eventsMaxBackoff)
+
+		if h.reconnect != nil {
+			srv, err := h.reconnect(cli.hostname)
+			if err != nil {
+				log.Warn().Err(err).Str("host", cli.hostname).Msg("unable to reconnect to docker host")
+				continue
+			}
+			cli = srv.Container
+		}
+
+		if cursor.timeNano == 0 {
+			// nothing was received yet, replay from the time the stream dropped
+			cursor.timeNano = dropped.UnixNano()
+		}
+	}
+}
+
+type eventSendError struct {
+	err error
+}
+
+func (e *eventSendError) Error() string {
+	return e.err.Error()
+}
+
+// eventCursor tracks the last forwarded event to resume a dropped stream from,
+// since is inclusive so events at the resume time are replayed and skipped here
+type eventCursor struct {
+	timeNano int64
+	// events already seen at timeNano
+	seen map[string]struct{}
+}
+
+// since returns the resume point in the seconds.nanoseconds format of the daemon
+func (c *eventCursor) since() string {
+	if c.timeNano == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d.%09d", c.timeNano/int64(time.Second), c.timeNano%int64(time.Second))
+}
+
+// advance records the event, returns false if it was already seen before a reconnect
+func (c *eventCursor) advance(msg events.Message) bool {
+	if msg.TimeNano < c.timeNano {
+		return false
+	}
+	if msg.TimeNano > c.timeNano {
+		c.timeNano = msg.TimeNano
+		c.seen = map[string]struct{}{}
+	}
+
+	key := string(msg.Type) + "/" + string(msg.Action) + "/" + msg.Actor.ID
+	if _, ok := c.seen[key]; ok {
+		return false
+	}
+	c.seen[key] = struct{}{}
+	return true
+}
+
+// streamEvents forwards daemon events until the daemon stream fails,
+// returns true if at least one event was received
+func (h *Handler) streamEvents(
+	ctx context.Context,
+	cli *ContainerService,
+	cursor *eventCursor,
+	stackName string,
+	responseStream *connect.ServerStream[v1.DockerEvent],
+	eventTypes ...events.Type,
+) (bool, error) {
+	msgs, errs := cli.Events(ctx, cursor.since(), eventTypes...)
+
+	received := false
+	for {
+		select {
+		case err := <-errs:
+			return received, err
+		case msg := <-msgs:
+			received = true
+			if !cursor.advance(msg) {
+				continue
+			}
+
+			labels := cli.EventLabels(ctx, msg)
+			if stackName != "" && labels[api.ProjectLabel] != stackName {
+				continue
+			}
+
+			if err := responseStream.Send(toRPCEvent(msg, labels, cli.hostname)); err != nil {
+				return received, &eventSendError{err: err}
+			}
+		}
+	}
+}
+
+func toRPCEvent(msg events.Message, labels map[string]string, host string) *v1.DockerEvent {
+	name := msg.Actor.Attributes["name"]
+	if name == "" {
+		name = msg.Actor.ID
+	}
+
+	return &v1.DockerEvent{
+		Type:        string(msg.Type),
+		Action:      string(msg.Action),
+		ActorID:     msg.Actor.ID,
+		Name:        name,
+		Attributes:  msg.Actor.Attributes,
+		StackName:   labels[api.ProjectLabel],
+		ServiceName: labels[api.ServiceLabel],
+		Time:        time.Unix(0, msg.TimeNano).UTC().Format(time.RFC3339Nano),
+		Host:        host,
+	}
+}
+
 ////////////////////////////////////////////
 // 				Utils 			  		  //
 ////////////////////////////////////////////
@@ -630,12 +1451,36 @@ func (h *Handler) NetworkDelete(ctx context.Context, req *connect.Request[v1.Del
 // executeComposeStreamCommand handles the boilerplate for running a Docker Compose command that streams logs.
 func (h *Handler) executeComposeStreamCommand(
 	ctx context.Context,
-	composeFile string,
+	composeFile *v1.ComposeFile,
 	responseStream *connect.ServerStream[v1.LogsMessage],
 	action func(context.Context, *types.Project, api.Service, ...string) error,
 	services ...string,
 ) error {
-	project, err := h.compose().LoadProject(ctx, composeFile)
+	// incase the stream connection is lost the command is not cancelled,
+	// allowing it to continue executing, instead of stopping mid-operation
+	return runComposeCommand(
+		context.WithoutCancel(ctx),
+		h.compose(),
+		composeFile,
+		func(val string) error {
+			return responseStream.Send(&v1.LogsMessage{Message: val})
+		},
+		action,
+		services...,
+	)
+}
+
+// runComposeCommand loads the project on the given host and runs action,
+// each line of compose output is passed to send
+func runComposeCommand(
+	ctx context.Context,
+	compose *ComposeService,
+	composeFile *v1.ComposeFile,
+	send func(val string) error,
+	action func(context.Context, *types.Project, api.Service, ...string) error,
+	services ...string,
+) error {
+	project, err := compose.LoadProject(ctx, composeFile.GetFilename(), projectOptions(composeFile)...)
 	if err != nil {
 		return err
 	}
@@ -644,21 +1489,14 @@ func (h *Handler) executeComposeStreamCommand(
 	//services = h.srv().withoutDockman(project, services...)
 	//log.Debug().Strs("ssdd", services).Msg("compose stream")
 
-	pipeWriter, wg := streamManager(func(val string) error {
-		if err = responseStream.Send(&v1.LogsMessage{Message: val}); err != nil {
-			return err
-		}
-		return nil
-	})
+	pipeWriter, wg := streamManager(send)
 
-	composeClient, err := h.compose().LoadComposeClient(pipeWriter, nil)
+	composeClient, err := compose.LoadComposeClient(pipeWriter, nil)
 	if err != nil {
 		return err
 	}
 
-	// incase the stream connection is lost context.Background
-	// will allow the service to continue executing, instead of stopping mid-operation
-	if err = action(context.Background(), project, composeClient, services...); err != nil {
+	if err = action(ctx, project, composeClient, services...); err != nil {
 		fileutil.Close(pipeWriter)
 		return err
 	}
@@ -669,6 +1507,36 @@ func (h *Handler) executeComposeStreamCommand(
 	return nil
 }
 
+// listFiles flattens the file tree of the compose root into paths relative to it
+func (h *Handler) listFiles() ([]string, error) {
+	fileList, err := h.files()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list compose files: %w", err)
+	}
+
+	var files []string
+	for dir, subFiles := range fileList {
+		if len(subFiles) == 0 {
+			// top level file
+			files = append(files, dir)
+			continue
+		}
+		for _, f := range subFiles {
+			files = append(files, filepath.Join(dir, f))
+		}
+	}
+
+	return files, nil
+}
+
+// projectOptions converts the extra files and profiles of a compose file request
+func projectOptions(file *v1.ComposeFile) []ProjectOption {
+	return []ProjectOption{
+		WithExtraFiles(file.GetExtraFiles()...),
+		WithProfiles(file.GetProfiles()...),
+	}
+}
+
 func ToRPCStat(cont ContainerStats) *v1.ContainerStats {
 	return &v1.ContainerStats{
 		Id:          cont.ID,
@@ -820,3 +1688,20 @@ func (l *ContainerLogWriter) Write(p []byte) (n int, err error) {
 	}
 	return len(p), nil
 }
+
+func toRPCServiceDrift(d ServiceDrift) *v1.ServiceDrift {
+	return &v1.ServiceDrift{
+		Service:       d.Service,
+		ContainerName: d.ContainerName,
+		State:         string(d.State),
+		ExpectedHash:  d.ExpectedHash,
+		ActualHash:    d.ActualHash,
+		Diffs: ToMap(d.Diffs, func(f FieldDiff) *v1.FieldDiff {
+			return &v1.FieldDiff{
+				Field:    f.Field,
+				Expected: f.Expected,
+				Actual:   f.Actual,
+			}
+		}),
+	}
+}
@@ -0,0 +1,137 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/docker/v1"
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/compose/v2/pkg/api"
+)
+
+const (
+	defaultBulkConcurrency = 4
+	maxBulkConcurrency     = 16
+	// max time a single stack may take, targets are detached from the request
+	bulkTargetTimeout = 30 * time.Minute
+)
+
+type BulkStatus string
+
+const (
+	BulkQueued  BulkStatus = "queued"
+	BulkRunning BulkStatus = "running"
+	// a line of compose output
+	BulkLog    BulkStatus = "log"
+	BulkDone   BulkStatus = "done"
+	BulkFailed BulkStatus = "failed"
+)
+
+type composeAction func(context.Context, *types.Project, api.Service, ...string) error
+
+// bulkActions maps the requested action to the compose operation of a host
+var bulkActions = map[string]func(*ComposeService) composeAction{
+	"start":   func(c *ComposeService) composeAction { return c.ComposeUp },
+	"stop":    func(c *ComposeService) composeAction { return c.ComposeStop },
+	"restart": func(c *ComposeService) composeAction { return c.ComposeRestart },
+	"update":  func(c *ComposeService) composeAction { return c.ComposeUpdate },
+}
+
+// ComposeBulk runs the same compose action on many stacks across hosts,
+// progress of every stack is streamed as it happens
+func (h *Handler) ComposeBulk(ctx context.Context, req *connect.Request[v1.BulkComposeRequest], responseStream *connect.ServerStream[v1.BulkProgress]) error {
+	if len(req.Msg.Targets) == 0 {
+		return fmt.Errorf("no stacks selected")
+	}
+	actionFn, ok := bulkActions[strings.ToLower(req.Msg.Action)]
+	if !ok {
+		return fmt.Errorf("unknown bulk action %q, must be one of start|stop|restart|update", req.Msg.Action)
+	}
+
+	hosts := h.hosts()
+
+	concurrency := int(req.Msg.Concurrency)
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+	concurrency = min(concurrency, maxBulkConcurrency)
+
+	// stream.Send is not safe for concurrent use
+	var sendMu sync.Mutex
+	send := func(target *v1.BulkTarget, status BulkStatus, message string) {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+
+		// send errors are ignored, targets keep running if the client disconnected
+		_ = responseStream.Send(&v1.BulkProgress{
+			Host:     target.Host,
+			Filename: target.GetFile().GetFilename(),
+			Status:   string(status),
+			Message:  message,
+		})
+	}
+
+	for _, target := range req.Msg.Targets {
+		send(target, BulkQueued, "")
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var failed int
+	var failedMu sync.Mutex
+
+	for _, target := range req.Msg.Targets {
+		wg.Go(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			// a client disconnect must not abort a stack halfway through an update
+			targetCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), bulkTargetTimeout)
+			defer cancel()
+
+			send(target, BulkRunning, "")
+			err := h.runBulkTarget(targetCtx, hosts, actionFn, target, func(line string) error {
+				send(target, BulkLog, line)
+				return nil
+			})
+			if err != nil {
+				failedMu.Lock()
+				failed++
+				failedMu.Unlock()
+
+				send(target, BulkFailed, err.Error())
+				return
+			}
+			send(target, BulkDone, "")
+		})
+	}
+	wg.Wait()
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d stacks failed", failed, len(req.Msg.Targets))
+	}
+	return nil
+}
+
+func (h *Handler) runBulkTarget(
+	ctx context.Context,
+	hosts map[string]*Service,
+	action func(*ComposeService) composeAction,
+	target *v1.BulkTarget,
+	send func(string) error,
+) error {
+	srv := h.srv()
+	if target.Host != "" {
+		var ok bool
+		srv, ok = hosts[target.Host]
+		if !ok {
+			return fmt.Errorf("host %q is not connected", target.Host)
+		}
+	}
+
+	return runComposeCommand(ctx, srv.Compose, target.GetFile(), send, action(srv.Compose), target.GetFile().GetSelectedServices()...)
+}
@@ -0,0 +1,81 @@
+package docker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/rs/zerolog/log"
+)
+
+// ImageActionHook is called after an image archive is loaded into a host, can be used for auditing
+type ImageActionHook func(r *http.Request, action, host string, err error, duration time.Duration)
+
+// ImageHandler takes image archive uploads,
+// they can be several GB so the body is streamed to the daemon instead of going through an rpc
+type ImageHandler struct {
+	srv   ServiceProvider
+	hosts HostsProvider
+	hook  ImageActionHook
+}
+
+func NewImageHandler(srv ServiceProvider, hosts HostsProvider, hook ImageActionHook) http.Handler {
+	if hook == nil {
+		hook = func(*http.Request, string, string, error, time.Duration) {}
+	}
+
+	hand := &ImageHandler{srv: srv, hosts: hosts, hook: hook}
+	return hand.register()
+}
+
+func (h *ImageHandler) register() http.Handler {
+	subMux := http.NewServeMux()
+	// body is the raw tar created by docker save or the ImageSave rpc,
+	// loads into the active host unless ?host= is set
+	subMux.HandleFunc("POST /load", h.load)
+
+	return subMux
+}
+
+func (h *ImageHandler) load(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	srv := h.srv()
+	if name := r.URL.Query().Get("host"); name != "" {
+		var ok bool
+		if srv, ok = h.hosts()[name]; !ok {
+			h.hook(r, "Load", name, fmt.Errorf("host %s is not connected", name), time.Since(start))
+			http.Error(w, "host "+name+" is not connected", http.StatusBadRequest)
+			return
+		}
+	}
+
+	// the daemon progress is passed on as newline delimited json
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+
+	err := srv.Container.ImageLoad(r.Context(), r.Body, func(msg jsonmessage.JSONMessage) error {
+		if err := enc.Encode(msg); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	h.hook(r, "Load", srv.Hostname(), err, time.Since(start))
+	if err != nil {
+		log.Error().Err(err).Msg("Error loading image archive")
+		// daemon errors were already sent as part of the progress
+		var daemonErr *jsonmessage.JSONError
+		if !errors.As(err, &daemonErr) {
+			_ = enc.Encode(jsonmessage.JSONMessage{
+				Error:        &jsonmessage.JSONError{Message: err.Error()},
+				ErrorMessage: err.Error(),
+			})
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventCursor(t *testing.T) {
+	var cursor eventCursor
+	require.Equal(t, "", cursor.since())
+
+	start := events.Message{Type: events.ContainerEventType, Action: events.ActionStart, Actor: events.Actor{ID: "a"}, TimeNano: 1700000000000000005}
+	die := events.Message{Type: events.ContainerEventType, Action: events.ActionDie, Actor: events.Actor{ID: "b"}, TimeNano: 1700000000000000005}
+	later := events.Message{Type: events.ContainerEventType, Action: events.ActionStop, Actor: events.Actor{ID: "a"}, TimeNano: 1700000000500000000}
+
+	require.True(t, cursor.advance(start))
+	require.True(t, cursor.advance(die))
+	require.Equal(t, "1700000000.000000005", cursor.since())
+
+	// replayed after a reconnect, since is inclusive
+	require.False(t, cursor.advance(start))
+	require.False(t, cursor.advance(die))
+	// later in the same second is not lost
+	require.True(t, cursor.advance(later))
+	require.Equal(t, "1700000000.500000000", cursor.since())
+	require.False(t, cursor.advance(start))
+}
+
+func TestImageArchiveName(t *testing.T) {
+	require.Equal(t, "images.tar", imageArchiveName(nil))
+	require.Equal(t, "nginx_1.27.tar", imageArchiveName([]string{"nginx:1.27"}))
+	require.Equal(t, "ghcr.io_org_app_latest-and-2-more.tar", imageArchiveName([]string{"ghcr.io/org/app:latest", "a", "b"}))
+}
@@ -0,0 +1,216 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/command/image/build"
+	"github.com/docker/cli/cli/config"
+	"github.com/docker/cli/cli/config/configfile"
+	buildtypes "github.com/docker/docker/api/types/build"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/moby/go-archive"
+)
+
+// ProgressFunc receives every progress message the daemon sends while building or pushing
+type ProgressFunc func(msg jsonmessage.JSONMessage) error
+
+type ImageBuildOptions struct {
+	// build context, relative to the compose root
+	Dir string
+	// relative to Dir, defaults to Dockerfile
+	Dockerfile string
+	Tags       []string
+	BuildArgs  map[string]string
+	// build stage to stop at
+	Target string
+	// always attempt to pull a newer version of the base images
+	Pull    bool
+	NoCache bool
+}
+
+// RegistryAuth explicit credentials for a push,
+// if empty the credentials configured with docker login on the dockman host are used
+type RegistryAuth struct {
+	Username string
+	Password string
+}
+
+// ImageBuild builds an image from a directory under the compose root.
+// The context is packed locally honoring .dockerignore and sent through the docker client,
+// so remote hosts build it without the files being synced first
+func (s *ComposeService) ImageBuild(ctx context.Context, opts ImageBuildOptions, progress ProgressFunc) error {
+	contextDir, err := s.buildContextDir(opts.Dir)
+	if err != nil {
+		return err
+	}
+
+	dockerfile := filepath.ToSlash(filepath.Clean(opts.Dockerfile))
+	if opts.Dockerfile == "" {
+		dockerfile = build.DefaultDockerfileName
+	}
+	if filepath.IsAbs(dockerfile) || dockerfile == ".." || strings.HasPrefix(dockerfile, "../") {
+		return fmt.Errorf("dockerfile %s must be inside the build context", opts.Dockerfile)
+	}
+
+	excludes, err := build.ReadDockerignore(contextDir)
+	if err != nil {
+		return fmt.Errorf("failed to read .dockerignore: %w", err)
+	}
+	excludes = build.TrimBuildFilesFromExcludes(excludes, dockerfile, false)
+	if err = build.ValidateContextDirectory(contextDir, excludes); err != nil {
+		return fmt.Errorf("invalid build context: %w", err)
+	}
+
+	buildCtx, err := archive.TarWithOptions(contextDir, &archive.TarOptions{
+		ExcludePatterns: excludes,
+		// file owners on the dockman host mean nothing inside the image
+		ChownOpts: &archive.ChownOpts{UID: 0, GID: 0},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to pack build context: %w", err)
+	}
+	defer fileutil.Close(buildCtx)
+
+	buildArgs := make(map[string]*string, len(opts.BuildArgs))
+	for key, value := range opts.BuildArgs {
+		buildArgs[key] = &value
+	}
+
+	resp, err := s.daemon.ImageBuild(ctx, buildCtx, buildtypes.ImageBuildOptions{
+		Dockerfile:  dockerfile,
+		Tags:        opts.Tags,
+		BuildArgs:   buildArgs,
+		Target:      opts.Target,
+		PullParent:  opts.Pull,
+		NoCache:     opts.NoCache,
+		Remove:      true,
+		AuthConfigs: registryCredentials(loadDockerConfig()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build %s: %w", opts.Dir, err)
+	}
+	defer fileutil.Close(resp.Body)
+
+	return readProgress(resp.Body, progress)
+}
+
+// buildContextDir resolves dir relative to the compose root, it may not leave the compose root
+func (s *ComposeService) buildContextDir(dir string) (string, error) {
+	root, err := filepath.Abs(s.composeRoot)
+	if err != nil {
+		return "", err
+	}
+	contextDir := filepath.Join(root, filepath.FromSlash(dir))
+	if !isSubPath(contextDir, root) {
+		return "", fmt.Errorf("build context %s is outside the compose root", dir)
+	}
+
+	contextDir, err = build.ResolveAndValidateContextPath(contextDir)
+	if err != nil {
+		return "", err
+	}
+	// symlinks could still point out of the compose root
+	resolvedRoot, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", err
+	}
+	if !isSubPath(contextDir, resolvedRoot) {
+		return "", fmt.Errorf("build context %s is outside the compose root", dir)
+	}
+	return contextDir, nil
+}
+
+// ImagePush pushes a tagged image to its registry
+func (s *ContainerService) ImagePush(ctx context.Context, ref string, auth RegistryAuth, progress ProgressFunc) error {
+	encodedAuth, err := registryAuth(ref, auth)
+	if err != nil {
+		return err
+	}
+
+	reader, err := s.daemon.ImagePush(ctx, ref, image.PushOptions{RegistryAuth: encodedAuth})
+	if err != nil {
+		return fmt.Errorf("failed to push %s: %w", ref, err)
+	}
+	defer fileutil.Close(reader)
+
+	return readProgress(reader, progress)
+}
+
+// registryAuth encodes the credentials for the registry of ref for the X-Registry-Auth header
+func registryAuth(ref string, auth RegistryAuth) (string, error) {
+	if auth.Username != "" || auth.Password != "" {
+		return registry.EncodeAuthConfig(registry.AuthConfig{
+			Username: auth.Username,
+			Password: auth.Password,
+		})
+	}
+
+	encoded, err := command.RetrieveAuthTokenFromImage(loadDockerConfig(), ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to load registry credentials for %s: %w", ref, err)
+	}
+	return encoded, nil
+}
+
+// loadDockerConfig loads the docker cli config of the dockman host,
+// the same credentials compose uses to pull images
+func loadDockerConfig() *configfile.ConfigFile {
+	return config.LoadDefaultConfigFile(io.Discard)
+}
+
+// registryCredentials returns every configured registry login keyed by registry,
+// builds need them to pull private base images
+func registryCredentials(cfg *configfile.ConfigFile) map[string]registry.AuthConfig {
+	creds, err := cfg.GetAllCredentials()
+	if err != nil {
+		return nil
+	}
+
+	result := make(map[string]registry.AuthConfig, len(creds))
+	for key, cred := range creds {
+		result[key] = registry.AuthConfig{
+			Username:      cred.Username,
+			Password:      cred.Password,
+			ServerAddress: cred.ServerAddress,
+			Auth:          cred.Auth,
+			IdentityToken: cred.IdentityToken,
+			RegistryToken: cred.RegistryToken,
+		}
+	}
+	return result
+}
+
+// readProgress decodes the json message stream of the daemon until it ends,
+// an error message from the daemon fails the operation after it was passed on
+func readProgress(r io.Reader, progress ProgressFunc) error {
+	decoder := json.NewDecoder(r)
+	for {
+		var msg jsonmessage.JSONMessage
+		err := decoder.Decode(&msg)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read daemon progress: %w", err)
+		}
+
+		if progress != nil {
+			if err = progress(msg); err != nil {
+				return err
+			}
+		}
+		if msg.Error != nil {
+			return msg.Error
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildContextDir(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "app", "src"), 0755))
+	outside := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+
+	srv := NewComposeService(&dependencies{composeRoot: root}, nil)
+
+	dir, err := srv.buildContextDir("app/src")
+	require.NoError(t, err)
+	require.Equal(t, "src", filepath.Base(dir))
+
+	_, err = srv.buildContextDir("../")
+	require.Error(t, err)
+	_, err = srv.buildContextDir("app/../../")
+	require.Error(t, err)
+	_, err = srv.buildContextDir("escape")
+	require.Error(t, err)
+	_, err = srv.buildContextDir("missing")
+	require.Error(t, err)
+}
+
+func TestReadProgress(t *testing.T) {
+	stream := `{"stream":"Step 1/2 : FROM alpine\n"}
+{"status":"Pushing","id":"abc","progressDetail":{"current":5,"total":10}}
+{"errorDetail":{"message":"denied"},"error":"denied"}
+{"stream":"never read"}`
+
+	var got []jsonmessage.JSONMessage
+	err := readProgress(strings.NewReader(stream), func(msg jsonmessage.JSONMessage) error {
+		got = append(got, msg)
+		return nil
+	})
+	require.EqualError(t, err, "denied")
+	require.Len(t, got, 3)
+	require.Equal(t, "abc", got[1].ID)
+	require.Equal(t, int64(10), got[1].Progress.Total)
+
+	require.NoError(t, readProgress(strings.NewReader(`{"status":"done"}`), nil))
+}
@@ -0,0 +1,72 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/docker/docker/client"
+)
+
+// ImageSave exports images as a single tar archive in the docker save format,
+// the caller must close the reader
+func (s *ContainerService) ImageSave(ctx context.Context, refs ...string) (io.ReadCloser, error) {
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("at least one image is required")
+	}
+
+	reader, err := s.daemon.ImageSave(ctx, refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save %v: %w", refs, err)
+	}
+	return reader, nil
+}
+
+// ImageLoad imports a tar archive created by docker save
+func (s *ContainerService) ImageLoad(ctx context.Context, archive io.Reader, progress ProgressFunc) error {
+	resp, err := s.daemon.ImageLoad(ctx, archive, client.ImageLoadWithQuiet(false))
+	if err != nil {
+		return fmt.Errorf("failed to load images: %w", err)
+	}
+	defer fileutil.Close(resp.Body)
+
+	return readProgress(resp.Body, progress)
+}
+
+// ImageTransfer copies images from one host to another,
+// the archive is piped from src straight into dst and never written to disk
+func ImageTransfer(ctx context.Context, src, dst *ContainerService, refs []string, progress ProgressFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	reader, err := src.ImageSave(ctx, refs...)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	saveErr := make(chan error, 1)
+	go func() {
+		defer fileutil.Close(reader)
+		_, copyErr := io.Copy(pw, reader)
+		// unblocks the load if the save failed midway
+		pw.CloseWithError(copyErr)
+		saveErr <- copyErr
+	}()
+
+	if err = dst.ImageLoad(ctx, pr, progress); err != nil {
+		// stops the save if the load failed first
+		pr.CloseWithError(err)
+		cancel()
+		<-saveErr
+		return err
+	}
+
+	// the daemon may stop reading before the end of the archive padding
+	_, _ = io.Copy(io.Discard, pr)
+	if err = <-saveErr; err != nil {
+		return fmt.Errorf("failed to read images from source: %w", err)
+	}
+	return nil
+}
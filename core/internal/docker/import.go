@@ -0,0 +1,266 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
+)
+
+var invalidServiceChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// ImportContainers generates a compose file from existing containers and
+// writes it to filename relative to the compose root, the generated yaml is returned.
+//
+// The containers are not touched, to adopt them remove the originals
+// and deploy the new stack.
+func (s *ComposeService) ImportContainers(ctx context.Context, filename string, containerIDs ...string) (string, error) {
+	if len(containerIDs) == 0 {
+		return "", fmt.Errorf("no containers selected")
+	}
+
+	fullPath := filepath.Join(s.composeRoot, filename)
+	if !strings.HasPrefix(fullPath, filepath.Clean(s.composeRoot)+string(filepath.Separator)) {
+		return "", fmt.Errorf("file %q is outside the compose root", filename)
+	}
+	if _, err := os.Stat(fullPath); err == nil {
+		return "", fmt.Errorf("file %q already exists", filename)
+	}
+
+	project := &types.Project{
+		Services: types.Services{},
+		Networks: types.Networks{},
+		Volumes:  types.Volumes{},
+	}
+
+	var names []string
+	for _, id := range containerIDs {
+		inspect, err := s.daemon.ContainerInspect(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("unable to inspect container %s: %w", id, err)
+		}
+		if inspect.Config == nil || inspect.HostConfig == nil {
+			return "", fmt.Errorf("container %s has no config", id)
+		}
+
+		if project := inspect.Config.Labels[api.ProjectLabel]; project != "" {
+			return "", fmt.Errorf("container %s is already managed by compose project %q", inspect.Name, project)
+		}
+
+		// settings baked into the image do not need to be repeated in the compose file
+		var imageConfig *container.Config
+		if img, err := s.daemon.ImageInspect(ctx, inspect.Image); err == nil {
+			imageConfig = imageDefaults(img)
+		}
+
+		svc := serviceFromContainer(inspect, imageConfig)
+		if _, exists := project.Services[svc.Name]; exists {
+			svc.Name = svc.Name + "-" + inspect.ID[:6]
+		}
+		project.Services[svc.Name] = svc
+		names = append(names, svc.ContainerName)
+
+		addResources(project, inspect)
+	}
+
+	rendered, err := project.MarshalYAML()
+	if err != nil {
+		return "", fmt.Errorf("unable to render compose file: %w", err)
+	}
+
+	contents := fmt.Sprintf(
+		"# generated by dockman from containers: %s\n"+
+			"# remove the original containers before deploying this stack\n%s",
+		strings.Join(names, ", "), rendered,
+	)
+
+	if err = os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return "", err
+	}
+	if err = os.WriteFile(fullPath, []byte(contents), 0644); err != nil {
+		return "", fmt.Errorf("unable to write compose file: %w", err)
+	}
+
+	return contents, nil
+}
+
+func imageDefaults(img image.InspectResponse) *container.Config {
+	if img.Config == nil {
+		return nil
+	}
+	return &container.Config{
+		Env:        img.Config.Env,
+		Cmd:        img.Config.Cmd,
+		Entrypoint: img.Config.Entrypoint,
+		Labels:     img.Config.Labels,
+		User:       img.Config.User,
+		WorkingDir: img.Config.WorkingDir,
+		Volumes:    img.Config.Volumes,
+	}
+}
+
+// serviceFromContainer is the reverse of containerCreate, it maps the inspected
+// container to a compose service leaving out values that match the image defaults
+func serviceFromContainer(inspect container.InspectResponse, imageConfig *container.Config) types.ServiceConfig {
+	if imageConfig == nil {
+		imageConfig = &container.Config{}
+	}
+	cfg := inspect.Config
+	hostCfg := inspect.HostConfig
+
+	name := strings.TrimPrefix(inspect.Name, "/")
+	svc := types.ServiceConfig{
+		Name:          serviceName(name),
+		ContainerName: name,
+		Image:         cfg.Image,
+		Environment:   types.MappingWithEquals{},
+		Labels:        types.Labels{},
+	}
+
+	if !slices.Equal(cfg.Cmd, imageConfig.Cmd) {
+		svc.Command = types.ShellCommand(cfg.Cmd)
+	}
+	if !slices.Equal(cfg.Entrypoint, imageConfig.Entrypoint) {
+		svc.Entrypoint = types.ShellCommand(cfg.Entrypoint)
+	}
+	if cfg.User != imageConfig.User {
+		svc.User = cfg.User
+	}
+	if cfg.WorkingDir != imageConfig.WorkingDir {
+		svc.WorkingDir = cfg.WorkingDir
+	}
+
+	for _, env := range cfg.Env {
+		if slices.Contains(imageConfig.Env, env) {
+			continue
+		}
+		key, val, _ := strings.Cut(env, "=")
+		svc.Environment[key] = &val
+	}
+
+	for key, val := range cfg.Labels {
+		if imageVal, ok := imageConfig.Labels[key]; ok && imageVal == val {
+			continue
+		}
+		svc.Labels[key] = val
+	}
+
+	switch policy := hostCfg.RestartPolicy; policy.Name {
+	case container.RestartPolicyDisabled:
+	case container.RestartPolicyOnFailure:
+		svc.Restart = string(policy.Name)
+		if policy.MaximumRetryCount > 0 {
+			svc.Restart += ":" + strconv.Itoa(policy.MaximumRetryCount)
+		}
+	default:
+		svc.Restart = string(policy.Name)
+	}
+
+	for _, port := range slices.Sorted(maps.Keys(hostCfg.PortBindings)) {
+		for _, binding := range hostCfg.PortBindings[port] {
+			svc.Ports = append(svc.Ports, types.ServicePortConfig{
+				HostIP:    binding.HostIP,
+				Published: binding.HostPort,
+				Target:    uint32(port.Int()),
+				Protocol:  port.Proto(),
+				Mode:      "ingress",
+			})
+		}
+	}
+
+	for _, m := range inspect.Mounts {
+		vol := types.ServiceVolumeConfig{
+			Target:   m.Destination,
+			ReadOnly: !m.RW,
+		}
+		switch m.Type {
+		case mount.TypeBind:
+			vol.Type = types.VolumeTypeBind
+			vol.Source = m.Source
+			vol.Bind = &types.ServiceVolumeBind{CreateHostPath: true}
+		case mount.TypeVolume:
+			vol.Type = types.VolumeTypeVolume
+			if _, declared := imageConfig.Volumes[m.Destination]; declared && isAnonymousVolume(m.Name) {
+				// created by the image VOLUME instruction, docker will create it again
+				continue
+			}
+			if !isAnonymousVolume(m.Name) {
+				vol.Source = m.Name
+			}
+		case mount.TypeTmpfs:
+			vol.Type = types.VolumeTypeTmpfs
+		default:
+			continue
+		}
+		svc.Volumes = append(svc.Volumes, vol)
+	}
+
+	mode := hostCfg.NetworkMode
+	switch {
+	case mode.IsHost(), mode.IsNone(), mode.IsContainer():
+		svc.NetworkMode = string(mode)
+	case mode.IsUserDefined():
+		svc.Networks = map[string]*types.ServiceNetworkConfig{}
+		if inspect.NetworkSettings != nil {
+			for netName, endpoint := range inspect.NetworkSettings.Networks {
+				netConf := &types.ServiceNetworkConfig{}
+				if endpoint != nil {
+					// the container id is added as an alias by docker
+					netConf.Aliases = slices.DeleteFunc(slices.Clone(endpoint.Aliases), func(alias string) bool {
+						return strings.HasPrefix(inspect.ID, alias)
+					})
+				}
+				svc.Networks[netName] = netConf
+			}
+		}
+	default:
+		// containers on the default bridge keep using it instead of a project network
+		svc.NetworkMode = "bridge"
+	}
+
+	return svc
+}
+
+// addResources declares the named volumes and networks used by the container
+// as external, so the stack reuses them instead of creating new ones
+func addResources(project *types.Project, inspect container.InspectResponse) {
+	for _, m := range inspect.Mounts {
+		if m.Type == mount.TypeVolume && !isAnonymousVolume(m.Name) {
+			project.Volumes[m.Name] = types.VolumeConfig{Name: m.Name, External: true}
+		}
+	}
+
+	if inspect.NetworkSettings == nil || !inspect.HostConfig.NetworkMode.IsUserDefined() {
+		return
+	}
+	for netName := range inspect.NetworkSettings.Networks {
+		project.Networks[netName] = types.NetworkConfig{Name: netName, External: true}
+	}
+}
+
+// anonymous volumes are named with a 64 char hex id
+var anonymousVolumePattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func isAnonymousVolume(name string) bool {
+	return anonymousVolumePattern.MatchString(name)
+}
+
+func serviceName(containerName string) string {
+	name := invalidServiceChars.ReplaceAllString(strings.ToLower(containerName), "-")
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return "app"
+	}
+	return name
+}
@@ -0,0 +1,68 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceFromContainer(t *testing.T) {
+	inspect := container.InspectResponse{
+		ContainerJSONBase: &container.ContainerJSONBase{
+			ID:   "0123456789abcdef",
+			Name: "/My_Web",
+			HostConfig: &container.HostConfig{
+				NetworkMode:   "proxy",
+				RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyOnFailure, MaximumRetryCount: 3},
+				PortBindings: nat.PortMap{
+					"80/tcp": {{HostIP: "127.0.0.1", HostPort: "8080"}},
+				},
+			},
+		},
+		Config: &container.Config{
+			Image:  "nginx:1.27",
+			Env:    []string{"PATH=/usr/bin", "MODE=prod"},
+			Cmd:    []string{"nginx", "-g", "daemon off;"},
+			Labels: map[string]string{"maintainer": "nginx", "team": "web"},
+		},
+		Mounts: []container.MountPoint{
+			{Type: mount.TypeBind, Source: "/srv/site", Destination: "/usr/share/nginx/html", RW: false},
+			{Type: mount.TypeVolume, Name: "web-cache", Destination: "/var/cache/nginx", RW: true},
+		},
+		NetworkSettings: &container.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"proxy": {Aliases: []string{"web", "0123456789ab"}},
+			},
+		},
+	}
+	imageConfig := &container.Config{
+		Env:    []string{"PATH=/usr/bin"},
+		Cmd:    []string{"nginx", "-g", "daemon off;"},
+		Labels: map[string]string{"maintainer": "nginx"},
+	}
+
+	svc := serviceFromContainer(inspect, imageConfig)
+
+	require.Equal(t, "my_web", svc.Name)
+	require.Equal(t, "My_Web", svc.ContainerName)
+	require.Nil(t, svc.Command)
+	require.Equal(t, "on-failure:3", svc.Restart)
+	require.Len(t, svc.Environment, 1)
+	require.Equal(t, "prod", *svc.Environment["MODE"])
+	require.Equal(t, map[string]string{"team": "web"}, map[string]string(svc.Labels))
+
+	require.Len(t, svc.Ports, 1)
+	require.Equal(t, "8080", svc.Ports[0].Published)
+	require.Equal(t, uint32(80), svc.Ports[0].Target)
+	require.Equal(t, "127.0.0.1", svc.Ports[0].HostIP)
+
+	require.Len(t, svc.Volumes, 2)
+	require.True(t, svc.Volumes[0].ReadOnly)
+	require.Equal(t, "web-cache", svc.Volumes[1].Source)
+
+	require.Equal(t, []string{"web"}, svc.Networks["proxy"].Aliases)
+}
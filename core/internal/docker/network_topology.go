@@ -0,0 +1,152 @@
+package docker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+)
+
+// networks created by the daemon itself, they can never be removed
+var builtinNetworks = []string{"bridge", "host", "none"}
+
+// NetworkEndpoint a container attached to a network,
+// addresses are empty for stopped containers unless they are static
+type NetworkEndpoint struct {
+	ContainerID    string
+	ContainerName  string
+	State          string
+	IPv4Address    string
+	IPv6Address    string
+	MacAddress     string
+	Aliases        []string
+	ComposeProject string
+	ComposeService string
+}
+
+type NetworkTopology struct {
+	Network   network.Inspect
+	Endpoints []NetworkEndpoint
+	// no container, running or stopped, is attached
+	Unused  bool
+	Builtin bool
+}
+
+// NetworksTopology lists every network with the containers attached to it
+func (s *ContainerService) NetworksTopology(ctx context.Context) ([]NetworkTopology, error) {
+	networks, err := s.NetworksList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// network inspect only knows about running containers, the container list also has stopped ones
+	containers, err := s.daemon.ContainerList(ctx, container.ListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	return buildTopology(networks, containers), nil
+}
+
+func buildTopology(networks []network.Inspect, containers []container.Summary) []NetworkTopology {
+	byID := make(map[string]int, len(networks))
+	byName := make(map[string]int, len(networks))
+	result := make([]NetworkTopology, len(networks))
+	for i, netI := range networks {
+		byID[netI.ID] = i
+		byName[netI.Name] = i
+		result[i] = NetworkTopology{
+			Network: netI,
+			Builtin: slices.Contains(builtinNetworks, netI.Name),
+		}
+	}
+
+	for _, c := range containers {
+		if c.NetworkSettings == nil {
+			continue
+		}
+		for netName, endpoint := range c.NetworkSettings.Networks {
+			if endpoint == nil {
+				continue
+			}
+			idx, ok := byID[endpoint.NetworkID]
+			if !ok {
+				if idx, ok = byName[netName]; !ok {
+					continue
+				}
+			}
+			result[idx].Endpoints = append(result[idx].Endpoints, toEndpoint(c, endpoint))
+		}
+	}
+
+	for i := range result {
+		sort.Slice(result[i].Endpoints, func(a, b int) bool {
+			return result[i].Endpoints[a].ContainerName < result[i].Endpoints[b].ContainerName
+		})
+		result[i].Unused = len(result[i].Endpoints) == 0
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Network.Name < result[j].Network.Name
+	})
+	return result
+}
+
+func toEndpoint(c container.Summary, endpoint *network.EndpointSettings) NetworkEndpoint {
+	ep := NetworkEndpoint{
+		ContainerID:    c.ID,
+		ContainerName:  containerName(c),
+		State:          c.State,
+		IPv4Address:    endpoint.IPAddress,
+		IPv6Address:    endpoint.GlobalIPv6Address,
+		MacAddress:     endpoint.MacAddress,
+		ComposeProject: c.Labels[api.ProjectLabel],
+		ComposeService: c.Labels[api.ServiceLabel],
+	}
+	// static addresses are known even when the container is stopped
+	if endpoint.IPAMConfig != nil {
+		ep.IPv4Address = cmp.Or(ep.IPv4Address, endpoint.IPAMConfig.IPv4Address)
+		ep.IPv6Address = cmp.Or(ep.IPv6Address, endpoint.IPAMConfig.IPv6Address)
+	}
+
+	// dns names include the aliases, the container name and its short id
+	shortID := c.ID
+	if len(shortID) > 12 {
+		shortID = shortID[:12]
+	}
+	for _, alias := range slices.Concat(endpoint.Aliases, endpoint.DNSNames) {
+		if alias == "" || alias == shortID || alias == c.ID || alias == ep.ContainerName || slices.Contains(ep.Aliases, alias) {
+			continue
+		}
+		ep.Aliases = append(ep.Aliases, alias)
+	}
+	return ep
+}
+
+// NetworksConnect attaches a container to a network at runtime,
+// aliases and static addresses are optional
+func (s *ContainerService) NetworksConnect(ctx context.Context, networkID, containerID string, aliases []string, ipv4, ipv6 string) error {
+	settings := &network.EndpointSettings{Aliases: aliases}
+	if ipv4 != "" || ipv6 != "" {
+		settings.IPAMConfig = &network.EndpointIPAMConfig{
+			IPv4Address: ipv4,
+			IPv6Address: ipv6,
+		}
+	}
+
+	if err := s.daemon.NetworkConnect(ctx, networkID, containerID, settings); err != nil {
+		return fmt.Errorf("failed to connect %s to %s: %w", containerID, networkID, err)
+	}
+	return nil
+}
+
+func (s *ContainerService) NetworksDisconnect(ctx context.Context, networkID, containerID string, force bool) error {
+	if err := s.daemon.NetworkDisconnect(ctx, networkID, containerID, force); err != nil {
+		return fmt.Errorf("failed to disconnect %s from %s: %w", containerID, networkID, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTopology(t *testing.T) {
+	networks := []network.Inspect{
+		{ID: "n1", Name: "web"},
+		{ID: "n2", Name: "bridge"},
+		{ID: "n3", Name: "orphan"},
+	}
+	containers := []container.Summary{
+		{
+			ID:     "0123456789abcdef",
+			Names:  []string{"/app-web-1"},
+			State:  "running",
+			Labels: map[string]string{api.ProjectLabel: "app", api.ServiceLabel: "web"},
+			NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{
+				"web": {
+					NetworkID: "n1",
+					IPAddress: "172.20.0.2",
+					Aliases:   []string{"web", "app-web-1"},
+					DNSNames:  []string{"app-web-1", "web", "0123456789ab"},
+				},
+			}},
+		},
+		{
+			ID:    "fedcba9876543210",
+			Names: []string{"/db"},
+			State: "exited",
+			NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{
+				// stopped containers have no network id, only static addresses
+				"web":    {IPAMConfig: &network.EndpointIPAMConfig{IPv4Address: "172.20.0.10"}},
+				"bridge": {NetworkID: "n2"},
+			}},
+		},
+	}
+
+	topology := buildTopology(networks, containers)
+	require.Len(t, topology, 3)
+
+	require.Equal(t, "bridge", topology[0].Network.Name)
+	require.True(t, topology[0].Builtin)
+	require.Len(t, topology[0].Endpoints, 1)
+
+	require.Equal(t, "orphan", topology[1].Network.Name)
+	require.True(t, topology[1].Unused)
+	require.False(t, topology[1].Builtin)
+
+	web := topology[2]
+	require.False(t, web.Unused)
+	require.Len(t, web.Endpoints, 2)
+	require.Equal(t, NetworkEndpoint{
+		ContainerID:    "0123456789abcdef",
+		ContainerName:  "app-web-1",
+		State:          "running",
+		IPv4Address:    "172.20.0.2",
+		Aliases:        []string{"web"},
+		ComposeProject: "app",
+		ComposeService: "web",
+	}, web.Endpoints[0])
+	require.Equal(t, "db", web.Endpoints[1].ContainerName)
+	require.Equal(t, "172.20.0.10", web.Endpoints[1].IPv4Address)
+}
@@ -0,0 +1,227 @@
+package docker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/compose/v2/pkg/compose"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+)
+
+type PlanAction string
+
+const (
+	PlanCreate   PlanAction = "create"
+	PlanRecreate PlanAction = "recreate"
+	PlanRemove   PlanAction = "remove"
+	// stopped container is started as is
+	PlanStart     PlanAction = "start"
+	PlanUnchanged PlanAction = "unchanged"
+)
+
+type PlannedContainer struct {
+	Service       string
+	ContainerName string
+	Action        PlanAction
+	// why the action is required, empty for unchanged
+	Reason string
+}
+
+// ComposePlan is a preview of what ComposeUp would change
+type ComposePlan struct {
+	Containers     []PlannedContainer
+	PullImages     []string
+	CreateNetworks []string
+	CreateVolumes  []string
+}
+
+// HasChanges reports whether applying the plan would modify anything
+func (p ComposePlan) HasChanges() bool {
+	if len(p.PullImages) > 0 || len(p.CreateNetworks) > 0 || len(p.CreateVolumes) > 0 {
+		return true
+	}
+	for _, c := range p.Containers {
+		if c.Action != PlanUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// ComposePlan diffs the compose file against the current state of the host
+// without changing anything, similar to what ComposeUp would do
+func (s *ComposeService) ComposePlan(ctx context.Context, shortName string, services []string, opts ...ProjectOption) (*ComposePlan, error) {
+	project, err := s.LoadProject(ctx, shortName, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := s.ComposeList(ctx, project, true)
+	if err != nil {
+		return nil, err
+	}
+
+	byService := make(map[string][]container.Summary)
+	for _, c := range containers {
+		svcName := c.Labels[api.ServiceLabel]
+		byService[svcName] = append(byService[svcName], c)
+	}
+
+	selected := project.ServiceNames()
+	if len(services) > 0 {
+		selected = services
+	}
+	slices.Sort(selected)
+
+	plan := &ComposePlan{}
+	images := make(map[string]string) // image ref -> local image id
+	for _, svcName := range selected {
+		svc, ok := project.Services[svcName]
+		if !ok {
+			return nil, fmt.Errorf("service %q not found in %s", svcName, shortName)
+		}
+
+		imageID := ""
+		if svc.Image != "" {
+			id, seen := images[svc.Image]
+			if !seen {
+				id = s.localImageID(ctx, svc.Image)
+				images[svc.Image] = id
+				if id == "" && svc.Build == nil {
+					plan.PullImages = append(plan.PullImages, svc.Image)
+				}
+			}
+			imageID = id
+		}
+
+		expectedHash, err := compose.ServiceHash(svc)
+		if err != nil {
+			return nil, fmt.Errorf("unable to hash service %s: %w", svcName, err)
+		}
+
+		plan.Containers = append(plan.Containers, planService(svc, byService[svcName], expectedHash, imageID)...)
+	}
+
+	// ComposeUp removes orphans, so containers of deleted services go away
+	for _, svcName := range slices.Sorted(maps.Keys(byService)) {
+		if _, ok := project.Services[svcName]; ok {
+			continue
+		}
+		for _, c := range byService[svcName] {
+			plan.Containers = append(plan.Containers, PlannedContainer{
+				Service:       svcName,
+				ContainerName: containerName(c),
+				Action:        PlanRemove,
+				Reason:        "service no longer exists in compose file",
+			})
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(project.Networks)) {
+		netConf := project.Networks[key]
+		if bool(netConf.External) {
+			continue
+		}
+		if _, err := s.daemon.NetworkInspect(ctx, netConf.Name, network.InspectOptions{}); err != nil {
+			plan.CreateNetworks = append(plan.CreateNetworks, netConf.Name)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(project.Volumes)) {
+		volConf := project.Volumes[key]
+		if bool(volConf.External) {
+			continue
+		}
+		if _, err := s.daemon.VolumeInspect(ctx, volConf.Name); err != nil {
+			plan.CreateVolumes = append(plan.CreateVolumes, volConf.Name)
+		}
+	}
+
+	return plan, nil
+}
+
+// planService plans the containers of a service, replicas above the scale are removed
+// and missing ones created
+func planService(svc types.ServiceConfig, existing []container.Summary, expectedHash, imageID string) []PlannedContainer {
+	// compose keeps the lowest numbered containers when scaling down
+	existing = slices.Clone(existing)
+	slices.SortStableFunc(existing, func(a, b container.Summary) int {
+		return cmp.Compare(containerNumber(a), containerNumber(b))
+	})
+
+	var planned []PlannedContainer
+	for i, c := range existing {
+		if i >= svc.GetScale() {
+			planned = append(planned, PlannedContainer{
+				Service:       svc.Name,
+				ContainerName: containerName(c),
+				Action:        PlanRemove,
+				Reason:        "service is scaled down",
+			})
+			continue
+		}
+		planned = append(planned, planContainer(svc, c, expectedHash, imageID))
+	}
+
+	for i := len(existing); i < svc.GetScale(); i++ {
+		planned = append(planned, PlannedContainer{
+			Service: svc.Name,
+			Action:  PlanCreate,
+			Reason:  "no container exists for service",
+		})
+	}
+	return planned
+}
+
+func planContainer(svc types.ServiceConfig, c container.Summary, expectedHash, imageID string) PlannedContainer {
+	planned := PlannedContainer{
+		Service:       svc.Name,
+		ContainerName: containerName(c),
+		Action:        PlanUnchanged,
+	}
+
+	switch {
+	case c.Labels[api.ConfigHashLabel] != expectedHash:
+		planned.Action = PlanRecreate
+		planned.Reason = "service configuration changed"
+	// build only services have no image to compare against
+	case svc.Image == "":
+		if c.State != container.StateRunning {
+			planned.Action = PlanStart
+			planned.Reason = "container will be started"
+		}
+	case imageID == "":
+		planned.Action = PlanRecreate
+		planned.Reason = "image is missing and will be pulled or built"
+	case c.Labels[api.ImageDigestLabel] != "" && c.Labels[api.ImageDigestLabel] != imageID:
+		planned.Action = PlanRecreate
+		planned.Reason = "a newer image is available locally"
+	case c.State != container.StateRunning:
+		// compose starts the existing container, nothing is recreated
+		planned.Action = PlanStart
+		planned.Reason = "container will be started"
+	}
+
+	return planned
+}
+
+// containerNumber returns the replica number compose assigned to the container, 0 if unknown
+func containerNumber(c container.Summary) int {
+	num, _ := strconv.Atoi(c.Labels[api.ContainerNumberLabel])
+	return num
+}
+
+func (s *ComposeService) localImageID(ctx context.Context, ref string) string {
+	inspect, err := s.daemon.ImageInspect(ctx, ref)
+	if err != nil {
+		return ""
+	}
+	return inspect.ID
+}
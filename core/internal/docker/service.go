@@ -1,6 +1,8 @@
 package docker
 
 import (
+	"fmt"
+
 	"github.com/docker/docker/client"
 )
 
@@ -61,6 +63,41 @@ func NewService(
 	}
 }
 
+// Hostname name of the docker host the service is connected to
+func (s *Service) Hostname() string {
+	return s.Container.hostname
+}
+
+// HostResolver finds connected hosts by name, an empty name is the active host
+type HostResolver struct {
+	active ServiceProvider
+	hosts  HostsProvider
+}
+
+func NewHostResolver(active ServiceProvider, hosts HostsProvider) HostResolver {
+	return HostResolver{active: active, hosts: hosts}
+}
+
+// Name resolves an empty host to the name of the active host
+func (r HostResolver) Name(name string) string {
+	if name == "" {
+		return r.active().Hostname()
+	}
+	return name
+}
+
+// Get returns the service of a connected host
+func (r HostResolver) Get(name string) (*Service, error) {
+	if name == "" {
+		return r.active(), nil
+	}
+	srv, ok := r.hosts()[name]
+	if !ok {
+		return nil, fmt.Errorf("host %s is not connected", name)
+	}
+	return srv, nil
+}
+
 func (s *Service) Close() error {
 	//return s.ContainerService.daemon().Close()
 	// todo look into close
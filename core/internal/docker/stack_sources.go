@@ -0,0 +1,72 @@
+package docker
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// StackSources everything that holds state for a stack, paths are absolute
+type StackSources struct {
+	Project string
+	// compose folder, empty when the compose file lives directly in the compose root
+	Dir          string
+	ComposeFiles []string
+	// bind mount sources under the compose root that exist on the host,
+	// sources inside another listed source are left out
+	Binds []string
+	// docker names of the named volumes used by the services
+	Volumes []string
+}
+
+// StackSources resolves the folder, bind mounts and named volumes of a loaded project
+func (s *ComposeService) StackSources(project *types.Project) StackSources {
+	src := StackSources{
+		Project:      project.Name,
+		ComposeFiles: project.ComposeFiles,
+	}
+	if filepath.Clean(project.WorkingDir) != filepath.Clean(s.composeRoot) {
+		src.Dir = project.WorkingDir
+	}
+
+	binds := map[string]struct{}{}
+	volumes := map[string]struct{}{}
+	for _, name := range slices.Sorted(maps.Keys(project.Services)) {
+		for _, vol := range project.Services[name].Volumes {
+			switch vol.Type {
+			case types.VolumeTypeBind:
+				source, root := filepath.Clean(vol.Source), filepath.Clean(s.composeRoot)
+				if source == root || !isSubPath(source, root) {
+					continue
+				}
+				if exists, err := s.syncer.Exists(source); err != nil || !exists {
+					continue
+				}
+				binds[source] = struct{}{}
+			case types.VolumeTypeVolume:
+				if vol.Source == "" {
+					continue
+				}
+				volName := vol.Source
+				if conf, ok := project.Volumes[vol.Source]; ok && conf.Name != "" {
+					volName = conf.Name
+				}
+				volumes[volName] = struct{}{}
+			}
+		}
+	}
+
+	sortedBinds := slices.Sorted(maps.Keys(binds))
+	for _, bind := range sortedBinds {
+		// sorted so parents come first
+		if slices.ContainsFunc(src.Binds, func(parent string) bool { return isSubPath(bind, parent) }) {
+			continue
+		}
+		src.Binds = append(src.Binds, bind)
+	}
+	src.Volumes = slices.Sorted(maps.Keys(volumes))
+
+	return src
+}
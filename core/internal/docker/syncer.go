@@ -14,6 +14,8 @@ import (
 // Syncer is responsible for ensuring project files are available on the target host.
 type Syncer interface {
 	Sync(ctx context.Context, project *types.Project) error
+	// Exists reports whether a path will exist on the target host once the project is synced
+	Exists(path string) (bool, error)
 }
 
 // SFTPSyncer syncs files to a remote host using SFTP.
@@ -27,38 +29,58 @@ func NewSFTPSyncer(sftpClient *ssh.SftpClient, composeRoot string) *SFTPSyncer {
 }
 
 func (s *SFTPSyncer) Sync(_ context.Context, project *types.Project) error {
-	log.Debug().Msg("syncing bind mount to remote host")
+	log.Debug().Msg("syncing bind mounts and build contexts to remote host")
 	for _, service := range project.Services {
 		for _, vol := range service.Volumes {
 			if vol.Bind == nil {
 				continue
 			}
 
-			localSourcePath := vol.Source
-
-			if !strings.HasPrefix(localSourcePath, s.composeRoot) {
-				log.Debug().
-					Str("local", localSourcePath).
-					Msg("Skipping bind mount outside of project root")
-				continue
+			if err := s.syncPath(service.Name, "bind mount", vol.Source); err != nil {
+				return err
 			}
+		}
 
-			if !fileutil.FileExists(localSourcePath) {
-				log.Debug().Str("path", localSourcePath).Msg("bind mount source path not found, skipping...")
-				continue
+		// ship the build context so builds run against the same files on the remote host
+		if service.Build != nil && service.Build.Context != "" {
+			if err := s.syncPath(service.Name, "build context", service.Build.Context); err != nil {
+				return err
 			}
+		}
+	}
+	return nil
+}
 
-			remoteDestPath := localSourcePath
-			log.Info().
-				Str("name", service.Name).
-				Str("src (local)", localSourcePath).
-				Str("dest (remote)", remoteDestPath).
-				Msg("Syncing bind mount for service")
+func (s *SFTPSyncer) Exists(path string) (bool, error) {
+	// files in the compose root are copied over by Sync
+	if strings.HasPrefix(path, s.composeRoot) {
+		return fileutil.FileExists(path), nil
+	}
+	return s.sftpClient.Exists(path)
+}
 
-			if err := s.sftpClient.CopyLocalToRemoteSFTP(localSourcePath, remoteDestPath); err != nil {
-				return fmt.Errorf("failed to sync bind mount %s for service %s: %w", localSourcePath, service.Name, err)
-			}
-		}
+func (s *SFTPSyncer) syncPath(serviceName, kind, localSourcePath string) error {
+	if !strings.HasPrefix(localSourcePath, s.composeRoot) {
+		log.Debug().
+			Str("local", localSourcePath).
+			Msgf("Skipping %s outside of project root", kind)
+		return nil
+	}
+
+	if !fileutil.FileExists(localSourcePath) {
+		log.Debug().Str("path", localSourcePath).Msgf("%s source path not found, skipping...", kind)
+		return nil
+	}
+
+	remoteDestPath := localSourcePath
+	log.Info().
+		Str("name", serviceName).
+		Str("src (local)", localSourcePath).
+		Str("dest (remote)", remoteDestPath).
+		Msgf("Syncing %s for service", kind)
+
+	if err := s.sftpClient.CopyLocalToRemoteSFTP(localSourcePath, remoteDestPath); err != nil {
+		return fmt.Errorf("failed to sync %s %s for service %s: %w", kind, localSourcePath, serviceName, err)
 	}
 	return nil
 }
@@ -74,3 +96,7 @@ func (n *NoopSyncer) Sync(_ context.Context, _ *types.Project) error {
 	// For local docker, files are already on the host. No sync needed.
 	return nil
 }
+
+func (n *NoopSyncer) Exists(path string) (bool, error) {
+	return fileutil.FileExists(path), nil
+}
@@ -0,0 +1,408 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/cli/cli/command"
+	"github.com/docker/cli/cli/config/configfile"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+)
+
+type Severity string
+
+const (
+	// the stack will fail to deploy
+	SeverityError Severity = "error"
+	// the stack will deploy but may not behave as expected
+	SeverityWarning Severity = "warning"
+)
+
+type ValidationCheck string
+
+const (
+	CheckLoad          ValidationCheck = "load"
+	CheckPorts         ValidationCheck = "ports"
+	CheckBindMounts    ValidationCheck = "bind_mounts"
+	CheckNetworks      ValidationCheck = "networks"
+	CheckVolumes       ValidationCheck = "volumes"
+	CheckContainerName ValidationCheck = "container_name"
+	CheckEnvFiles      ValidationCheck = "env_files"
+	CheckImages        ValidationCheck = "images"
+)
+
+type ValidationFinding struct {
+	Severity Severity
+	Check    ValidationCheck
+	// empty for findings that concern the whole project
+	Service string
+	Message string
+}
+
+func (f ValidationFinding) Error() string {
+	if f.Service == "" {
+		return f.Message
+	}
+	return fmt.Sprintf("service %q: %s", f.Service, f.Message)
+}
+
+type validator struct {
+	project  *types.Project
+	findings []ValidationFinding
+}
+
+func (v *validator) add(severity Severity, check ValidationCheck, service, format string, args ...any) {
+	v.findings = append(v.findings, ValidationFinding{
+		Severity: severity,
+		Check:    check,
+		Service:  service,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// ComposeValidate runs pre-flight checks against the target host
+// and reports everything that would make a deployment fail or misbehave
+func (s *ComposeService) ComposeValidate(ctx context.Context, shortName string, opts ...ProjectOption) []ValidationFinding {
+	project, err := s.loadProjectConfig(ctx, shortName, opts...)
+	if err != nil {
+		return []ValidationFinding{{Severity: SeverityError, Check: CheckLoad, Message: err.Error()}}
+	}
+
+	v := &validator{project: project}
+	// env files are checked before resolving so every missing file is reported
+	v.checkEnvFiles()
+
+	resolved, err := project.WithServicesEnvironmentResolved(true)
+	if err != nil {
+		if len(v.findings) == 0 {
+			v.add(SeverityError, CheckEnvFiles, "", "failed to resolve services environment: %v", err)
+		}
+		return v.findings
+	}
+	v.project = resolved.WithoutUnnecessaryResources()
+
+	containers, err := s.containerService.ContainersList(ctx)
+	if err != nil {
+		v.add(SeverityError, CheckLoad, "", "unable to list containers: %v", err)
+		return v.findings
+	}
+
+	v.checkPorts(containers)
+	v.checkContainerNames(containers)
+	s.checkBindMounts(v)
+	s.checkExternalResources(ctx, v)
+	s.checkImages(ctx, v)
+
+	return v.findings
+}
+
+func (v *validator) services() []string {
+	return slices.Sorted(maps.Keys(v.project.Services))
+}
+
+func (v *validator) checkEnvFiles() {
+	for _, name := range v.services() {
+		for _, envFile := range v.project.Services[name].EnvFiles {
+			if fileutil.FileExists(envFile.Path) {
+				continue
+			}
+
+			if envFile.Required {
+				v.add(SeverityError, CheckEnvFiles, name, "env file %s does not exist", envFile.Path)
+			} else {
+				v.add(SeverityWarning, CheckEnvFiles, name, "optional env file %s does not exist", envFile.Path)
+			}
+		}
+	}
+}
+
+// portRange is a published host port or range of host ports
+type portRange struct {
+	hostIP     string
+	start, end uint16
+	protocol   string
+}
+
+func (p portRange) overlaps(other portRange) bool {
+	return p.protocol == other.protocol &&
+		p.start <= other.end && other.start <= p.end &&
+		ipsOverlap(p.hostIP, other.hostIP)
+}
+
+// ipsOverlap checks if two bindings can conflict, an empty or unspecified IP binds to all interfaces
+func ipsOverlap(a, b string) bool {
+	isAll := func(ip string) bool {
+		parsed := net.ParseIP(ip)
+		return ip == "" || (parsed != nil && parsed.IsUnspecified())
+	}
+	return isAll(a) || isAll(b) || net.ParseIP(a).Equal(net.ParseIP(b))
+}
+
+func parsePublished(port types.ServicePortConfig) (portRange, error) {
+	result := portRange{hostIP: port.HostIP, protocol: port.Protocol}
+	if result.protocol == "" {
+		result.protocol = "tcp"
+	}
+
+	if port.HostIP != "" && net.ParseIP(port.HostIP) == nil {
+		return result, fmt.Errorf("invalid host ip %q", port.HostIP)
+	}
+
+	startStr, endStr, isRange := strings.Cut(port.Published, "-")
+	if !isRange {
+		endStr = startStr
+	}
+
+	start, err := strconv.ParseUint(startStr, 10, 16)
+	if err != nil || start == 0 {
+		return result, fmt.Errorf("invalid published port %q", port.Published)
+	}
+	end, err := strconv.ParseUint(endStr, 10, 16)
+	if err != nil || end == 0 {
+		return result, fmt.Errorf("invalid published port %q", port.Published)
+	}
+	if start > end {
+		return result, fmt.Errorf("invalid published port range %q, start is greater than end", port.Published)
+	}
+
+	result.start, result.end = uint16(start), uint16(end)
+	return result, nil
+}
+
+func (v *validator) checkPorts(containers []container.Summary) {
+	type claimed struct {
+		service string
+		ports   portRange
+	}
+	var projectPorts []claimed
+
+	for _, name := range v.services() {
+		for _, portConfig := range v.project.Services[name].Ports {
+			// docker picks a random port
+			if portConfig.Published == "" {
+				continue
+			}
+
+			published, err := parsePublished(portConfig)
+			if err != nil {
+				v.add(SeverityError, CheckPorts, name, "%v", err)
+				continue
+			}
+
+			for _, other := range projectPorts {
+				if other.ports.overlaps(published) {
+					v.add(SeverityError, CheckPorts, name,
+						"port %s/%s is also published by service %q", portConfig.Published, published.protocol, other.service)
+				}
+			}
+			projectPorts = append(projectPorts, claimed{service: name, ports: published})
+
+			// compose expands range:range mappings into one config per port, so only a range
+			// published for a single target (range:single) can fall back to another free port
+			anyFree := published.start != published.end
+			v.checkPortConflicts(name, portConfig.Published, published, anyFree, containers)
+		}
+	}
+}
+
+// checkPortConflicts reports running containers outside the project using the published ports,
+// with anyFree docker binds the first free port of the range, so it is only an error if every port is taken
+func (v *validator) checkPortConflicts(service, published string, ports portRange, anyFree bool, containers []container.Summary) {
+	used := make(map[uint16]string)
+	for _, c := range containers {
+		if c.State != container.StateRunning || c.Labels[api.ProjectLabel] == v.project.Name {
+			continue
+		}
+
+		for _, p := range c.Ports {
+			if p.PublicPort == 0 {
+				continue
+			}
+
+			bound := portRange{hostIP: p.IP, start: p.PublicPort, end: p.PublicPort, protocol: p.Type}
+			if ports.overlaps(bound) {
+				used[p.PublicPort] = containerName(c)
+			}
+		}
+	}
+
+	if len(used) == 0 {
+		return
+	}
+
+	total := int(ports.end) - int(ports.start) + 1
+	if anyFree && len(used) < total {
+		return
+	}
+
+	for _, port := range slices.Sorted(maps.Keys(used)) {
+		v.add(SeverityError, CheckPorts, service,
+			"port %d (from %s) is already in use by container %q", port, published, used[port])
+	}
+}
+
+func (v *validator) checkContainerNames(containers []container.Summary) {
+	existing := make(map[string]container.Summary)
+	for _, c := range containers {
+		for _, name := range c.Names {
+			existing[strings.TrimPrefix(name, "/")] = c
+		}
+	}
+
+	declared := make(map[string]string)
+	for _, name := range v.services() {
+		containerName := v.project.Services[name].ContainerName
+		if containerName == "" {
+			continue
+		}
+
+		if other, ok := declared[containerName]; ok {
+			v.add(SeverityError, CheckContainerName, name, "container_name %q is also used by service %q", containerName, other)
+		}
+		declared[containerName] = name
+
+		if c, ok := existing[containerName]; ok && c.Labels[api.ProjectLabel] != v.project.Name {
+			owner := "outside of compose"
+			if project := c.Labels[api.ProjectLabel]; project != "" {
+				owner = fmt.Sprintf("by project %q", project)
+			}
+			v.add(SeverityError, CheckContainerName, name,
+				"container_name %q is already used by container %s created %s", containerName, c.ID[:12], owner)
+		}
+	}
+}
+
+func (s *ComposeService) checkBindMounts(v *validator) {
+	for _, name := range v.services() {
+		for _, vol := range v.project.Services[name].Volumes {
+			if vol.Type != types.VolumeTypeBind {
+				continue
+			}
+
+			exists, err := s.syncer.Exists(vol.Source)
+			if err != nil {
+				v.add(SeverityWarning, CheckBindMounts, name, "unable to check bind mount source %s: %v", vol.Source, err)
+				continue
+			}
+			if exists {
+				continue
+			}
+
+			if vol.Bind != nil && !vol.Bind.CreateHostPath {
+				v.add(SeverityError, CheckBindMounts, name, "bind mount source %s does not exist on the host", vol.Source)
+			} else {
+				v.add(SeverityWarning, CheckBindMounts, name,
+					"bind mount source %s does not exist, docker will create it as an empty directory", vol.Source)
+			}
+		}
+	}
+}
+
+// checkExternalResources ensures external networks and volumes already exist
+func (s *ComposeService) checkExternalResources(ctx context.Context, v *validator) {
+	for _, key := range slices.Sorted(maps.Keys(v.project.Networks)) {
+		netConf := v.project.Networks[key]
+		if !bool(netConf.External) {
+			continue
+		}
+		if _, err := s.daemon.NetworkInspect(ctx, netConf.Name, network.InspectOptions{}); err != nil {
+			v.add(SeverityError, CheckNetworks, servicesUsingNetwork(v.project, key),
+				"external network %q does not exist, create it before deploying", netConf.Name)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(v.project.Volumes)) {
+		volConf := v.project.Volumes[key]
+		if !bool(volConf.External) {
+			continue
+		}
+		if _, err := s.daemon.VolumeInspect(ctx, volConf.Name); err != nil {
+			v.add(SeverityError, CheckVolumes, servicesUsingVolume(v.project, key),
+				"external volume %q does not exist, create it before deploying", volConf.Name)
+		}
+	}
+}
+
+// checkImages ensures images that are not available locally and
+// not built by compose can be pulled from their registry
+func (s *ComposeService) checkImages(ctx context.Context, v *validator) {
+	// same credentials compose uses to pull, loaded once the first remote image is checked
+	var dockerConfig *configfile.ConfigFile
+	checked := make(map[string]struct{})
+	for _, name := range v.services() {
+		svc := v.project.Services[name]
+		if svc.Image == "" || svc.Build != nil {
+			continue
+		}
+		if _, ok := checked[svc.Image]; ok {
+			continue
+		}
+		checked[svc.Image] = struct{}{}
+
+		if _, err := s.daemon.ImageInspect(ctx, svc.Image); err == nil {
+			continue
+		}
+
+		if svc.PullPolicy == types.PullPolicyNever {
+			v.add(SeverityError, CheckImages, name, "image %s is not available locally and pull_policy is never", svc.Image)
+			continue
+		}
+
+		if dockerConfig == nil {
+			dockerConfig = loadDockerConfig()
+		}
+		encodedAuth, err := command.RetrieveAuthTokenFromImage(dockerConfig, svc.Image)
+		if err != nil {
+			// try anonymously, public images do not need credentials
+			log.Debug().Err(err).Str("image", svc.Image).Msg("unable to load registry credentials")
+		}
+
+		_, err = s.daemon.DistributionInspect(ctx, svc.Image, encodedAuth)
+		switch {
+		case err == nil:
+		case client.IsErrNotFound(err):
+			v.add(SeverityError, CheckImages, name, "image %s can not be pulled: %v", svc.Image, err)
+		default:
+			// auth failures and unreachable registries do not mean the pull will fail
+			v.add(SeverityWarning, CheckImages, name, "image %s could not be checked in its registry: %v", svc.Image, err)
+		}
+	}
+}
+
+// servicesUsingNetwork returns a comma separated list of services attached to the network
+func servicesUsingNetwork(project *types.Project, network string) string {
+	var services []string
+	for name, svc := range project.Services {
+		if _, ok := svc.Networks[network]; ok {
+			services = append(services, name)
+		}
+	}
+	slices.Sort(services)
+	return strings.Join(services, ",")
+}
+
+// servicesUsingVolume returns a comma separated list of services mounting the volume
+func servicesUsingVolume(project *types.Project, volume string) string {
+	var services []string
+	for name, svc := range project.Services {
+		for _, vol := range svc.Volumes {
+			if vol.Type == types.VolumeTypeVolume && vol.Source == volume {
+				services = append(services, name)
+				break
+			}
+		}
+	}
+	slices.Sort(services)
+	return strings.Join(services, ",")
+}
@@ -0,0 +1,113 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePublished(t *testing.T) {
+	single, err := parsePublished(types.ServicePortConfig{Published: "8080", Protocol: "tcp"})
+	require.NoError(t, err)
+	require.Equal(t, portRange{start: 8080, end: 8080, protocol: "tcp"}, single)
+
+	ranged, err := parsePublished(types.ServicePortConfig{HostIP: "127.0.0.1", Published: "9000-9005"})
+	require.NoError(t, err)
+	require.Equal(t, portRange{hostIP: "127.0.0.1", start: 9000, end: 9005, protocol: "tcp"}, ranged)
+
+	_, err = parsePublished(types.ServicePortConfig{Published: "9005-9000"})
+	require.Error(t, err)
+	_, err = parsePublished(types.ServicePortConfig{Published: "70000"})
+	require.Error(t, err)
+	_, err = parsePublished(types.ServicePortConfig{HostIP: "localhost", Published: "80"})
+	require.Error(t, err)
+
+	require.True(t, ranged.overlaps(portRange{start: 9003, end: 9003, protocol: "tcp"}))
+	require.False(t, ranged.overlaps(portRange{hostIP: "10.0.0.2", start: 9003, end: 9003, protocol: "tcp"}))
+	require.False(t, ranged.overlaps(portRange{start: 9003, end: 9003, protocol: "udp"}))
+}
+
+func TestValidatePortsAndNames(t *testing.T) {
+	v := &validator{project: &types.Project{
+		Name: "stack",
+		Services: types.Services{
+			"web": {
+				Name:          "web",
+				ContainerName: "proxy",
+				Ports:         []types.ServicePortConfig{{Published: "80", Target: 80, Protocol: "tcp"}},
+			},
+			"api": {
+				Name:  "api",
+				Ports: []types.ServicePortConfig{{Published: "8000-8001", Target: 8000, Protocol: "tcp"}},
+			},
+		},
+	}}
+
+	containers := []container.Summary{
+		{
+			ID:    "aaaaaaaaaaaaaaaa",
+			Names: []string{"/proxy"},
+			State: container.StateRunning,
+			Ports: []container.Port{{IP: "0.0.0.0", PublicPort: 80, Type: "tcp"}},
+		},
+		{
+			// only one port of the range is taken, docker can use the other
+			ID:    "bbbbbbbbbbbbbbbb",
+			Names: []string{"/other"},
+			State: container.StateRunning,
+			Ports: []container.Port{{IP: "0.0.0.0", PublicPort: 8000, Type: "tcp"}},
+		},
+		{
+			// containers of the same project will be replaced
+			ID:     "cccccccccccccccc",
+			Names:  []string{"/stack-api-1"},
+			State:  container.StateRunning,
+			Labels: map[string]string{api.ProjectLabel: "stack"},
+			Ports:  []container.Port{{IP: "0.0.0.0", PublicPort: 8001, Type: "tcp"}},
+		},
+	}
+
+	v.checkPorts(containers)
+	v.checkContainerNames(containers)
+
+	require.Len(t, v.findings, 2)
+	require.Equal(t, CheckPorts, v.findings[0].Check)
+	require.Equal(t, "web", v.findings[0].Service)
+	require.Equal(t, CheckContainerName, v.findings[1].Check)
+	require.Equal(t, SeverityError, v.findings[1].Severity)
+}
+
+func TestValidatePortRangeMapping(t *testing.T) {
+	ranged, err := types.ParsePortConfig("8000-8002:8000-8002")
+	require.NoError(t, err)
+	single, err := types.ParsePortConfig("9000-9002:80")
+	require.NoError(t, err)
+
+	v := &validator{project: &types.Project{
+		Name: "stack",
+		Services: types.Services{
+			"api": {Name: "api", Ports: ranged},
+			"web": {Name: "web", Ports: single},
+		},
+	}}
+
+	containers := []container.Summary{{
+		ID:    "aaaaaaaaaaaaaaaa",
+		Names: []string{"/other"},
+		State: container.StateRunning,
+		Ports: []container.Port{
+			{IP: "0.0.0.0", PublicPort: 8001, Type: "tcp"},
+			{IP: "0.0.0.0", PublicPort: 9001, Type: "tcp"},
+		},
+	}}
+
+	v.checkPorts(containers)
+
+	// every port of range:range is needed, range:single uses another free port
+	require.Len(t, v.findings, 1)
+	require.Equal(t, "api", v.findings[0].Service)
+	require.Contains(t, v.findings[0].Message, "8001")
+}
@@ -0,0 +1,228 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+)
+
+// VolumeHelperImage small image used to mount volumes into short-lived helper containers
+const VolumeHelperImage = "busybox:stable"
+
+// volumeMountPath where the volume is mounted inside the helper container
+const volumeMountPath = "/data"
+
+// ErrNotDirectory returned when exporting a bind mount whose source is a file
+var ErrNotDirectory = errors.New("mount source is not a directory")
+
+// VolumeExport writes the contents of a volume to w as an uncompressed tar stream,
+// paths in the archive are relative to the volume root
+func (s *ContainerService) VolumeExport(ctx context.Context, volumeName string, w io.Writer) error {
+	return s.mountExport(ctx, volumeMount(volumeName, true), w)
+}
+
+// VolumeImport extracts the tar stream r into a volume, creating the volume if it does not exist.
+// When clear is set the existing volume contents are removed first
+func (s *ContainerService) VolumeImport(ctx context.Context, volumeName string, r io.Reader, clear bool) error {
+	if _, err := s.daemon.VolumeInspect(ctx, volumeName); err != nil {
+		if !client.IsErrNotFound(err) {
+			return fmt.Errorf("failed to inspect volume %s: %w", volumeName, err)
+		}
+		if _, err = s.daemon.VolumeCreate(ctx, volume.CreateOptions{Name: volumeName}); err != nil {
+			return fmt.Errorf("failed to create volume %s: %w", volumeName, err)
+		}
+	}
+
+	return s.mountImport(ctx, volumeMount(volumeName, false), r, clear)
+}
+
+// BindExport same as VolumeExport for a directory on the host filesystem
+func (s *ContainerService) BindExport(ctx context.Context, hostPath string, w io.Writer) error {
+	return s.mountExport(ctx, bindMount(hostPath, true), w)
+}
+
+// BindImport same as VolumeImport for a directory on the host filesystem, the directory is created if missing
+func (s *ContainerService) BindImport(ctx context.Context, hostPath string, r io.Reader, clear bool) error {
+	return s.mountImport(ctx, bindMount(hostPath, false), r, clear)
+}
+
+func (s *ContainerService) mountExport(ctx context.Context, m mount.Mount, w io.Writer) error {
+	helperID, err := s.volumeHelperCreate(ctx, m)
+	if err != nil {
+		return err
+	}
+	defer s.volumeHelperRemove(helperID)
+
+	stat, err := s.daemon.ContainerStatPath(ctx, helperID, volumeMountPath)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", m.Source, err)
+	}
+	if !stat.Mode.IsDir() {
+		return fmt.Errorf("%s: %w", m.Source, ErrNotDirectory)
+	}
+
+	// the trailing /. copies the directory contents without the data/ prefix
+	reader, _, err := s.daemon.CopyFromContainer(ctx, helperID, volumeMountPath+"/.")
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", m.Source, err)
+	}
+	defer fileutil.Close(reader)
+
+	if _, err = io.Copy(w, reader); err != nil {
+		return fmt.Errorf("failed to stream %s: %w", m.Source, err)
+	}
+	return nil
+}
+
+func (s *ContainerService) mountImport(ctx context.Context, m mount.Mount, r io.Reader, clear bool) error {
+	var cmd []string
+	if clear {
+		cmd = []string{"find", volumeMountPath, "-mindepth", "1", "-delete"}
+	}
+	helperID, err := s.volumeHelperCreate(ctx, m, cmd...)
+	if err != nil {
+		return err
+	}
+	defer s.volumeHelperRemove(helperID)
+
+	if clear {
+		if _, err = s.volumeHelperRun(ctx, helperID); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", m.Source, err)
+		}
+	}
+
+	err = s.daemon.CopyToContainer(ctx, helperID, volumeMountPath, r, container.CopyToContainerOptions{
+		CopyUIDGID: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", m.Source, err)
+	}
+	return nil
+}
+
+func volumeMount(volumeName string, readOnly bool) mount.Mount {
+	return mount.Mount{
+		Type:     mount.TypeVolume,
+		Source:   volumeName,
+		Target:   volumeMountPath,
+		ReadOnly: readOnly,
+	}
+}
+
+func bindMount(hostPath string, readOnly bool) mount.Mount {
+	return mount.Mount{
+		Type:     mount.TypeBind,
+		Source:   hostPath,
+		Target:   volumeMountPath,
+		ReadOnly: readOnly,
+		BindOptions: &mount.BindOptions{
+			// restoring into a path that was removed should not fail
+			CreateMountpoint: !readOnly,
+		},
+	}
+}
+
+// VolumeContainers lists all containers that mount the volume, stopped ones included
+func (s *ContainerService) VolumeContainers(ctx context.Context, volumeName string) ([]container.Summary, error) {
+	return s.daemon.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("volume", volumeName)),
+	})
+}
+
+// volumeHelperCreate creates but does not start a helper container with m mounted at volumeMountPath,
+// the archive api works on stopped containers so the helper only runs when a cmd is given
+func (s *ContainerService) volumeHelperCreate(ctx context.Context, m mount.Mount, cmd ...string) (string, error) {
+	if err := s.ensureImage(ctx, VolumeHelperImage); err != nil {
+		return "", err
+	}
+
+	if len(cmd) == 0 {
+		cmd = []string{"true"}
+	}
+
+	resp, err := s.daemon.ContainerCreate(ctx,
+		&container.Config{
+			Image:  VolumeHelperImage,
+			Cmd:    cmd,
+			Labels: map[string]string{"dockman.helper": "volume"},
+		},
+		&container.HostConfig{
+			Mounts: []mount.Mount{m},
+		},
+		nil, nil, "",
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to create helper container for %s: %w", m.Source, err)
+	}
+	return resp.ID, nil
+}
+
+// volumeHelperRun starts the helper container, waits for its command to exit and returns its stdout,
+// a failing command returns its stderr as the error
+func (s *ContainerService) volumeHelperRun(ctx context.Context, helperID string) (string, error) {
+	if err := s.daemon.ContainerStart(ctx, helperID, container.StartOptions{}); err != nil {
+		return "", err
+	}
+
+	var exitCode int64
+	waitCh, errCh := s.daemon.ContainerWait(ctx, helperID, container.WaitConditionNotRunning)
+	select {
+	case res := <-waitCh:
+		if res.Error != nil {
+			return "", fmt.Errorf("%s", res.Error.Message)
+		}
+		exitCode = res.StatusCode
+	case err := <-errCh:
+		return "", err
+	}
+
+	logs, err := s.daemon.ContainerLogs(ctx, helperID, container.LogsOptions{ShowStdout: true, ShowStderr: true})
+	if err != nil {
+		return "", fmt.Errorf("failed to read helper output: %w", err)
+	}
+	defer fileutil.Close(logs)
+
+	var stdout, stderr strings.Builder
+	if _, err = stdcopy.StdCopy(&stdout, &stderr, logs); err != nil {
+		return "", fmt.Errorf("failed to read helper output: %w", err)
+	}
+
+	if exitCode != 0 {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = fmt.Sprintf("helper exited with status %d", exitCode)
+		}
+		return stdout.String(), errors.New(msg)
+	}
+	return stdout.String(), nil
+}
+
+// volumeHelperRemove uses its own context so the helper is cleaned up even if the request was cancelled
+func (s *ContainerService) volumeHelperRemove(helperID string) {
+	err := s.daemon.ContainerRemove(context.Background(), helperID, container.RemoveOptions{Force: true})
+	if err != nil {
+		log.Warn().Err(err).Str("container", helperID).Msg("failed to remove volume helper container")
+	}
+}
+
+// ensureImage pulls the image only if it is not present on the host
+func (s *ContainerService) ensureImage(ctx context.Context, ref string) error {
+	if _, err := s.daemon.ImageInspect(ctx, ref); err == nil {
+		return nil
+	} else if !client.IsErrNotFound(err) {
+		return fmt.Errorf("failed to inspect image %s: %w", ref, err)
+	}
+	return s.ImagePull(ctx, ref)
+}
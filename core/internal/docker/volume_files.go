@@ -0,0 +1,274 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/pkg/fileutil"
+	"github.com/docker/docker/api/types/container"
+)
+
+// FileSizeLimit max size of a single file read from or written to a volume or container
+const FileSizeLimit = 10 << 20 // 10 MiB
+
+var ErrFileTooLarge = fmt.Errorf("file exceeds the %d MiB limit", FileSizeLimit>>20)
+
+type FileEntry struct {
+	Name string
+	// path from the volume or container root, always starts with /
+	Path    string
+	Size    int64
+	Mode    fs.FileMode
+	ModTime time.Time
+	UID     int
+	GID     int
+	// only set for symlinks listed from containers
+	LinkTarget string
+}
+
+func (e FileEntry) IsDir() bool {
+	return e.Mode.IsDir()
+}
+
+// VolumeListDir lists the direct children of dir inside a volume, directories first
+func (s *ContainerService) VolumeListDir(ctx context.Context, volumeName, dir string) ([]FileEntry, error) {
+	dir = cleanRootPath(dir)
+	target := path.Join(volumeMountPath, dir)
+
+	// mode is printed in hex so the file type can be decoded,
+	// name comes last as it may contain spaces
+	helperID, err := s.volumeHelperCreate(ctx, volumeMount(volumeName, true),
+		"find", target, "-mindepth", "1", "-maxdepth", "1",
+		"-exec", "stat", "-c", "%f %s %Y %u %g %n", "{}", "+",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer s.volumeHelperRemove(helperID)
+
+	out, err := s.volumeHelperRun(ctx, helperID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s in %s: %w", dir, volumeName, err)
+	}
+
+	entries := parseStatOutput(out, volumeMountPath)
+	sortEntries(entries)
+	return entries, nil
+}
+
+// sortEntries sorts directories first, then by name
+func sortEntries(entries []FileEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].IsDir() != entries[j].IsDir() {
+			return entries[i].IsDir()
+		}
+		return entries[i].Name < entries[j].Name
+	})
+}
+
+// VolumeReadFile returns the contents of a regular file inside a volume
+func (s *ContainerService) VolumeReadFile(ctx context.Context, volumeName, filePath string) ([]byte, error) {
+	filePath = cleanRootPath(filePath)
+
+	helperID, err := s.volumeHelperCreate(ctx, volumeMount(volumeName, true))
+	if err != nil {
+		return nil, err
+	}
+	defer s.volumeHelperRemove(helperID)
+
+	reader, stat, err := s.daemon.CopyFromContainer(ctx, helperID, path.Join(volumeMountPath, filePath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s in %s: %w", filePath, volumeName, err)
+	}
+	defer fileutil.Close(reader)
+
+	if !stat.Mode.IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", filePath)
+	}
+	if stat.Size > FileSizeLimit {
+		return nil, ErrFileTooLarge
+	}
+
+	tr := tar.NewReader(reader)
+	if _, err = tr.Next(); err != nil {
+		return nil, fmt.Errorf("failed to read %s in %s: %w", filePath, volumeName, err)
+	}
+	return io.ReadAll(io.LimitReader(tr, FileSizeLimit))
+}
+
+// VolumeWriteFile creates or replaces a file inside a volume, missing parent directories are created.
+// The file keeps the owner of the file it replaces, new files and directories take the owner of
+// the closest existing parent
+func (s *ContainerService) VolumeWriteFile(ctx context.Context, volumeName, filePath string, contents []byte, mode fs.FileMode) error {
+	filePath = cleanRootPath(filePath)
+	if filePath == "/" {
+		return fmt.Errorf("a file name is required")
+	}
+	if len(contents) > FileSizeLimit {
+		return ErrFileTooLarge
+	}
+	if mode == 0 {
+		mode = 0644
+	}
+
+	helperID, err := s.volumeHelperCreate(ctx, volumeMount(volumeName, false),
+		"sh", "-c", prepareWriteScript, "_", path.Join(volumeMountPath, filePath),
+	)
+	if err != nil {
+		return err
+	}
+	defer s.volumeHelperRemove(helperID)
+
+	out, err := s.volumeHelperRun(ctx, helperID)
+	if err != nil {
+		return fmt.Errorf("failed to prepare %s in %s: %w", filePath, volumeName, err)
+	}
+	var uid, gid int
+	if _, err = fmt.Sscanf(strings.TrimSpace(out), "%d %d", &uid, &gid); err != nil {
+		return fmt.Errorf("unexpected helper output %q: %w", out, err)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err = tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path.Base(filePath),
+		Mode:     int64(mode.Perm()),
+		Size:     int64(len(contents)),
+		Uid:      uid,
+		Gid:      gid,
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = tw.Write(contents); err != nil {
+		return err
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+
+	// extracting into the parent leaves existing directories untouched
+	dst := path.Join(volumeMountPath, path.Dir(filePath))
+	err = s.daemon.CopyToContainer(ctx, helperID, dst, &buf, container.CopyToContainerOptions{
+		CopyUIDGID: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write %s in %s: %w", filePath, volumeName, err)
+	}
+	return nil
+}
+
+// prepareWriteScript prints the owner of the file or its closest existing parent
+// and creates the missing parent directories with that owner
+const prepareWriteScript = `set -e
+f="$1"; d=$(dirname "$f"); p="$f"
+while [ ! -e "$p" ]; do p=$(dirname "$p"); done
+o=$(stat -c "%u %g" "$p")
+if [ ! -d "$d" ]; then
+	mkdir -p "$d"
+	q="$d"
+	while [ "$q" != "$p" ]; do chown "${o% *}:${o#* }" "$q"; q=$(dirname "$q"); done
+fi
+echo "$o"`
+
+// VolumeDeleteFile removes a file inside a volume, directories are only removed when recursive is set
+func (s *ContainerService) VolumeDeleteFile(ctx context.Context, volumeName, filePath string, recursive bool) error {
+	filePath = cleanRootPath(filePath)
+	if filePath == "/" {
+		return fmt.Errorf("refusing to delete the volume root")
+	}
+
+	cmd := []string{"rm", "-f", "--", path.Join(volumeMountPath, filePath)}
+	if recursive {
+		cmd = []string{"rm", "-rf", "--", path.Join(volumeMountPath, filePath)}
+	}
+
+	helperID, err := s.volumeHelperCreate(ctx, volumeMount(volumeName, false), cmd...)
+	if err != nil {
+		return err
+	}
+	defer s.volumeHelperRemove(helperID)
+
+	if _, err = s.volumeHelperRun(ctx, helperID); err != nil {
+		return fmt.Errorf("failed to delete %s in %s: %w", filePath, volumeName, err)
+	}
+	return nil
+}
+
+// cleanRootPath resolves a user supplied path to an absolute path inside a volume or container,
+// .. can never climb above the volume root
+func cleanRootPath(p string) string {
+	return path.Clean("/" + strings.ReplaceAll(p, `\`, "/"))
+}
+
+// parseStatOutput parses lines of `stat -c "%f %s %Y %u %g %n"`, root is stripped from the names
+func parseStatOutput(out, root string) []FileEntry {
+	var entries []FileEntry
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, " ", 6)
+		if len(fields) != 6 {
+			continue
+		}
+
+		rawMode, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			continue
+		}
+		size, _ := strconv.ParseInt(fields[1], 10, 64)
+		mtime, _ := strconv.ParseInt(fields[2], 10, 64)
+		uid, _ := strconv.Atoi(fields[3])
+		gid, _ := strconv.Atoi(fields[4])
+
+		full := strings.TrimPrefix(fields[5], root)
+		entries = append(entries, FileEntry{
+			Name:    path.Base(full),
+			Path:    cleanRootPath(full),
+			Size:    size,
+			Mode:    unixMode(uint32(rawMode)),
+			ModTime: time.Unix(mtime, 0),
+			UID:     uid,
+			GID:     gid,
+		})
+	}
+	return entries
+}
+
+// unixMode converts a raw st_mode into a FileMode
+func unixMode(raw uint32) fs.FileMode {
+	mode := fs.FileMode(raw & 0o777)
+	switch raw & 0o170000 {
+	case 0o040000:
+		mode |= fs.ModeDir
+	case 0o120000:
+		mode |= fs.ModeSymlink
+	case 0o010000:
+		mode |= fs.ModeNamedPipe
+	case 0o140000:
+		mode |= fs.ModeSocket
+	case 0o020000:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case 0o060000:
+		mode |= fs.ModeDevice
+	}
+	if raw&0o4000 != 0 {
+		mode |= fs.ModeSetuid
+	}
+	if raw&0o2000 != 0 {
+		mode |= fs.ModeSetgid
+	}
+	if raw&0o1000 != 0 {
+		mode |= fs.ModeSticky
+	}
+	return mode
+}
@@ -0,0 +1,37 @@
+package docker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanRootPath(t *testing.T) {
+	require.Equal(t, "/", cleanRootPath(""))
+	require.Equal(t, "/conf/app.ini", cleanRootPath("conf/app.ini"))
+	require.Equal(t, "/etc/passwd", cleanRootPath("../../etc/passwd"))
+	require.Equal(t, "/a/c", cleanRootPath(`a\b\..\c`))
+}
+
+func TestParseStatOutput(t *testing.T) {
+	out := "41ed 4096 1700000000 1000 1000 /data/conf\n" +
+		"81a4 12 1700000100 0 0 /data/conf/my file.ini\n" +
+		"a1ff 7 1700000200 0 0 /data/link\n" +
+		"garbage\n"
+
+	entries := parseStatOutput(out, volumeMountPath)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "conf", entries[0].Name)
+	require.Equal(t, "/conf", entries[0].Path)
+	require.True(t, entries[0].IsDir())
+	require.Equal(t, "drwxr-xr-x", entries[0].Mode.String())
+	require.Equal(t, 1000, entries[0].UID)
+
+	require.Equal(t, "my file.ini", entries[1].Name)
+	require.Equal(t, "/conf/my file.ini", entries[1].Path)
+	require.Equal(t, int64(12), entries[1].Size)
+	require.True(t, entries[1].Mode.IsRegular())
+
+	require.Equal(t, "Lrwxrwxrwx", entries[2].Mode.String())
+}
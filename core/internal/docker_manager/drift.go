@@ -0,0 +1,97 @@
+package docker_manager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/notifications"
+	"github.com/rs/zerolog/log"
+)
+
+// max time allowed to check all stacks on a single host
+const driftCheckTimeout = 2 * time.Minute
+
+// StartDriftChecker periodically compares every stack on every host
+// with its compose file and sends a notification when drift is found.
+//
+// blocking function must be run a go routine
+func (srv *Service) StartDriftChecker(interval time.Duration) {
+	log.Info().Str("interval", interval.String()).Msg("Starting compose drift checker")
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+
+	// drift already notified keyed by host/file/service, value is the hash pair,
+	// so the same drift is only reported once
+	notified := make(map[string]string)
+	for range tick.C {
+		srv.checkDrift(notified)
+	}
+}
+
+func (srv *Service) checkDrift(notified map[string]string) {
+	seen := make(map[string]struct{})
+	var report []string
+
+	for host, dock := range srv.ListServices() {
+		files, err := dock.Compose.ListComposeFiles()
+		if err != nil {
+			log.Warn().Err(err).Str("host", host).Msg("unable to list compose files for drift check")
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), driftCheckTimeout)
+		for _, file := range files {
+			drift, err := dock.Compose.ComposeDrift(ctx, file)
+			if err != nil {
+				log.Debug().Err(err).Str("host", host).Str("file", file).Msg("unable to check drift")
+				continue
+			}
+
+			for _, d := range drift {
+				// missing services are not drift, the stack may simply be stopped
+				if d.State == docker.DriftInSync || d.State == docker.DriftMissing {
+					continue
+				}
+
+				key := fmt.Sprintf("%s/%s/%s", host, file, d.Service)
+				seen[key] = struct{}{}
+				hashes := d.ExpectedHash + ":" + d.ActualHash
+				if notified[key] == hashes {
+					continue
+				}
+				notified[key] = hashes
+				report = append(report, describeDrift(host, file, d))
+			}
+		}
+		cancel()
+	}
+
+	// forget resolved drift so it is reported again if it comes back
+	for key := range notified {
+		if _, ok := seen[key]; !ok {
+			delete(notified, key)
+		}
+	}
+
+	if len(report) == 0 {
+		return
+	}
+
+	notifications.Send(notifications.NewMessage(
+		notifications.LevelDrift,
+		fmt.Sprintf("Config drift detected in %d service(s)", len(report)),
+		strings.Join(report, "\n\n"),
+	))
+}
+
+func describeDrift(host, file string, d docker.ServiceDrift) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintf(&sb, "[%s] %s: service %s is %s", host, file, d.Service, d.State)
+	for _, diff := range d.Diffs {
+		_, _ = fmt.Fprintf(&sb, "\n  %s: %q -> %q", diff.Field, diff.Actual, diff.Expected)
+	}
+	return sb.String()
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/RA341/dockman/internal/config"
 	"github.com/RA341/dockman/internal/docker"
 	"github.com/RA341/dockman/internal/git"
+	"github.com/RA341/dockman/internal/metrics"
 	"github.com/RA341/dockman/internal/ssh"
 	"github.com/rs/zerolog/log"
 )
@@ -27,6 +28,8 @@ type Service struct {
 
 	mu           sync.RWMutex
 	activeClient *docker.Service
+	// serializes reconnects so concurrent callers do not replace each others clients
+	reconnectMu sync.Mutex
 
 	userConfig config.Store
 	updaterCtx chan interface{}
@@ -123,7 +126,10 @@ func (srv *Service) StartContainerUpdater() {
 func (srv *Service) UpdateContainers(opts ...docker.UpdateOption) {
 	updateHost := func(name string, dock *ConnectedDockerClient) error {
 		cli := srv.loadDockerService(name, dock)
+
+		start := time.Now()
 		err := cli.Container.ContainersUpdateAll(context.Background(), opts...)
+		metrics.ObserveUpdaterRun(name, time.Since(start), err)
 		if err != nil {
 			return fmt.Errorf("error occured while updating containers for host: %s\n%w", name, err)
 		}
@@ -159,6 +165,15 @@ func (srv *Service) GetService() *docker.Service {
 	return srv.activeClient
 }
 
+// ListServices returns a docker service for every connected host
+func (srv *Service) ListServices() map[string]*docker.Service {
+	services := make(map[string]*docker.Service)
+	for name, dock := range srv.manager.ListHosts() {
+		services[name] = srv.loadDockerService(name, dock)
+	}
+	return services
+}
+
 func (srv *Service) EditClient(editedMach *ssh.MachineOptions) error {
 	oldMach, err := srv.ssh.GetMachByID(editedMach.ID)
 	if err != nil {
@@ -284,6 +299,55 @@ func (srv *Service) SwitchClient(name string) error {
 	return nil
 }
 
+// Reconnect re-establishes the ssh tunnel and docker client of a host and returns a service for it.
+// Calls are serialized, a client that answers again, eg: it was already reconnected by a concurrent caller,
+// is reused instead of being torn down
+func (srv *Service) Reconnect(name string) (*docker.Service, error) {
+	srv.reconnectMu.Lock()
+	defer srv.reconnectMu.Unlock()
+
+	mach, ok := srv.manager.connectedClients.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("host %s is not connected", name)
+	}
+	_, err := testDockerConnection(mach.dockerClient)
+	if err == nil {
+		return srv.loadDockerService(name, mach), nil
+	}
+	if name == docker.LocalClient {
+		// nothing to re-establish for the local socket
+		return nil, err
+	}
+
+	if err = srv.ssh.Reconnect(name); err != nil {
+		return nil, fmt.Errorf("unable to reconnect ssh for %s: %w", name, err)
+	}
+
+	conn, ok := srv.ssh.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("ssh client not found for %s after reconnect", name)
+	}
+
+	mach.Close()
+	if err = srv.manager.Load(name, conn); err != nil {
+		return nil, fmt.Errorf("failed to create docker client: %w", err)
+	}
+	mach, ok = srv.manager.connectedClients.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("host %s was removed during reconnect", name)
+	}
+
+	dock := srv.loadDockerService(name, mach)
+	if srv.manager.Active() == name {
+		srv.mu.Lock()
+		srv.activeClient = dock
+		srv.mu.Unlock()
+	}
+
+	log.Info().Str("host", name).Msg("reconnected to docker host")
+	return dock, nil
+}
+
 func (srv *Service) loadDockerService(name string, mach *ConnectedDockerClient) *docker.Service {
 	// to add direct links to services
 	composeRoot := srv.composeRoot()
@@ -149,6 +149,29 @@ func (h *Handler) Rename(_ context.Context, req *connect.Request[v1.RenameFile])
 	return &connect.Response[v1.Empty]{}, nil
 }
 
+func (h *Handler) ListTemplates(_ context.Context, _ *connect.Request[v1.Empty]) (*connect.Response[v1.ListTemplatesResponse], error) {
+	templates, err := h.srv.ListTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []*v1.Template
+	for _, t := range templates {
+		resp = append(resp, t.toProto())
+	}
+
+	return connect.NewResponse(&v1.ListTemplatesResponse{Templates: resp}), nil
+}
+
+func (h *Handler) CreateFromTemplate(_ context.Context, req *connect.Request[v1.CreateFromTemplateRequest]) (*connect.Response[v1.File], error) {
+	filename, err := h.srv.CreateFromTemplate(req.Msg.TemplateId, req.Msg.StackName, req.Msg.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.File{Filename: filename}), nil
+}
+
 func getFile(c *v1.File) (string, error) {
 	msg := c.GetFilename()
 	if msg == "" {
@@ -204,3 +227,25 @@ func (i ImageConfig) toProto() *v1.ImageConfig {
 		Sort: i.Sort.toProto(),
 	}
 }
+
+func (t Template) toProto() *v1.Template {
+	placeholders := make([]*v1.Placeholder, 0, len(t.Placeholders))
+	for _, p := range t.Placeholders {
+		placeholders = append(placeholders, &v1.Placeholder{
+			Name:        p.Name,
+			Description: p.Description,
+			Default:     p.Default,
+			Required:    p.Required,
+			Random:      p.Random,
+		})
+	}
+
+	return &v1.Template{
+		Id:           t.ID,
+		Name:         t.Name,
+		Description:  t.Description,
+		Compose:      t.Compose,
+		Builtin:      t.Builtin,
+		Placeholders: placeholders,
+	}
+}
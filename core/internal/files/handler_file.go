@@ -4,6 +4,7 @@ import (
 	b64 "encoding/base64"
 	"net/http"
 	"path/filepath"
+	"time"
 
 	"github.com/RA341/dockman/pkg/fileutil"
 	"github.com/rs/zerolog/log"
@@ -11,12 +12,20 @@ import (
 
 const fileContentsFormKey = "contents"
 
+// ActionHook is called after a file is loaded or saved, can be used for auditing
+type ActionHook func(r *http.Request, action, filename string, err error, duration time.Duration)
+
 type FileHandler struct {
-	srv *Service
+	srv  *Service
+	hook ActionHook
 }
 
-func NewFileHandler(service *Service) http.Handler {
-	hand := &FileHandler{srv: service}
+func NewFileHandler(service *Service, hook ActionHook) http.Handler {
+	if hook == nil {
+		hook = func(*http.Request, string, string, error, time.Duration) {}
+	}
+
+	hand := &FileHandler{srv: service, hook: hook}
 	return hand.register()
 }
 
@@ -36,7 +45,9 @@ func (h *FileHandler) loadFile(w http.ResponseWriter, r *http.Request) {
 	}
 	cleanPath := filepath.Clean(fileName)
 
+	start := time.Now()
 	fullPath, err := h.srv.LoadFilePath(cleanPath)
+	h.hook(r, "Load", cleanPath, err, time.Since(start))
 	if err != nil {
 		log.Error().Err(err).Str("path", cleanPath).Msg("Error loading file")
 		http.Error(w, "Filename not found", http.StatusBadRequest)
@@ -68,7 +79,9 @@ func (h *FileHandler) saveFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	start := time.Now()
 	err = h.srv.Save(string(decodedFileName), file)
+	h.hook(r, "Save", string(decodedFileName), err, time.Since(start))
 	if err != nil {
 		log.Error().Err(err).Msg("Error saving file")
 		http.Error(w, "Error saving file", http.StatusInternalServerError)
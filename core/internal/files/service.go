@@ -24,15 +24,17 @@ type Service struct {
 	machineFolder ActiveMachineFolderProvider
 	composeRoot   func() string
 	dockYamlPath  string
-	guid          int
-	puid          int
+	// directory with custom stack templates
+	templateDir string
+	guid        int
+	puid        int
 
 	lastModTime time.Time
 	cachedYaml  *DockmanYaml
 }
 
 func NewService(
-	composeRoot, dockYaml string,
+	composeRoot, dockYaml, templateDir string,
 	puid, guid int,
 	machineFolder ActiveMachineFolderProvider,
 ) *Service {
@@ -59,6 +61,7 @@ func NewService(
 
 	srv := &Service{
 		composeRoot:   prov,
+		templateDir:   templateDir,
 		guid:          guid,
 		puid:          puid,
 		machineFolder: machineFolder,
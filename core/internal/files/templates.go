@@ -0,0 +1,250 @@
+package files
+
+import (
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/rs/zerolog/log"
+)
+
+//go:embed all:templates
+var builtinTemplates embed.FS
+
+// templateMetaFile describes a template, it is not copied into the generated stack
+const templateMetaFile = "template.yaml"
+
+// stackNamePlaceholder is always available and set to the name of the new stack
+const stackNamePlaceholder = "STACK_NAME"
+
+// placeholderPattern matches {{ NAME }} in template files
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+var stackNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+type Placeholder struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Default     string `yaml:"default"`
+	Required    bool   `yaml:"required"`
+	// generate a random value if none is provided, for passwords and secrets
+	Random bool `yaml:"random"`
+}
+
+type Template struct {
+	// ID is the name of the template directory
+	ID           string        `yaml:"-"`
+	Name         string        `yaml:"name"`
+	Description  string        `yaml:"description"`
+	Compose      string        `yaml:"compose"`
+	Placeholders []Placeholder `yaml:"placeholders"`
+	// Builtin templates ship with dockman, custom templates with the same id override them
+	Builtin bool `yaml:"-"`
+
+	fsys fs.FS
+}
+
+// ListTemplates returns the builtin and custom templates sorted by id
+func (s *Service) ListTemplates() ([]Template, error) {
+	catalog, err := s.templateCatalog()
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.SortedFunc(maps.Values(catalog), func(a, b Template) int {
+		return strings.Compare(a.ID, b.ID)
+	}), nil
+}
+
+// CreateFromTemplate writes the template files into a new folder in the compose root
+// and returns the path of the compose file relative to the compose root
+func (s *Service) CreateFromTemplate(templateID, stackName string, values map[string]string) (string, error) {
+	if !stackNamePattern.MatchString(stackName) {
+		return "", fmt.Errorf("invalid stack name %q, only letters, numbers, '_', '.' and '-' are allowed", stackName)
+	}
+
+	catalog, err := s.templateCatalog()
+	if err != nil {
+		return "", err
+	}
+	tmpl, ok := catalog[templateID]
+	if !ok {
+		return "", fmt.Errorf("template %q not found", templateID)
+	}
+
+	resolved, err := tmpl.resolveValues(stackName, values)
+	if err != nil {
+		return "", err
+	}
+
+	stackDir := s.WithRoot(stackName)
+	if _, err = os.Stat(stackDir); err == nil {
+		return "", fmt.Errorf("%s already exists", stackName)
+	}
+
+	// with compose folders the stack is promoted only if the compose file is named compose.yaml
+	composeFile := tmpl.Compose
+	if s.GetDockmanYaml().UseComposeFolders {
+		composeFile = "compose.yaml"
+	}
+
+	err = fs.WalkDir(tmpl.fsys, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filePath == templateMetaFile {
+			return nil
+		}
+
+		contents, err := fs.ReadFile(tmpl.fsys, filePath)
+		if err != nil {
+			return err
+		}
+
+		dest := filePath
+		if filePath == tmpl.Compose {
+			dest = composeFile
+		}
+		dest = filepath.Join(stackDir, filepath.FromSlash(dest))
+
+		if err = os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(dest, fillPlaceholders(contents, resolved), 0644)
+	})
+	if err != nil {
+		// do not leave a half written stack behind
+		if rmErr := os.RemoveAll(stackDir); rmErr != nil {
+			log.Warn().Err(rmErr).Str("path", stackDir).Msg("unable to clean up failed template")
+		}
+		return "", fmt.Errorf("unable to create stack from template %s: %w", templateID, err)
+	}
+
+	return path.Join(stackName, composeFile), nil
+}
+
+// templateCatalog loads builtin templates and overlays the ones in the template dir
+func (s *Service) templateCatalog() (map[string]Template, error) {
+	builtin, err := fs.Sub(builtinTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := loadTemplates(builtin, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.templateDir == "" {
+		return catalog, nil
+	}
+
+	custom, err := loadTemplates(os.DirFS(s.templateDir), false)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return catalog, nil
+		}
+		return nil, err
+	}
+	maps.Copy(catalog, custom)
+
+	return catalog, nil
+}
+
+// loadTemplates treats every directory with a template.yaml as a template
+func loadTemplates(fsys fs.FS, builtin bool) (map[string]Template, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]Template, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		id := entry.Name()
+		meta, err := fs.ReadFile(fsys, path.Join(id, templateMetaFile))
+		if err != nil {
+			continue
+		}
+
+		var tmpl Template
+		if err = yaml.Unmarshal(meta, &tmpl); err != nil {
+			log.Warn().Err(err).Str("template", id).Msg("invalid template.yaml, skipping template")
+			continue
+		}
+
+		tmpl.ID = id
+		tmpl.Builtin = builtin
+		if tmpl.Name == "" {
+			tmpl.Name = id
+		}
+		if tmpl.Compose == "" {
+			tmpl.Compose = "compose.yaml"
+		}
+		tmpl.fsys, err = fs.Sub(fsys, id)
+		if err != nil {
+			return nil, err
+		}
+
+		result[id] = tmpl
+	}
+
+	return result, nil
+}
+
+// resolveValues fills in defaults and random values and checks required placeholders
+func (t Template) resolveValues(stackName string, values map[string]string) (map[string]string, error) {
+	resolved := map[string]string{stackNamePlaceholder: stackName}
+
+	var missing []string
+	for _, p := range t.Placeholders {
+		val := strings.TrimSpace(values[p.Name])
+		if val == "" {
+			val = p.Default
+		}
+		if val == "" && p.Random {
+			val = randomValue()
+		}
+		if val == "" && p.Required {
+			missing = append(missing, p.Name)
+		}
+		resolved[p.Name] = val
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing values for placeholders: %s", strings.Join(missing, ", "))
+	}
+
+	return resolved, nil
+}
+
+// fillPlaceholders replaces known placeholders, unknown ones are left as is
+func fillPlaceholders(contents []byte, values map[string]string) []byte {
+	return placeholderPattern.ReplaceAllFunc(contents, func(match []byte) []byte {
+		name := placeholderPattern.FindSubmatch(match)[1]
+		if val, ok := values[string(name)]; ok {
+			return []byte(val)
+		}
+		return match
+	})
+}
+
+func randomValue() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,46 @@
+package files
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateFromTemplate(t *testing.T) {
+	root := t.TempDir()
+	srv := NewService(root, "", "", 1000, 1000, func() string { return docker.LocalClient })
+
+	templates, err := srv.ListTemplates()
+	require.NoError(t, err)
+	require.NotEmpty(t, templates)
+
+	_, err = srv.CreateFromTemplate("postgres-app", "shop", nil)
+	require.ErrorContains(t, err, "APP_IMAGE")
+
+	composeFile, err := srv.CreateFromTemplate("postgres-app", "shop", map[string]string{
+		"APP_IMAGE": "ghcr.io/acme/shop:latest",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "shop/compose.yaml", composeFile)
+
+	compose, err := os.ReadFile(filepath.Join(root, composeFile))
+	require.NoError(t, err)
+	require.Contains(t, string(compose), "image: ghcr.io/acme/shop:latest")
+	require.Contains(t, string(compose), "name: shop-db-data")
+	require.NotContains(t, string(compose), "{{")
+
+	env, err := os.ReadFile(filepath.Join(root, "shop", ".env"))
+	require.NoError(t, err)
+	require.Contains(t, string(env), "POSTGRES_DB=app")
+	require.NotContains(t, string(env), "{{")
+
+	require.NoFileExists(t, filepath.Join(root, "shop", templateMetaFile))
+
+	_, err = srv.CreateFromTemplate("postgres-app", "shop", map[string]string{"APP_IMAGE": "x"})
+	require.Error(t, err)
+	_, err = srv.CreateFromTemplate("postgres-app", "../escape", map[string]string{"APP_IMAGE": "x"})
+	require.Error(t, err)
+}
@@ -0,0 +1,324 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/RA341/dockman/internal/ssh"
+	"github.com/docker/compose/v2/pkg/api"
+	"github.com/docker/docker/api/types/container"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+const namespace = "dockman"
+
+var containerLabels = []string{"host", "id", "name", "stack", "service"}
+
+var (
+	hostUpDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "host", "up"),
+		"Whether the docker daemon of the host responded during the last scrape",
+		[]string{"host"}, nil,
+	)
+
+	containerStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "state"),
+		"Current state of the container, the value is always 1",
+		append(containerLabels, "state"), nil,
+	)
+	containerRestartsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "restart_count"),
+		"Number of times the container has been restarted by the daemon",
+		containerLabels, nil,
+	)
+	containerCPUDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "cpu_usage_percent"),
+		"CPU usage of the container in percent",
+		containerLabels, nil,
+	)
+	containerMemUsageDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "memory_usage_bytes"),
+		"Memory used by the container",
+		containerLabels, nil,
+	)
+	containerMemLimitDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "memory_limit_bytes"),
+		"Memory limit of the container",
+		containerLabels, nil,
+	)
+	containerNetRxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "network_receive_bytes_total"),
+		"Bytes received by the container over all networks",
+		containerLabels, nil,
+	)
+	containerNetTxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "network_transmit_bytes_total"),
+		"Bytes sent by the container over all networks",
+		containerLabels, nil,
+	)
+	containerBlockReadDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "block_read_bytes_total"),
+		"Bytes read from block devices by the container",
+		containerLabels, nil,
+	)
+	containerBlockWriteDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "container", "block_write_bytes_total"),
+		"Bytes written to block devices by the container",
+		containerLabels, nil,
+	)
+
+	imageUpdateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "image", "update_available"),
+		"Whether a newer version of an image used by a container is available",
+		[]string{"host", "image", "image_id"}, nil,
+	)
+
+	sshEnabledDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ssh", "machine_enabled"),
+		"Whether the ssh machine is enabled in dockman",
+		[]string{"machine", "host"}, nil,
+	)
+	sshUpDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "ssh", "connection_up"),
+		"Whether the ssh connection to the machine responded to a keepalive",
+		[]string{"machine", "host"}, nil,
+	)
+)
+
+// HostProvider returns a docker service for every connected host
+type HostProvider func() map[string]*docker.Service
+
+// collector gathers docker and ssh metrics from all hosts on every scrape
+type collector struct {
+	hosts HostProvider
+	ssh   *ssh.Service
+
+	// max time spent collecting metrics per scrape
+	timeout time.Duration
+}
+
+func newCollector(hosts HostProvider, sshSrv *ssh.Service) *collector {
+	return &collector{
+		hosts:   hosts,
+		ssh:     sshSrv,
+		timeout: 8 * time.Second,
+	}
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	descs := []*prometheus.Desc{
+		hostUpDesc,
+		containerStateDesc,
+		containerRestartsDesc,
+		containerCPUDesc,
+		containerMemUsageDesc,
+		containerMemLimitDesc,
+		containerNetRxDesc,
+		containerNetTxDesc,
+		containerBlockReadDesc,
+		containerBlockWriteDesc,
+		imageUpdateDesc,
+		sshEnabledDesc,
+		sshUpDesc,
+	}
+	for _, d := range descs {
+		ch <- d
+	}
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for host, srv := range c.hosts() {
+		wg.Go(func() {
+			c.collectHost(ctx, ch, host, srv)
+		})
+	}
+	if c.ssh != nil {
+		wg.Go(func() {
+			c.collectSSH(ch)
+		})
+	}
+	wg.Wait()
+}
+
+func (c *collector) collectHost(ctx context.Context, ch chan<- prometheus.Metric, host string, srv *docker.Service) {
+	containers, err := srv.Container.ContainersList(ctx)
+	if err != nil {
+		log.Warn().Err(err).Str("host", host).Msg("unable to list containers for metrics")
+		ch <- prometheus.MustNewConstMetric(hostUpDesc, prometheus.GaugeValue, 0, host)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(hostUpDesc, prometheus.GaugeValue, 1, host)
+
+	byID := make(map[string][]string, len(containers))
+	for _, cont := range containers {
+		lv := containerLabelValues(host, cont)
+		byID[cont.ID[:12]] = lv
+
+		ch <- prometheus.MustNewConstMetric(
+			containerStateDesc, prometheus.GaugeValue, 1, append(lv, cont.State)...,
+		)
+	}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		c.collectRestarts(ctx, ch, srv, containers, byID)
+	})
+	wg.Go(func() {
+		c.collectStats(ctx, ch, host, srv, byID)
+	})
+	wg.Go(func() {
+		c.collectImageUpdates(ch, host, srv, containers)
+	})
+	wg.Wait()
+}
+
+func (c *collector) collectRestarts(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+	srv *docker.Service,
+	containers []container.Summary,
+	byID map[string][]string,
+) {
+	var wg sync.WaitGroup
+	for _, cont := range containers {
+		wg.Go(func() {
+			inspect, err := srv.Container.ContainerInspect(ctx, cont.ID)
+			if err != nil {
+				log.Debug().Err(err).Str("container", cont.ID[:12]).Msg("unable to inspect container for metrics")
+				return
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				containerRestartsDesc, prometheus.GaugeValue,
+				float64(inspect.RestartCount), byID[cont.ID[:12]]...,
+			)
+		})
+	}
+	wg.Wait()
+}
+
+func (c *collector) collectStats(
+	ctx context.Context,
+	ch chan<- prometheus.Metric,
+	host string,
+	srv *docker.Service,
+	byID map[string][]string,
+) {
+	// default list options only return running containers
+	stats, err := srv.Container.ContainerStats(ctx, container.ListOptions{})
+	if err != nil {
+		log.Warn().Err(err).Str("host", host).Msg("unable to get container stats for metrics")
+		return
+	}
+
+	for _, st := range stats {
+		lv, ok := byID[st.ID]
+		if !ok {
+			// container started after we listed
+			continue
+		}
+
+		ch <- prometheus.MustNewConstMetric(containerCPUDesc, prometheus.GaugeValue, st.CPUUsage, lv...)
+		ch <- prometheus.MustNewConstMetric(containerMemUsageDesc, prometheus.GaugeValue, float64(st.MemoryUsage), lv...)
+		ch <- prometheus.MustNewConstMetric(containerMemLimitDesc, prometheus.GaugeValue, float64(st.MemoryLimit), lv...)
+		ch <- prometheus.MustNewConstMetric(containerNetRxDesc, prometheus.CounterValue, float64(st.NetworkRx), lv...)
+		ch <- prometheus.MustNewConstMetric(containerNetTxDesc, prometheus.CounterValue, float64(st.NetworkTx), lv...)
+		ch <- prometheus.MustNewConstMetric(containerBlockReadDesc, prometheus.CounterValue, float64(st.BlockRead), lv...)
+		ch <- prometheus.MustNewConstMetric(containerBlockWriteDesc, prometheus.CounterValue, float64(st.BlockWrite), lv...)
+	}
+}
+
+func (c *collector) collectImageUpdates(
+	ch chan<- prometheus.Metric,
+	host string,
+	srv *docker.Service,
+	containers []container.Summary,
+) {
+	// image id -> image name
+	images := make(map[string]string)
+	for _, cont := range containers {
+		if _, ok := images[cont.ImageID]; !ok {
+			images[cont.ImageID] = cont.Image
+		}
+	}
+
+	ids := make([]string, 0, len(images))
+	for id := range images {
+		ids = append(ids, id)
+	}
+
+	updates, err := srv.Container.ImageUpdatesAvailable(ids...)
+	if err != nil {
+		log.Warn().Err(err).Str("host", host).Msg("unable to get image updates for metrics")
+		return
+	}
+
+	for id, name := range images {
+		var available float64
+		if _, ok := updates[id]; ok {
+			available = 1
+		}
+		ch <- prometheus.MustNewConstMetric(imageUpdateDesc, prometheus.GaugeValue, available, host, name, id)
+	}
+}
+
+func (c *collector) collectSSH(ch chan<- prometheus.Metric) {
+	machines, err := c.ssh.ListConfig()
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to list ssh machines for metrics")
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, mach := range machines {
+		wg.Go(func() {
+			ch <- prometheus.MustNewConstMetric(
+				sshEnabledDesc, prometheus.GaugeValue, boolToFloat(mach.Enable), mach.Name, mach.Host,
+			)
+
+			var up bool
+			if mach.Enable {
+				err := c.ssh.Ping(mach.Name, c.timeout/2)
+				if err != nil {
+					log.Debug().Err(err).Str("machine", mach.Name).Msg("ssh keepalive failed")
+				}
+				up = err == nil
+			}
+
+			ch <- prometheus.MustNewConstMetric(
+				sshUpDesc, prometheus.GaugeValue, boolToFloat(up), mach.Name, mach.Host,
+			)
+		})
+	}
+	wg.Wait()
+}
+
+func containerLabelValues(host string, cont container.Summary) []string {
+	var name string
+	if len(cont.Names) > 0 {
+		name = strings.TrimPrefix(cont.Names[0], "/")
+	}
+
+	return []string{
+		host,
+		cont.ID[:12],
+		name,
+		cont.Labels[api.ProjectLabel],
+		cont.Labels[api.ServiceLabel],
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
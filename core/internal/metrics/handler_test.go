@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RA341/dockman/internal/docker"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerToken(t *testing.T) {
+	srv := NewService(func() map[string]*docker.Service { return nil }, nil)
+	hand := NewHandler(srv, "secret")
+
+	tests := []struct {
+		header string
+		status int
+	}{
+		{"", http.StatusUnauthorized},
+		{"Bearer wrong", http.StatusUnauthorized},
+		{"secret", http.StatusUnauthorized},
+		{"Bearer secret", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if tt.header != "" {
+			req.Header.Set("Authorization", tt.header)
+		}
+		rec := httptest.NewRecorder()
+		hand.ServeHTTP(rec, req)
+		require.Equal(t, tt.status, rec.Code, "header: %q", tt.header)
+	}
+}
@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"github.com/RA341/dockman/internal/ssh"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/rs/zerolog/log"
+)
+
+type Service struct {
+	registry *prometheus.Registry
+}
+
+func NewService(hosts HostProvider, sshSrv *ssh.Service) *Service {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		newCollector(hosts, sshSrv),
+		updaterRunDuration,
+		updaterRuns,
+		updaterLastRun,
+	)
+
+	log.Debug().Msg("Metrics service loaded successfully")
+	return &Service{registry: registry}
+}
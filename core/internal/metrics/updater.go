@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+var (
+	updaterRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "updater",
+			Name:      "run_duration_seconds",
+			Help:      "Time taken by the container updater to process a host",
+			Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200},
+		},
+		[]string{"host"},
+	)
+	updaterRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "updater",
+			Name:      "runs_total",
+			Help:      "Number of container updater runs per host by outcome",
+		},
+		[]string{"host", "outcome"},
+	)
+	updaterLastRun = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "updater",
+			Name:      "last_run_timestamp_seconds",
+			Help:      "Unix time of the last container updater run per host",
+		},
+		[]string{"host"},
+	)
+)
+
+// ObserveUpdaterRun records the duration and outcome of a container updater run for a host
+func ObserveUpdaterRun(host string, duration time.Duration, err error) {
+	outcome := OutcomeSuccess
+	if err != nil {
+		outcome = OutcomeFailure
+	}
+
+	updaterRunDuration.WithLabelValues(host).Observe(duration.Seconds())
+	updaterRuns.WithLabelValues(host, outcome).Inc()
+	updaterLastRun.WithLabelValues(host).SetToCurrentTime()
+}
@@ -1 +1,84 @@
 package notifications
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	v1 "github.com/RA341/dockman/generated/notifications/v1"
+)
+
+type Handler struct {
+	srv *Service
+}
+
+func NewConnectHandler(srv *Service) *Handler {
+	return &Handler{srv: srv}
+}
+
+func (h *Handler) List(context.Context, *connect.Request[v1.Empty]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	notifs, err := h.srv.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcNotifs []*v1.Notification
+	for _, n := range notifs {
+		rpcNotifs = append(rpcNotifs, ToProto(n))
+	}
+
+	return connect.NewResponse(&v1.ListNotificationsResponse{Notifications: rpcNotifs}), nil
+}
+
+func (h *Handler) Save(_ context.Context, req *connect.Request[v1.Notification]) (*connect.Response[v1.Empty], error) {
+	notif := FromProto(req.Msg)
+	if err := h.srv.Save(&notif); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) Delete(_ context.Context, req *connect.Request[v1.DeleteNotificationRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.Delete(uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func (h *Handler) Test(ctx context.Context, req *connect.Request[v1.TestNotificationRequest]) (*connect.Response[v1.Empty], error) {
+	if err := h.srv.Test(ctx, uint(req.Msg.Id)); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.Empty{}), nil
+}
+
+func ToProto(notif Notification) *v1.Notification {
+	conf := make(map[string]string, len(notif.Config))
+	for k := range notif.Config {
+		conf[k] = notif.Config.string(k)
+	}
+
+	return &v1.Notification{
+		Id:     uint64(notif.ID),
+		Name:   notif.Name,
+		Level:  string(notif.Level),
+		Config: conf,
+	}
+}
+
+func FromProto(notif *v1.Notification) Notification {
+	conf := make(Config, len(notif.Config))
+	for k, v := range notif.Config {
+		conf[k] = v
+	}
+
+	n := Notification{
+		Name:   notif.Name,
+		Level:  Level(notif.Level),
+		Config: conf,
+	}
+	n.ID = uint(notif.Id)
+	return n
+}
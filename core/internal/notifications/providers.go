@@ -0,0 +1,124 @@
+package notifications
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nikoksr/notify"
+	"github.com/nikoksr/notify/service/discord"
+	"github.com/nikoksr/notify/service/http"
+	"github.com/nikoksr/notify/service/mail"
+	"github.com/nikoksr/notify/service/slack"
+	"github.com/nikoksr/notify/service/telegram"
+)
+
+type notificationProviders string
+
+// ProviderKey is the config key used to select the provider
+const ProviderKey = "type"
+
+const (
+	TelegramProvider notificationProviders = "telegram"
+	DiscordProvider  notificationProviders = "discord"
+	SlackProvider    notificationProviders = "slack"
+	EmailProvider    notificationProviders = "email"
+	WebhookProvider  notificationProviders = "webhook"
+)
+
+type providerInit func(conf Config) (notify.Notifier, error)
+
+// list values such as receivers are stored as comma seperated strings
+var supportedNotifs = map[notificationProviders]providerInit{
+	TelegramProvider: func(conf Config) (notify.Notifier, error) {
+		token, err := conf.required("apiToken")
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := telegram.New(token)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range conf.list("receivers") {
+			chatID, err := strconv.ParseInt(r, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid telegram chat id %q: %w", r, err)
+			}
+			t.AddReceivers(chatID)
+		}
+
+		return t, nil
+	},
+	DiscordProvider: func(conf Config) (notify.Notifier, error) {
+		token, err := conf.required("botToken")
+		if err != nil {
+			return nil, err
+		}
+
+		d := discord.New()
+		if err = d.AuthenticateWithBotToken(token); err != nil {
+			return nil, err
+		}
+		d.AddReceivers(conf.list("channels")...)
+
+		return d, nil
+	},
+	SlackProvider: func(conf Config) (notify.Notifier, error) {
+		token, err := conf.required("apiToken")
+		if err != nil {
+			return nil, err
+		}
+
+		s := slack.New(token)
+		s.AddReceivers(conf.list("channels")...)
+
+		return s, nil
+	},
+	EmailProvider: func(conf Config) (notify.Notifier, error) {
+		from, err := conf.required("from")
+		if err != nil {
+			return nil, err
+		}
+		// smtp host with port, eg: smtp.gmail.com:587
+		host, err := conf.required("host")
+		if err != nil {
+			return nil, err
+		}
+
+		m := mail.New(from, host)
+		if username := conf.string("username"); username != "" {
+			smtpHost, _, _ := strings.Cut(host, ":")
+			m.AuthenticateSMTP("", username, conf.string("password"), smtpHost)
+		}
+		m.AddReceivers(conf.list("receivers")...)
+
+		return m, nil
+	},
+	WebhookProvider: func(conf Config) (notify.Notifier, error) {
+		url, err := conf.required("url")
+		if err != nil {
+			return nil, err
+		}
+
+		h := http.New()
+		h.AddReceiversURLs(url)
+
+		return h, nil
+	},
+}
+
+func loadExternalService(config Config) (notify.Notifier, error) {
+	provider := notificationProviders(config.string(ProviderKey))
+	init, ok := supportedNotifs[provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported notification provider: %q", provider)
+	}
+
+	notifier, err := init(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load %s provider: %w", provider, err)
+	}
+	return notifier, nil
+}
@@ -3,10 +3,9 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/RA341/dockman/pkg/syncmap"
 	"github.com/nikoksr/notify"
-	"github.com/nikoksr/notify/service/telegram"
 	"github.com/rs/zerolog/log"
 )
 
@@ -36,27 +35,43 @@ Available Providers
 
 var std *Service
 
+// Send queues a notification to be sent to all providers configured for its level
 func Send(mes *NotifMessage) {
+	if std == nil {
+		log.Warn().Str("subject", mes.Subject).Msg("notification service is not initialized, dropping notification")
+		return
+	}
 	std.send(mes)
 }
 
-func InitNotificationService(store Store) {
+func InitNotificationService(store Store) *Service {
 	std = newService(store)
+	go std.worker()
+	return std
 }
 
 // NotifQueueSize max 100 notifs allowed to be queued
 const NotifQueueSize = 100
 
+// max time allowed to deliver a single notification to all providers
+const sendTimeout = 30 * time.Second
+
 type NotifMessage struct {
 	level         Level
 	Subject, Body string
 }
 
+func NewMessage(level Level, subject, body string) *NotifMessage {
+	return &NotifMessage{
+		level:   level,
+		Subject: subject,
+		Body:    body,
+	}
+}
+
 type Service struct {
 	store Store
 
-	posts syncmap.Map[Level, chan NotifMessage]
-
 	notifChan chan *NotifMessage
 }
 
@@ -67,114 +82,85 @@ func newService(store Store) *Service {
 	}
 }
 
-func (srv *Service) send(notifs *NotifMessage) {
-	configs, err := srv.store.GetAllByLevel(notifs.level)
-	if err != nil {
-		log.Warn().Err(err).Msg("unable to get notif configs")
-		return
-	}
-
-	for _, config := range configs {
-		cal, ok := config.Config["sds"]
-		loadExternalService(config.Config)
+func (srv *Service) List() ([]Notification, error) {
+	return srv.store.List()
+}
 
+func (srv *Service) Save(notif *Notification) error {
+	switch notif.Level {
+	case LevelUpdate, LevelBackup, LevelAlert, LevelDrift, LevelCleanup:
+	default:
+		return fmt.Errorf("unknown notification level: %q", notif.Level)
 	}
 
-	val, ok := srv.posts.Load(notifs.level)
-	if !ok {
-		srv.posts.Load()
+	if _, err := loadExternalService(notif.Config); err != nil {
+		return fmt.Errorf("invalid notification config: %w", err)
 	}
+	return srv.store.Save(notif)
 }
 
-type notificationProviders string
-
-const (
-	TelegramProvider = "telegram"
-	DiscordProvider  = "discord"
-	SlackProvider    = "slack"
-	EmailProvider    = "email"
-	WebhookProvider  = "webhook"
-)
-
-type providerInit func(conf Config) (notify.Notifier, error)
-
-var supportedNotifs = map[notificationProviders]providerInit{
-	TelegramProvider: func(conf Config) (notify.Notifier, error) {
-		val, ok := conf["apiToken"].(string)
-		if !ok {
-			return nil, fmt.Errorf("unable to get apitoken")
-		}
-
-		t, err := telegram.New(val)
-		if err != nil {
-			return nil, err
-		}
-
-		r, ok := conf["receivers"].([]int64)
-		if ok {
-			t.AddReceivers(r...)
-		}
-
-		return t, nil
-	},
-	DiscordProvider: func(conf Config) (notify.Notifier, error) {
-
-	},
+func (srv *Service) Delete(id uint) error {
+	return srv.store.Delete(id)
 }
 
-func loadExternalService(config Config) error {
-	val, ok := config["type"].(string)
-	if !ok {
-		return nil
+// Test sends a test message using the notification config with the given id
+func (srv *Service) Test(ctx context.Context, id uint) error {
+	conf, err := srv.store.Get(id)
+	if err != nil {
+		return fmt.Errorf("unable to find notification config: %w", err)
 	}
 
-	switch val {
-	case "telegram":
-		return loadTelegram(config)
-	default:
-		return nil
+	notifier, err := loadExternalService(conf.Config)
+	if err != nil {
+		return err
 	}
-}
 
-func createNewLevelWorker(level Level) chan NotifMessage {
-	levelChan := make(chan NotifMessage, 50)
-	go notifWorker(levelChan, notifiers)
+	return notifier.Send(ctx,
+		"Dockman test notification",
+		fmt.Sprintf("If you see this, %s notifications are configured correctly", conf.Level),
+	)
 }
 
-func notifWorker(stream chan NotifMessage, srv ...notify.Notifier) {
-
-	for {
-		select {
-		case notifMsg, ok := <-stream:
-			if !ok {
-				return
-			}
-
-		}
+func (srv *Service) send(notif *NotifMessage) {
+	select {
+	case srv.notifChan <- notif:
+	default:
+		log.Warn().Str("subject", notif.Subject).Msg("notification queue is full, dropping notification")
 	}
 }
 
 // blocking function must be run a go routine
-func sendNotifs() {
-	//// Create a telegram service. Ignoring error for demo simplicity.
-	//telegramService, _ := telegram.New("your_telegram_api_token")
-	//
-	//// Passing a telegram chat id as receiver for our messages.
-	//// Basically where should our message be sent?
-	//telegramService.AddReceivers(-1234567890)
-
-	//notify.UseServices(telegramService)
+func (srv *Service) worker() {
+	for notif := range srv.notifChan {
+		srv.deliver(notif)
+	}
 }
 
-func send(subject, body string) {
-	err := notify.Send(context.Background(), subject, body)
+func (srv *Service) deliver(notif *NotifMessage) {
+	configs, err := srv.store.GetAllByLevel(notif.level)
 	if err != nil {
-		log.Error().Err(err).Msg("failed to send notification")
+		log.Warn().Err(err).Msg("unable to get notif configs")
+		return
+	}
+	if len(configs) == 0 {
+		log.Debug().Str("level", string(notif.level)).Msg("no notification providers configured for level")
+		return
 	}
-}
-func (srv *Service) loadNotifiers() {
 
-}
+	dispatcher := notify.New()
+	for _, conf := range configs {
+		notifier, err := loadExternalService(conf.Config)
+		if err != nil {
+			log.Warn().Err(err).Uint("id", conf.ID).Msg("unable to load notification provider, skipping...")
+			continue
+		}
+		dispatcher.UseServices(notifier)
+	}
 
-func loadTelegram(config Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+
+	if err = dispatcher.Send(ctx, notif.Subject, notif.Body); err != nil {
+		log.Error().Err(err).Str("subject", notif.Subject).Msg("failed to send notification")
+	}
 }
@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	"gorm.io/gorm"
 )
@@ -15,17 +17,23 @@ type Level string
 const (
 	LevelUpdate Level = "update"
 	LevelBackup Level = "backup"
+	LevelAlert  Level = "alert"
+	LevelDrift  Level = "drift"
+	// reports of scheduled prune policies
+	LevelCleanup Level = "cleanup"
 )
 
 type Store interface {
 	Save(notif *Notification) error
 	Get(id uint) (*Notification, error)
+	List() ([]Notification, error)
 	GetAllByLevel(level Level) ([]Notification, error)
 	Delete(id uint) error
 }
 
 type Notification struct {
 	gorm.Model
+	Name  string
 	Level Level `gorm:"not null"`
 	// config for the specific notifs
 	// telegram/discord/slack etc
@@ -34,7 +42,31 @@ type Notification struct {
 
 type Config map[string]interface{}
 
-func (c *Config) Value() (driver.Value, error) {
+func (c Config) string(key string) string {
+	val, _ := c[key].(string)
+	return strings.TrimSpace(val)
+}
+
+func (c Config) required(key string) (string, error) {
+	val := c.string(key)
+	if val == "" {
+		return "", fmt.Errorf("%s is required", key)
+	}
+	return val, nil
+}
+
+// list splits a comma seperated value
+func (c Config) list(key string) []string {
+	var result []string
+	for _, v := range strings.Split(c.string(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func (c Config) Value() (driver.Value, error) {
 	return json.Marshal(c)
 }
 
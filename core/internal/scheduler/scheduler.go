@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"time"
+)
+
+// Job a stored task that runs on an interval
+type Job interface {
+	// Due reports whether the job is enabled and its interval has passed since the last run
+	Due(now time.Time) bool
+}
+
+// Start calls runDue every check interval
+//
+// blocking function must be run a go routine
+func Start(check time.Duration, runDue func()) {
+	tick := time.NewTicker(check)
+	defer tick.Stop()
+
+	for range tick.C {
+		runDue()
+	}
+}
+
+// RunDue runs the due jobs one at a time. save must store now as the last run of the job,
+// it is called before running so a failing job is retried on the next interval, not every check.
+// Jobs that could not be saved are skipped
+func RunDue[J any, P interface {
+	*J
+	Job
+}](jobs []J, save func(job P, now time.Time) error, run func(job P)) {
+	now := time.Now()
+	for i := range jobs {
+		job := P(&jobs[i])
+		if !job.Due(now) {
+			continue
+		}
+		if err := save(job, now); err != nil {
+			continue
+		}
+		run(job)
+	}
+}
+
+// Due reports whether an enabled job last run at lastRun is due again at now
+func Due(enable bool, lastRun time.Time, interval time.Duration, now time.Time) bool {
+	return enable && !now.Before(lastRun.Add(interval))
+}
@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testJob struct {
+	name    string
+	enable  bool
+	lastRun time.Time
+}
+
+func (j *testJob) Due(now time.Time) bool {
+	return Due(j.enable, j.lastRun, time.Hour, now)
+}
+
+func TestRunDue(t *testing.T) {
+	now := time.Now()
+	jobs := []testJob{
+		{name: "due", enable: true, lastRun: now.Add(-2 * time.Hour)},
+		{name: "never-run", enable: true},
+		{name: "recent", enable: true, lastRun: now.Add(-time.Minute)},
+		{name: "disabled", lastRun: now.Add(-2 * time.Hour)},
+		{name: "unsaved", enable: true},
+	}
+
+	var ran []string
+	RunDue(jobs,
+		func(job *testJob, now time.Time) error {
+			if job.name == "unsaved" {
+				return errors.New("db is down")
+			}
+			job.lastRun = now
+			return nil
+		},
+		func(job *testJob) {
+			ran = append(ran, job.name)
+		},
+	)
+
+	require.Equal(t, []string{"due", "never-run"}, ran)
+	require.False(t, jobs[0].Due(time.Now()))
+}
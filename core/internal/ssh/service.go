@@ -62,6 +62,45 @@ func (m *Service) ListConfig() ([]MachineOptions, error) {
 	return m.machines.List()
 }
 
+// Ping checks if the connection to the named machine is still alive
+// by sending a keepalive request, unresponsive connections fail after timeout
+func (m *Service) Ping(name string, timeout time.Duration) error {
+	conn, ok := m.connectedClients.Load(name)
+	if !ok {
+		return fmt.Errorf("machine %s is not connected", name)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		// servers reply with failure for unknown requests,
+		// we only care that a reply was received
+		_, _, err := conn.SshClient.SendRequest("keepalive@openssh.com", true, nil)
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("keepalive timed out after %s", timeout)
+	}
+}
+
+// Reconnect closes the current connection to a machine and connects again
+func (m *Service) Reconnect(name string) error {
+	machine, err := m.machines.Get(name)
+	if err != nil {
+		return fmt.Errorf("unable to find machine %s: %w", name, err)
+	}
+
+	if conn, ok := m.connectedClients.Load(name); ok {
+		fileutil.Close(conn)
+		m.connectedClients.Delete(name)
+	}
+
+	return m.LoadClient(&machine, false)
+}
+
 func (m *Service) EnableClient(machine *MachineOptions) error {
 	log.Info().Str("client", machine.Name).Msg("Enabling client")
 
@@ -1,6 +1,7 @@
 package ssh
 
 import (
+	"errors"
 	"fmt"
 	"github.com/RA341/dockman/pkg/fileutil"
 	"github.com/pkg/sftp"
@@ -27,6 +28,18 @@ func NewSFTPFromSSH(cli *ssh.Client) (*SftpClient, error) {
 	return &SftpClient{sfCli: sftpClient}, nil
 }
 
+// Exists checks if a file or directory exists on the remote host
+func (cli *SftpClient) Exists(path string) (bool, error) {
+	_, err := cli.sfCli.Stat(path)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
+
 // CopyLocalToRemoteSFTP Helper function to recursively copy local files/directories via SFTP.
 func (cli *SftpClient) CopyLocalToRemoteSFTP(localPath string, remotePath string) error {
 	localStat, err := os.Stat(localPath)
@@ -101,3 +114,36 @@ func (cli *SftpClient) CopyFileSFTP(localFile, remoteFile string) error {
 
 	return nil
 }
+
+// Create opens a remote file for writing, creating missing parent directories
+func (cli *SftpClient) Create(remoteFile string) (io.WriteCloser, error) {
+	remoteDir := filepath.Dir(remoteFile)
+	if err := cli.sfCli.MkdirAll(remoteDir); err != nil {
+		return nil, fmt.Errorf("failed to create remote directory %s: %w", remoteDir, err)
+	}
+
+	file, err := cli.sfCli.Create(remoteFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote file %s: %w", remoteFile, err)
+	}
+	return file, nil
+}
+
+// Open opens a remote file for reading
+func (cli *SftpClient) Open(remoteFile string) (io.ReadCloser, error) {
+	file, err := cli.sfCli.Open(remoteFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open remote file %s: %w", remoteFile, err)
+	}
+	return file, nil
+}
+
+// ReadDir lists the entries of a remote directory
+func (cli *SftpClient) ReadDir(remoteDir string) ([]os.FileInfo, error) {
+	return cli.sfCli.ReadDir(remoteDir)
+}
+
+// Remove deletes a remote file or empty directory
+func (cli *SftpClient) Remove(remoteFile string) error {
+	return cli.sfCli.Remove(remoteFile)
+}
@@ -0,0 +1,280 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file alerts/v1/alerts.proto (package alerts.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file alerts/v1/alerts.proto.
+ */
+export const file_alerts_v1_alerts: GenFile = /*@__PURE__*/
+  fileDesc("ChZhbGVydHMvdjEvYWxlcnRzLnByb3RvEglhbGVydHMudjEiMwoRTGlzdFJ1bGVzUmVzcG9uc2USHgoFcnVsZXMYASADKAsyDy5hbGVydHMudjEuUnVsZSKhAQoEUnVsZRIKCgJpZBgBIAEoBBIMCgRuYW1lGAIgASgJEg4KBmVuYWJsZRgDIAEoCBIMCgR0eXBlGAQgASgJEgwKBGhvc3QYBSABKAkSDgoGdGFyZ2V0GAYgASgJEhEKCXRocmVzaG9sZBgHIAEoARIZChFkdXJhdGlvbkluU2Vjb25kcxgIIAEoAxIVCg1zaWxlbmNlZFVudGlsGAkgASgJIh8KEURlbGV0ZVJ1bGVSZXF1ZXN0EgoKAmlkGAEgASgEIjsKElNpbGVuY2VSdWxlUmVxdWVzdBIKCgJpZBgBIAEoBBIZChFkdXJhdGlvbkluU2Vjb25kcxgCIAEoAyI2ChJMaXN0QWxlcnRzUmVzcG9uc2USIAoGYWxlcnRzGAEgAygLMhAuYWxlcnRzLnYxLkFsZXJ0IpcBCgVBbGVydBIOCgZydWxlSWQYASABKAQSEAoIcnVsZU5hbWUYAiABKAkSDAoEaG9zdBgDIAEoCRIOCgZ0YXJnZXQYBCABKAkSDQoFc3RhdGUYBSABKAkSDQoFdmFsdWUYBiABKAESDQoFc2luY2UYByABKAkSDwoHZmlyZWRBdBgIIAEoCRIQCghzaWxlbmNlZBgJIAEoCCIHCgVFbXB0eTLHAgoMQWxlcnRTZXJ2aWNlEkAKCUxpc3RSdWxlcxIQLmFsZXJ0cy52MS5FbXB0eRocLmFsZXJ0cy52MS5MaXN0UnVsZXNSZXNwb25zZSIDkAIBEi8KCFNhdmVSdWxlEg8uYWxlcnRzLnYxLlJ1bGUaEC5hbGVydHMudjEuRW1wdHkiABI+CgpEZWxldGVSdWxlEhwuYWxlcnRzLnYxLkRlbGV0ZVJ1bGVSZXF1ZXN0GhAuYWxlcnRzLnYxLkVtcHR5IgASQAoLU2lsZW5jZVJ1bGUSHS5hbGVydHMudjEuU2lsZW5jZVJ1bGVSZXF1ZXN0GhAuYWxlcnRzLnYxLkVtcHR5IgASQgoKTGlzdEFsZXJ0cxIQLmFsZXJ0cy52MS5FbXB0eRodLmFsZXJ0cy52MS5MaXN0QWxlcnRzUmVzcG9uc2UiA5ACAUKPAQoNY29tLmFsZXJ0cy52MUILQWxlcnRzUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9hbGVydHMvdjGiAgNBWFiqAglBbGVydHMuVjHKAglBbGVydHNcVjHiAhVBbGVydHNcVjFcR1BCTWV0YWRhdGHqAgpBbGVydHM6OlYxYgZwcm90bzM");
+
+/**
+ * @generated from message alerts.v1.ListRulesResponse
+ */
+export type ListRulesResponse = Message<"alerts.v1.ListRulesResponse"> & {
+  /**
+   * @generated from field: repeated alerts.v1.Rule rules = 1;
+   */
+  rules: Rule[];
+};
+
+/**
+ * Describes the message alerts.v1.ListRulesResponse.
+ * Use `create(ListRulesResponseSchema)` to create a new message.
+ */
+export const ListRulesResponseSchema: GenMessage<ListRulesResponse> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 0);
+
+/**
+ * @generated from message alerts.v1.Rule
+ */
+export type Rule = Message<"alerts.v1.Rule"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool enable = 3;
+   */
+  enable: boolean;
+
+  /**
+   * memory_percent|restarts|unhealthy|volume_size
+   *
+   * @generated from field: string type = 4;
+   */
+  type: string;
+
+  /**
+   * empty to evaluate on all hosts
+   *
+   * @generated from field: string host = 5;
+   */
+  host: string;
+
+  /**
+   * container or volume name, supports globs, empty matches all
+   *
+   * @generated from field: string target = 6;
+   */
+  target: string;
+
+  /**
+   * memory_percent: percent of the memory limit
+   * restarts: number of restarts within the duration
+   * volume_size: size in GB
+   *
+   * @generated from field: double threshold = 7;
+   */
+  threshold: number;
+
+  /**
+   * how long the condition must hold before firing,
+   * for restart rules this is the window restarts are counted in
+   *
+   * @generated from field: int64 durationInSeconds = 8;
+   */
+  durationInSeconds: bigint;
+
+  /**
+   * @generated from field: string silencedUntil = 9;
+   */
+  silencedUntil: string;
+};
+
+/**
+ * Describes the message alerts.v1.Rule.
+ * Use `create(RuleSchema)` to create a new message.
+ */
+export const RuleSchema: GenMessage<Rule> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 1);
+
+/**
+ * @generated from message alerts.v1.DeleteRuleRequest
+ */
+export type DeleteRuleRequest = Message<"alerts.v1.DeleteRuleRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message alerts.v1.DeleteRuleRequest.
+ * Use `create(DeleteRuleRequestSchema)` to create a new message.
+ */
+export const DeleteRuleRequestSchema: GenMessage<DeleteRuleRequest> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 2);
+
+/**
+ * @generated from message alerts.v1.SilenceRuleRequest
+ */
+export type SilenceRuleRequest = Message<"alerts.v1.SilenceRuleRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 durationInSeconds = 2;
+   */
+  durationInSeconds: bigint;
+};
+
+/**
+ * Describes the message alerts.v1.SilenceRuleRequest.
+ * Use `create(SilenceRuleRequestSchema)` to create a new message.
+ */
+export const SilenceRuleRequestSchema: GenMessage<SilenceRuleRequest> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 3);
+
+/**
+ * @generated from message alerts.v1.ListAlertsResponse
+ */
+export type ListAlertsResponse = Message<"alerts.v1.ListAlertsResponse"> & {
+  /**
+   * @generated from field: repeated alerts.v1.Alert alerts = 1;
+   */
+  alerts: Alert[];
+};
+
+/**
+ * Describes the message alerts.v1.ListAlertsResponse.
+ * Use `create(ListAlertsResponseSchema)` to create a new message.
+ */
+export const ListAlertsResponseSchema: GenMessage<ListAlertsResponse> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 4);
+
+/**
+ * @generated from message alerts.v1.Alert
+ */
+export type Alert = Message<"alerts.v1.Alert"> & {
+  /**
+   * @generated from field: uint64 ruleId = 1;
+   */
+  ruleId: bigint;
+
+  /**
+   * @generated from field: string ruleName = 2;
+   */
+  ruleName: string;
+
+  /**
+   * @generated from field: string host = 3;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string target = 4;
+   */
+  target: string;
+
+  /**
+   * pending|firing
+   *
+   * @generated from field: string state = 5;
+   */
+  state: string;
+
+  /**
+   * @generated from field: double value = 6;
+   */
+  value: number;
+
+  /**
+   * @generated from field: string since = 7;
+   */
+  since: string;
+
+  /**
+   * @generated from field: string firedAt = 8;
+   */
+  firedAt: string;
+
+  /**
+   * @generated from field: bool silenced = 9;
+   */
+  silenced: boolean;
+};
+
+/**
+ * Describes the message alerts.v1.Alert.
+ * Use `create(AlertSchema)` to create a new message.
+ */
+export const AlertSchema: GenMessage<Alert> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 5);
+
+/**
+ * @generated from message alerts.v1.Empty
+ */
+export type Empty = Message<"alerts.v1.Empty"> & {
+};
+
+/**
+ * Describes the message alerts.v1.Empty.
+ * Use `create(EmptySchema)` to create a new message.
+ */
+export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
+  messageDesc(file_alerts_v1_alerts, 6);
+
+/**
+ * @generated from service alerts.v1.AlertService
+ */
+export const AlertService: GenService<{
+  /**
+   * @generated from rpc alerts.v1.AlertService.ListRules
+   */
+  listRules: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListRulesResponseSchema;
+  },
+  /**
+   * @generated from rpc alerts.v1.AlertService.SaveRule
+   */
+  saveRule: {
+    methodKind: "unary";
+    input: typeof RuleSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc alerts.v1.AlertService.DeleteRule
+   */
+  deleteRule: {
+    methodKind: "unary";
+    input: typeof DeleteRuleRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * mutes notifications for a rule, set durationInSeconds to 0 to unsilence
+   *
+   * @generated from rpc alerts.v1.AlertService.SilenceRule
+   */
+  silenceRule: {
+    methodKind: "unary";
+    input: typeof SilenceRuleRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * currently pending or firing alerts
+   *
+   * @generated from rpc alerts.v1.AlertService.ListAlerts
+   */
+  listAlerts: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListAlertsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_alerts_v1_alerts, 0);
+
@@ -0,0 +1,174 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file audit/v1/audit.proto (package audit.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file audit/v1/audit.proto.
+ */
+export const file_audit_v1_audit: GenFile = /*@__PURE__*/
+  fileDesc("ChRhdWRpdC92MS9hdWRpdC5wcm90bxIIYXVkaXQudjEiigEKDFF1ZXJ5UmVxdWVzdBIMCgR1c2VyGAEgASgJEgwKBGhvc3QYAiABKAkSEQoJcHJvY2VkdXJlGAMgASgJEhIKCmZhaWxlZE9ubHkYBCABKAgSDAoEZnJvbRgFIAEoCRIKCgJ0bxgGIAEoCRINCgVsaW1pdBgHIAEoBRIOCgZvZmZzZXQYCCABKAUiQAoNUXVlcnlSZXNwb25zZRIgCgdlbnRyaWVzGAEgAygLMg8uYXVkaXQudjEuRW50cnkSDQoFdG90YWwYAiABKAMikQEKBUVudHJ5EgoKAmlkGAEgASgEEgwKBHRpbWUYAiABKAkSDAoEdXNlchgDIAEoCRIMCgRob3N0GAQgASgJEhEKCXByb2NlZHVyZRgFIAEoCRIMCgRhcmdzGAYgASgJEg4KBnJlc3VsdBgHIAEoCRINCgVlcnJvchgIIAEoCRISCgpkdXJhdGlvbk1zGAkgASgDMk0KDEF1ZGl0U2VydmljZRI9CgVRdWVyeRIWLmF1ZGl0LnYxLlF1ZXJ5UmVxdWVzdBoXLmF1ZGl0LnYxLlF1ZXJ5UmVzcG9uc2UiA5ACAUKIAQoMY29tLmF1ZGl0LnYxQgpBdWRpdFByb3RvUAFaK2dpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvYXVkaXQvdjGiAgNBWFiqAghBdWRpdC5WMcoCCEF1ZGl0XFYx4gIUQXVkaXRcVjFcR1BCTWV0YWRhdGHqAglBdWRpdDo6VjFiBnByb3RvMw");
+
+/**
+ * empty fields are ignored
+ *
+ * @generated from message audit.v1.QueryRequest
+ */
+export type QueryRequest = Message<"audit.v1.QueryRequest"> & {
+  /**
+   * @generated from field: string user = 1;
+   */
+  user: string;
+
+  /**
+   * @generated from field: string host = 2;
+   */
+  host: string;
+
+  /**
+   * matches any procedure containing this value
+   *
+   * @generated from field: string procedure = 3;
+   */
+  procedure: string;
+
+  /**
+   * @generated from field: bool failedOnly = 4;
+   */
+  failedOnly: boolean;
+
+  /**
+   * RFC3339 timestamps
+   *
+   * @generated from field: string from = 5;
+   */
+  from: string;
+
+  /**
+   * @generated from field: string to = 6;
+   */
+  to: string;
+
+  /**
+   * defaults to 100
+   *
+   * @generated from field: int32 limit = 7;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 8;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message audit.v1.QueryRequest.
+ * Use `create(QueryRequestSchema)` to create a new message.
+ */
+export const QueryRequestSchema: GenMessage<QueryRequest> = /*@__PURE__*/
+  messageDesc(file_audit_v1_audit, 0);
+
+/**
+ * @generated from message audit.v1.QueryResponse
+ */
+export type QueryResponse = Message<"audit.v1.QueryResponse"> & {
+  /**
+   * @generated from field: repeated audit.v1.Entry entries = 1;
+   */
+  entries: Entry[];
+
+  /**
+   * total entries matching the filter ignoring limit and offset
+   *
+   * @generated from field: int64 total = 2;
+   */
+  total: bigint;
+};
+
+/**
+ * Describes the message audit.v1.QueryResponse.
+ * Use `create(QueryResponseSchema)` to create a new message.
+ */
+export const QueryResponseSchema: GenMessage<QueryResponse> = /*@__PURE__*/
+  messageDesc(file_audit_v1_audit, 1);
+
+/**
+ * @generated from message audit.v1.Entry
+ */
+export type Entry = Message<"audit.v1.Entry"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string time = 2;
+   */
+  time: string;
+
+  /**
+   * @generated from field: string user = 3;
+   */
+  user: string;
+
+  /**
+   * @generated from field: string host = 4;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string procedure = 5;
+   */
+  procedure: string;
+
+  /**
+   * json encoded request with secrets redacted
+   *
+   * @generated from field: string args = 6;
+   */
+  args: string;
+
+  /**
+   * ok or the rpc error code
+   *
+   * @generated from field: string result = 7;
+   */
+  result: string;
+
+  /**
+   * @generated from field: string error = 8;
+   */
+  error: string;
+
+  /**
+   * @generated from field: int64 durationMs = 9;
+   */
+  durationMs: bigint;
+};
+
+/**
+ * Describes the message audit.v1.Entry.
+ * Use `create(EntrySchema)` to create a new message.
+ */
+export const EntrySchema: GenMessage<Entry> = /*@__PURE__*/
+  messageDesc(file_audit_v1_audit, 2);
+
+/**
+ * @generated from service audit.v1.AuditService
+ */
+export const AuditService: GenService<{
+  /**
+   * @generated from rpc audit.v1.AuditService.Query
+   */
+  query: {
+    methodKind: "unary";
+    input: typeof QueryRequestSchema;
+    output: typeof QueryResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_audit_v1_audit, 0);
+
@@ -0,0 +1,612 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file backup/v1/backup.proto (package backup.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file backup/v1/backup.proto.
+ */
+export const file_backup_v1_backup: GenFile = /*@__PURE__*/
+  fileDesc("ChZiYWNrdXAvdjEvYmFja3VwLnByb3RvEgliYWNrdXAudjEiBwoFRW1wdHkiKAoITG9jYXRpb24SDwoHbWFjaGluZRgBIAEoCRILCgNkaXIYAiABKAkibQoHQXJjaGl2ZRIMCgRuYW1lGAEgASgJEg4KBnZvbHVtZRgCIAEoCRIMCgRzaXplGAMgASgDEg8KB2NyZWF0ZWQYBCABKAkSJQoIbG9jYXRpb24YBSABKAsyEy5iYWNrdXAudjEuTG9jYXRpb24iWgoTVm9sdW1lQmFja3VwUmVxdWVzdBIMCgRob3N0GAEgASgJEg4KBnZvbHVtZRgCIAEoCRIlCghsb2NhdGlvbhgDIAEoCzITLmJhY2t1cC52MS5Mb2NhdGlvbiKTAQoUVm9sdW1lUmVzdG9yZVJlcXVlc3QSDAoEaG9zdBgBIAEoCRIlCghsb2NhdGlvbhgCIAEoCzITLmJhY2t1cC52MS5Mb2NhdGlvbhIPCgdhcmNoaXZlGAMgASgJEg4KBnZvbHVtZRgEIAEoCRIWCg5zdG9wQ29udGFpbmVycxgFIAEoCBINCgVjbGVhchgGIAEoCCI8ChRMaXN0QXJjaGl2ZXNSZXNwb25zZRIkCghhcmNoaXZlcxgBIAMoCzISLmJhY2t1cC52MS5BcmNoaXZlIksKFERlbGV0ZUFyY2hpdmVSZXF1ZXN0EiUKCGxvY2F0aW9uGAEgASgLMhMuYmFja3VwLnYxLkxvY2F0aW9uEgwKBG5hbWUYAiABKAki5wEKCFNjaGVkdWxlEgoKAmlkGAEgASgEEg4KBmVuYWJsZRgCIAEoCBINCgVzdGFjaxgDIAEoCRIMCgRob3N0GAQgASgJEiUKCGxvY2F0aW9uGAUgASgLMhMuYmFja3VwLnYxLkxvY2F0aW9uEhkKEWludGVydmFsSW5TZWNvbmRzGAYgASgDEhYKDnN0b3BDb250YWluZXJzGAcgASgIEhAKCGtlZXBMYXN0GAggASgFEhEKCWtlZXBEYWlseRgJIAEoBRISCgprZWVwV2Vla2x5GAogASgFEg8KB2xhc3RSdW4YCyABKAkiPwoVTGlzdFNjaGVkdWxlc1Jlc3BvbnNlEiYKCXNjaGVkdWxlcxgBIAMoCzITLmJhY2t1cC52MS5TY2hlZHVsZSIjChVEZWxldGVTY2hlZHVsZVJlcXVlc3QSCgoCaWQYASABKAQi2QEKDEJhY2t1cFJlY29yZBIKCgJpZBgBIAEoBBISCgpzY2hlZHVsZUlkGAIgASgEEg0KBXN0YWNrGAMgASgJEgwKBGhvc3QYBCABKAkSJQoIbG9jYXRpb24YBSABKAsyEy5iYWNrdXAudjEuTG9jYXRpb24SDwoHYXJjaGl2ZRgGIAEoCRIMCgRzaXplGAcgASgDEg4KBnN0YXR1cxgIIAEoCRINCgVlcnJvchgJIAEoCRIRCglzdGFydGVkQXQYCiABKAkSFAoMZHVyYXRpb25Jbk1zGAsgASgDInAKElN0YWNrQmFja3VwUmVxdWVzdBINCgVzdGFjaxgBIAEoCRIMCgRob3N0GAIgASgJEiUKCGxvY2F0aW9uGAMgASgLMhMuYmFja3VwLnYxLkxvY2F0aW9uEhYKDnN0b3BDb250YWluZXJzGAQgASgIIigKF0xpc3RTdGFja0JhY2t1cHNSZXF1ZXN0Eg0KBXN0YWNrGAEgASgJIkQKGExpc3RTdGFja0JhY2t1cHNSZXNwb25zZRIoCgdyZWNvcmRzGAEgAygLMhcuYmFja3VwLnYxLkJhY2t1cFJlY29yZCJIChNTdGFja1Jlc3RvcmVSZXF1ZXN0EgoKAmlkGAEgASgEEhYKDnN0b3BDb250YWluZXJzGAIgASgIEg0KBWNsZWFyGAMgASgIIiYKGERlbGV0ZVN0YWNrQmFja3VwUmVxdWVzdBIKCgJpZBgBIAEoBDK0BgoNQmFja3VwU2VydmljZRJECgxWb2x1bWVCYWNrdXASHi5iYWNrdXAudjEuVm9sdW1lQmFja3VwUmVxdWVzdBoSLmJhY2t1cC52MS5BcmNoaXZlIgASRAoNVm9sdW1lUmVzdG9yZRIfLmJhY2t1cC52MS5Wb2x1bWVSZXN0b3JlUmVxdWVzdBoQLmJhY2t1cC52MS5FbXB0eSIAEkkKDExpc3RBcmNoaXZlcxITLmJhY2t1cC52MS5Mb2NhdGlvbhofLmJhY2t1cC52MS5MaXN0QXJjaGl2ZXNSZXNwb25zZSIDkAIBEkQKDURlbGV0ZUFyY2hpdmUSHy5iYWNrdXAudjEuRGVsZXRlQXJjaGl2ZVJlcXVlc3QaEC5iYWNrdXAudjEuRW1wdHkiABJICg1MaXN0U2NoZWR1bGVzEhAuYmFja3VwLnYxLkVtcHR5GiAuYmFja3VwLnYxLkxpc3RTY2hlZHVsZXNSZXNwb25zZSIDkAIBEjcKDFNhdmVTY2hlZHVsZRITLmJhY2t1cC52MS5TY2hlZHVsZRoQLmJhY2t1cC52MS5FbXB0eSIAEkYKDkRlbGV0ZVNjaGVkdWxlEiAuYmFja3VwLnYxLkRlbGV0ZVNjaGVkdWxlUmVxdWVzdBoQLmJhY2t1cC52MS5FbXB0eSIAEkcKC1N0YWNrQmFja3VwEh0uYmFja3VwLnYxLlN0YWNrQmFja3VwUmVxdWVzdBoXLmJhY2t1cC52MS5CYWNrdXBSZWNvcmQiABJgChBMaXN0U3RhY2tCYWNrdXBzEiIuYmFja3VwLnYxLkxpc3RTdGFja0JhY2t1cHNSZXF1ZXN0GiMuYmFja3VwLnYxLkxpc3RTdGFja0JhY2t1cHNSZXNwb25zZSIDkAIBEkIKDFN0YWNrUmVzdG9yZRIeLmJhY2t1cC52MS5TdGFja1Jlc3RvcmVSZXF1ZXN0GhAuYmFja3VwLnYxLkVtcHR5IgASTAoRRGVsZXRlU3RhY2tCYWNrdXASIy5iYWNrdXAudjEuRGVsZXRlU3RhY2tCYWNrdXBSZXF1ZXN0GhAuYmFja3VwLnYxLkVtcHR5IgBCjwEKDWNvbS5iYWNrdXAudjFCC0JhY2t1cFByb3RvUAFaLGdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvYmFja3VwL3YxogIDQlhYqgIJQmFja3VwLlYxygIJQmFja3VwXFYx4gIVQmFja3VwXFYxXEdQQk1ldGFkYXRh6gIKQmFja3VwOjpWMWIGcHJvdG8z");
+
+/**
+ * @generated from message backup.v1.Empty
+ */
+export type Empty = Message<"backup.v1.Empty"> & {
+};
+
+/**
+ * Describes the message backup.v1.Empty.
+ * Use `create(EmptySchema)` to create a new message.
+ */
+export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 0);
+
+/**
+ * an empty machine is the local backup directory,
+ * otherwise dir on a connected ssh machine
+ *
+ * @generated from message backup.v1.Location
+ */
+export type Location = Message<"backup.v1.Location"> & {
+  /**
+   * @generated from field: string machine = 1;
+   */
+  machine: string;
+
+  /**
+   * defaults to dockman-backups in the ssh user's home
+   *
+   * @generated from field: string dir = 2;
+   */
+  dir: string;
+};
+
+/**
+ * Describes the message backup.v1.Location.
+ * Use `create(LocationSchema)` to create a new message.
+ */
+export const LocationSchema: GenMessage<Location> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 1);
+
+/**
+ * @generated from message backup.v1.Archive
+ */
+export type Archive = Message<"backup.v1.Archive"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string volume = 2;
+   */
+  volume: string;
+
+  /**
+   * @generated from field: int64 size = 3;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: string created = 4;
+   */
+  created: string;
+
+  /**
+   * @generated from field: backup.v1.Location location = 5;
+   */
+  location?: Location;
+};
+
+/**
+ * Describes the message backup.v1.Archive.
+ * Use `create(ArchiveSchema)` to create a new message.
+ */
+export const ArchiveSchema: GenMessage<Archive> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 2);
+
+/**
+ * @generated from message backup.v1.VolumeBackupRequest
+ */
+export type VolumeBackupRequest = Message<"backup.v1.VolumeBackupRequest"> & {
+  /**
+   * docker host the volume lives on, empty for the active host
+   *
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string volume = 2;
+   */
+  volume: string;
+
+  /**
+   * @generated from field: backup.v1.Location location = 3;
+   */
+  location?: Location;
+};
+
+/**
+ * Describes the message backup.v1.VolumeBackupRequest.
+ * Use `create(VolumeBackupRequestSchema)` to create a new message.
+ */
+export const VolumeBackupRequestSchema: GenMessage<VolumeBackupRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 3);
+
+/**
+ * @generated from message backup.v1.VolumeRestoreRequest
+ */
+export type VolumeRestoreRequest = Message<"backup.v1.VolumeRestoreRequest"> & {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from field: backup.v1.Location location = 2;
+   */
+  location?: Location;
+
+  /**
+   * @generated from field: string archive = 3;
+   */
+  archive: string;
+
+  /**
+   * defaults to the volume the archive was taken from
+   *
+   * @generated from field: string volume = 4;
+   */
+  volume: string;
+
+  /**
+   * stop containers using the volume and start them again after the restore
+   *
+   * @generated from field: bool stopContainers = 5;
+   */
+  stopContainers: boolean;
+
+  /**
+   * remove existing volume contents before extracting
+   *
+   * @generated from field: bool clear = 6;
+   */
+  clear: boolean;
+};
+
+/**
+ * Describes the message backup.v1.VolumeRestoreRequest.
+ * Use `create(VolumeRestoreRequestSchema)` to create a new message.
+ */
+export const VolumeRestoreRequestSchema: GenMessage<VolumeRestoreRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 4);
+
+/**
+ * @generated from message backup.v1.ListArchivesResponse
+ */
+export type ListArchivesResponse = Message<"backup.v1.ListArchivesResponse"> & {
+  /**
+   * @generated from field: repeated backup.v1.Archive archives = 1;
+   */
+  archives: Archive[];
+};
+
+/**
+ * Describes the message backup.v1.ListArchivesResponse.
+ * Use `create(ListArchivesResponseSchema)` to create a new message.
+ */
+export const ListArchivesResponseSchema: GenMessage<ListArchivesResponse> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 5);
+
+/**
+ * @generated from message backup.v1.DeleteArchiveRequest
+ */
+export type DeleteArchiveRequest = Message<"backup.v1.DeleteArchiveRequest"> & {
+  /**
+   * @generated from field: backup.v1.Location location = 1;
+   */
+  location?: Location;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message backup.v1.DeleteArchiveRequest.
+ * Use `create(DeleteArchiveRequestSchema)` to create a new message.
+ */
+export const DeleteArchiveRequestSchema: GenMessage<DeleteArchiveRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 6);
+
+/**
+ * @generated from message backup.v1.Schedule
+ */
+export type Schedule = Message<"backup.v1.Schedule"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: bool enable = 2;
+   */
+  enable: boolean;
+
+  /**
+   * compose file relative to the compose root
+   *
+   * @generated from field: string stack = 3;
+   */
+  stack: string;
+
+  /**
+   * empty for the active host, resolved to its name when saved
+   *
+   * @generated from field: string host = 4;
+   */
+  host: string;
+
+  /**
+   * @generated from field: backup.v1.Location location = 5;
+   */
+  location?: Location;
+
+  /**
+   * @generated from field: int64 intervalInSeconds = 6;
+   */
+  intervalInSeconds: bigint;
+
+  /**
+   * @generated from field: bool stopContainers = 7;
+   */
+  stopContainers: boolean;
+
+  /**
+   * retention, archives matching any rule are kept, all 0 keeps everything
+   *
+   * @generated from field: int32 keepLast = 8;
+   */
+  keepLast: number;
+
+  /**
+   * @generated from field: int32 keepDaily = 9;
+   */
+  keepDaily: number;
+
+  /**
+   * @generated from field: int32 keepWeekly = 10;
+   */
+  keepWeekly: number;
+
+  /**
+   * @generated from field: string lastRun = 11;
+   */
+  lastRun: string;
+};
+
+/**
+ * Describes the message backup.v1.Schedule.
+ * Use `create(ScheduleSchema)` to create a new message.
+ */
+export const ScheduleSchema: GenMessage<Schedule> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 7);
+
+/**
+ * @generated from message backup.v1.ListSchedulesResponse
+ */
+export type ListSchedulesResponse = Message<"backup.v1.ListSchedulesResponse"> & {
+  /**
+   * @generated from field: repeated backup.v1.Schedule schedules = 1;
+   */
+  schedules: Schedule[];
+};
+
+/**
+ * Describes the message backup.v1.ListSchedulesResponse.
+ * Use `create(ListSchedulesResponseSchema)` to create a new message.
+ */
+export const ListSchedulesResponseSchema: GenMessage<ListSchedulesResponse> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 8);
+
+/**
+ * @generated from message backup.v1.DeleteScheduleRequest
+ */
+export type DeleteScheduleRequest = Message<"backup.v1.DeleteScheduleRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message backup.v1.DeleteScheduleRequest.
+ * Use `create(DeleteScheduleRequestSchema)` to create a new message.
+ */
+export const DeleteScheduleRequestSchema: GenMessage<DeleteScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 9);
+
+/**
+ * @generated from message backup.v1.BackupRecord
+ */
+export type BackupRecord = Message<"backup.v1.BackupRecord"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * 0 for manual backups
+   *
+   * @generated from field: uint64 scheduleId = 2;
+   */
+  scheduleId: bigint;
+
+  /**
+   * @generated from field: string stack = 3;
+   */
+  stack: string;
+
+  /**
+   * @generated from field: string host = 4;
+   */
+  host: string;
+
+  /**
+   * @generated from field: backup.v1.Location location = 5;
+   */
+  location?: Location;
+
+  /**
+   * relative to the location dir
+   *
+   * @generated from field: string archive = 6;
+   */
+  archive: string;
+
+  /**
+   * @generated from field: int64 size = 7;
+   */
+  size: bigint;
+
+  /**
+   * success|failed
+   *
+   * @generated from field: string status = 8;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string error = 9;
+   */
+  error: string;
+
+  /**
+   * @generated from field: string startedAt = 10;
+   */
+  startedAt: string;
+
+  /**
+   * @generated from field: int64 durationInMs = 11;
+   */
+  durationInMs: bigint;
+};
+
+/**
+ * Describes the message backup.v1.BackupRecord.
+ * Use `create(BackupRecordSchema)` to create a new message.
+ */
+export const BackupRecordSchema: GenMessage<BackupRecord> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 10);
+
+/**
+ * @generated from message backup.v1.StackBackupRequest
+ */
+export type StackBackupRequest = Message<"backup.v1.StackBackupRequest"> & {
+  /**
+   * @generated from field: string stack = 1;
+   */
+  stack: string;
+
+  /**
+   * empty for the active host
+   *
+   * @generated from field: string host = 2;
+   */
+  host: string;
+
+  /**
+   * @generated from field: backup.v1.Location location = 3;
+   */
+  location?: Location;
+
+  /**
+   * @generated from field: bool stopContainers = 4;
+   */
+  stopContainers: boolean;
+};
+
+/**
+ * Describes the message backup.v1.StackBackupRequest.
+ * Use `create(StackBackupRequestSchema)` to create a new message.
+ */
+export const StackBackupRequestSchema: GenMessage<StackBackupRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 11);
+
+/**
+ * @generated from message backup.v1.ListStackBackupsRequest
+ */
+export type ListStackBackupsRequest = Message<"backup.v1.ListStackBackupsRequest"> & {
+  /**
+   * empty lists all stacks
+   *
+   * @generated from field: string stack = 1;
+   */
+  stack: string;
+};
+
+/**
+ * Describes the message backup.v1.ListStackBackupsRequest.
+ * Use `create(ListStackBackupsRequestSchema)` to create a new message.
+ */
+export const ListStackBackupsRequestSchema: GenMessage<ListStackBackupsRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 12);
+
+/**
+ * @generated from message backup.v1.ListStackBackupsResponse
+ */
+export type ListStackBackupsResponse = Message<"backup.v1.ListStackBackupsResponse"> & {
+  /**
+   * @generated from field: repeated backup.v1.BackupRecord records = 1;
+   */
+  records: BackupRecord[];
+};
+
+/**
+ * Describes the message backup.v1.ListStackBackupsResponse.
+ * Use `create(ListStackBackupsResponseSchema)` to create a new message.
+ */
+export const ListStackBackupsResponseSchema: GenMessage<ListStackBackupsResponse> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 13);
+
+/**
+ * @generated from message backup.v1.StackRestoreRequest
+ */
+export type StackRestoreRequest = Message<"backup.v1.StackRestoreRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * stop the stack during the restore and start the running containers again after
+   *
+   * @generated from field: bool stopContainers = 2;
+   */
+  stopContainers: boolean;
+
+  /**
+   * remove existing bind mount and volume contents before extracting
+   *
+   * @generated from field: bool clear = 3;
+   */
+  clear: boolean;
+};
+
+/**
+ * Describes the message backup.v1.StackRestoreRequest.
+ * Use `create(StackRestoreRequestSchema)` to create a new message.
+ */
+export const StackRestoreRequestSchema: GenMessage<StackRestoreRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 14);
+
+/**
+ * @generated from message backup.v1.DeleteStackBackupRequest
+ */
+export type DeleteStackBackupRequest = Message<"backup.v1.DeleteStackBackupRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message backup.v1.DeleteStackBackupRequest.
+ * Use `create(DeleteStackBackupRequestSchema)` to create a new message.
+ */
+export const DeleteStackBackupRequestSchema: GenMessage<DeleteStackBackupRequest> = /*@__PURE__*/
+  messageDesc(file_backup_v1_backup, 15);
+
+/**
+ * @generated from service backup.v1.BackupService
+ */
+export const BackupService: GenService<{
+  /**
+   * streams a volume into a new tar.zst archive
+   *
+   * @generated from rpc backup.v1.BackupService.VolumeBackup
+   */
+  volumeBackup: {
+    methodKind: "unary";
+    input: typeof VolumeBackupRequestSchema;
+    output: typeof ArchiveSchema;
+  },
+  /**
+   * extracts an archive into a new or existing volume
+   *
+   * @generated from rpc backup.v1.BackupService.VolumeRestore
+   */
+  volumeRestore: {
+    methodKind: "unary";
+    input: typeof VolumeRestoreRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.ListArchives
+   */
+  listArchives: {
+    methodKind: "unary";
+    input: typeof LocationSchema;
+    output: typeof ListArchivesResponseSchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.DeleteArchive
+   */
+  deleteArchive: {
+    methodKind: "unary";
+    input: typeof DeleteArchiveRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.ListSchedules
+   */
+  listSchedules: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListSchedulesResponseSchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.SaveSchedule
+   */
+  saveSchedule: {
+    methodKind: "unary";
+    input: typeof ScheduleSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * archives and history of the schedule are kept
+   *
+   * @generated from rpc backup.v1.BackupService.DeleteSchedule
+   */
+  deleteSchedule: {
+    methodKind: "unary";
+    input: typeof DeleteScheduleRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * archives the compose folder, bind mounts under the compose root and named volumes of a stack
+   *
+   * @generated from rpc backup.v1.BackupService.StackBackup
+   */
+  stackBackup: {
+    methodKind: "unary";
+    input: typeof StackBackupRequestSchema;
+    output: typeof BackupRecordSchema;
+  },
+  /**
+   * backup history newest first
+   *
+   * @generated from rpc backup.v1.BackupService.ListStackBackups
+   */
+  listStackBackups: {
+    methodKind: "unary";
+    input: typeof ListStackBackupsRequestSchema;
+    output: typeof ListStackBackupsResponseSchema;
+  },
+  /**
+   * @generated from rpc backup.v1.BackupService.StackRestore
+   */
+  stackRestore: {
+    methodKind: "unary";
+    input: typeof StackRestoreRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * removes the archive and its history entry
+   *
+   * @generated from rpc backup.v1.BackupService.DeleteStackBackup
+   */
+  deleteStackBackup: {
+    methodKind: "unary";
+    input: typeof DeleteStackBackupRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_backup_v1_backup, 0);
+
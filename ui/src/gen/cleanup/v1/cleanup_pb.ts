@@ -0,0 +1,376 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file cleanup/v1/cleanup.proto (package cleanup.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file cleanup/v1/cleanup.proto.
+ */
+export const file_cleanup_v1_cleanup: GenFile = /*@__PURE__*/
+  fileDesc("ChhjbGVhbnVwL3YxL2NsZWFudXAucHJvdG8SCmNsZWFudXAudjEiBwoFRW1wdHkiwgIKBlBvbGljeRIKCgJpZBgBIAEoBBIMCgRuYW1lGAIgASgJEg4KBmVuYWJsZRgDIAEoCBIMCgRob3N0GAQgASgJEhkKEWludGVydmFsSW5TZWNvbmRzGAUgASgDEhIKCmNvbnRhaW5lcnMYBiABKAgSDgoGaW1hZ2VzGAcgASgIEhEKCWFsbEltYWdlcxgIIAEoCBIPCgd2b2x1bWVzGAkgASgIEhIKCmFsbFZvbHVtZXMYCiABKAgSEAoIbmV0d29ya3MYCyABKAgSEgoKYnVpbGRDYWNoZRgMIAEoCBIWCg5idWlsZENhY2hlS2VlcBgNIAEoAxIaChJvbGRlclRoYW5JblNlY29uZHMYDiABKAMSDgoGbGFiZWxzGA8gAygJEg4KBm5vdGlmeRgQIAEoCBIPCgdsYXN0UnVuGBEgASgJIjwKFExpc3RQb2xpY2llc1Jlc3BvbnNlEiQKCHBvbGljaWVzGAEgAygLMhIuY2xlYW51cC52MS5Qb2xpY3kiIQoTRGVsZXRlUG9saWN5UmVxdWVzdBIKCgJpZBgBIAEoBCIeChBSdW5Qb2xpY3lSZXF1ZXN0EgoKAmlkGAEgASgEIq4CCgZSZXBvcnQSCgoCaWQYASABKAQSEAoIcG9saWN5SWQYAiABKAQSDgoGcG9saWN5GAMgASgJEgwKBGhvc3QYBCABKAkSDgoGbWFudWFsGAUgASgIEg4KBnN0YXR1cxgGIAEoCRINCgVlcnJvchgHIAEoCRIZChFjb250YWluZXJzRGVsZXRlZBgIIAEoBRIVCg1pbWFnZXNEZWxldGVkGAkgASgFEhYKDnZvbHVtZXNEZWxldGVkGAogASgFEhcKD25ldHdvcmtzRGVsZXRlZBgLIAEoBRIVCg1jYWNoZXNEZWxldGVkGAwgASgFEhYKDnNwYWNlUmVjbGFpbWVkGA0gASgEEhEKCXN0YXJ0ZWRBdBgOIAEoCRIUCgxkdXJhdGlvbkluTXMYDyABKAMiNQoSTGlzdFJlcG9ydHNSZXF1ZXN0EhAKCHBvbGljeUlkGAEgASgEEg0KBWxpbWl0GAIgASgFIjoKE0xpc3RSZXBvcnRzUmVzcG9uc2USIwoHcmVwb3J0cxgBIAMoCzISLmNsZWFudXAudjEuUmVwb3J0Mu0CCg5DbGVhbnVwU2VydmljZRJICgxMaXN0UG9saWNpZXMSES5jbGVhbnVwLnYxLkVtcHR5GiAuY2xlYW51cC52MS5MaXN0UG9saWNpZXNSZXNwb25zZSIDkAIBEjUKClNhdmVQb2xpY3kSEi5jbGVhbnVwLnYxLlBvbGljeRoRLmNsZWFudXAudjEuRW1wdHkiABJECgxEZWxldGVQb2xpY3kSHy5jbGVhbnVwLnYxLkRlbGV0ZVBvbGljeVJlcXVlc3QaES5jbGVhbnVwLnYxLkVtcHR5IgASPwoJUnVuUG9saWN5EhwuY2xlYW51cC52MS5SdW5Qb2xpY3lSZXF1ZXN0GhIuY2xlYW51cC52MS5SZXBvcnQiABJTCgtMaXN0UmVwb3J0cxIeLmNsZWFudXAudjEuTGlzdFJlcG9ydHNSZXF1ZXN0Gh8uY2xlYW51cC52MS5MaXN0UmVwb3J0c1Jlc3BvbnNlIgOQAgFClgEKDmNvbS5jbGVhbnVwLnYxQgxDbGVhbnVwUHJvdG9QAVotZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9jbGVhbnVwL3YxogIDQ1hYqgIKQ2xlYW51cC5WMcoCCkNsZWFudXBcVjHiAhZDbGVhbnVwXFYxXEdQQk1ldGFkYXRh6gILQ2xlYW51cDo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message cleanup.v1.Empty
+ */
+export type Empty = Message<"cleanup.v1.Empty"> & {
+};
+
+/**
+ * Describes the message cleanup.v1.Empty.
+ * Use `create(EmptySchema)` to create a new message.
+ */
+export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 0);
+
+/**
+ * @generated from message cleanup.v1.Policy
+ */
+export type Policy = Message<"cleanup.v1.Policy"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool enable = 3;
+   */
+  enable: boolean;
+
+  /**
+   * empty for the active host, resolved to its name when saved
+   *
+   * @generated from field: string host = 4;
+   */
+  host: string;
+
+  /**
+   * @generated from field: int64 intervalInSeconds = 5;
+   */
+  intervalInSeconds: bigint;
+
+  /**
+   * stopped containers
+   *
+   * @generated from field: bool containers = 6;
+   */
+  containers: boolean;
+
+  /**
+   * @generated from field: bool images = 7;
+   */
+  images: boolean;
+
+  /**
+   * also tagged images without a container, otherwise only dangling
+   *
+   * @generated from field: bool allImages = 8;
+   */
+  allImages: boolean;
+
+  /**
+   * @generated from field: bool volumes = 9;
+   */
+  volumes: boolean;
+
+  /**
+   * also named volumes, otherwise only anonymous
+   *
+   * @generated from field: bool allVolumes = 10;
+   */
+  allVolumes: boolean;
+
+  /**
+   * @generated from field: bool networks = 11;
+   */
+  networks: boolean;
+
+  /**
+   * @generated from field: bool buildCache = 12;
+   */
+  buildCache: boolean;
+
+  /**
+   * keep the most recently used build cache up to this many bytes, 0 removes all unused cache
+   *
+   * @generated from field: int64 buildCacheKeep = 13;
+   */
+  buildCacheKeep: bigint;
+
+  /**
+   * only prune objects older than this, 0 prunes regardless of age, not supported for volumes
+   *
+   * @generated from field: int64 olderThanInSeconds = 14;
+   */
+  olderThanInSeconds: bigint;
+
+  /**
+   * key or key=value, prefix with ! to skip matching objects, not supported for the build cache
+   *
+   * @generated from field: repeated string labels = 15;
+   */
+  labels: string[];
+
+  /**
+   * send a notification after each scheduled run
+   *
+   * @generated from field: bool notify = 16;
+   */
+  notify: boolean;
+
+  /**
+   * @generated from field: string lastRun = 17;
+   */
+  lastRun: string;
+};
+
+/**
+ * Describes the message cleanup.v1.Policy.
+ * Use `create(PolicySchema)` to create a new message.
+ */
+export const PolicySchema: GenMessage<Policy> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 1);
+
+/**
+ * @generated from message cleanup.v1.ListPoliciesResponse
+ */
+export type ListPoliciesResponse = Message<"cleanup.v1.ListPoliciesResponse"> & {
+  /**
+   * @generated from field: repeated cleanup.v1.Policy policies = 1;
+   */
+  policies: Policy[];
+};
+
+/**
+ * Describes the message cleanup.v1.ListPoliciesResponse.
+ * Use `create(ListPoliciesResponseSchema)` to create a new message.
+ */
+export const ListPoliciesResponseSchema: GenMessage<ListPoliciesResponse> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 2);
+
+/**
+ * @generated from message cleanup.v1.DeletePolicyRequest
+ */
+export type DeletePolicyRequest = Message<"cleanup.v1.DeletePolicyRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message cleanup.v1.DeletePolicyRequest.
+ * Use `create(DeletePolicyRequestSchema)` to create a new message.
+ */
+export const DeletePolicyRequestSchema: GenMessage<DeletePolicyRequest> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 3);
+
+/**
+ * @generated from message cleanup.v1.RunPolicyRequest
+ */
+export type RunPolicyRequest = Message<"cleanup.v1.RunPolicyRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message cleanup.v1.RunPolicyRequest.
+ * Use `create(RunPolicyRequestSchema)` to create a new message.
+ */
+export const RunPolicyRequestSchema: GenMessage<RunPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 4);
+
+/**
+ * @generated from message cleanup.v1.Report
+ */
+export type Report = Message<"cleanup.v1.Report"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: uint64 policyId = 2;
+   */
+  policyId: bigint;
+
+  /**
+   * @generated from field: string policy = 3;
+   */
+  policy: string;
+
+  /**
+   * @generated from field: string host = 4;
+   */
+  host: string;
+
+  /**
+   * @generated from field: bool manual = 5;
+   */
+  manual: boolean;
+
+  /**
+   * success|partial|failed
+   *
+   * @generated from field: string status = 6;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string error = 7;
+   */
+  error: string;
+
+  /**
+   * @generated from field: int32 containersDeleted = 8;
+   */
+  containersDeleted: number;
+
+  /**
+   * @generated from field: int32 imagesDeleted = 9;
+   */
+  imagesDeleted: number;
+
+  /**
+   * @generated from field: int32 volumesDeleted = 10;
+   */
+  volumesDeleted: number;
+
+  /**
+   * @generated from field: int32 networksDeleted = 11;
+   */
+  networksDeleted: number;
+
+  /**
+   * @generated from field: int32 cachesDeleted = 12;
+   */
+  cachesDeleted: number;
+
+  /**
+   * @generated from field: uint64 spaceReclaimed = 13;
+   */
+  spaceReclaimed: bigint;
+
+  /**
+   * @generated from field: string startedAt = 14;
+   */
+  startedAt: string;
+
+  /**
+   * @generated from field: int64 durationInMs = 15;
+   */
+  durationInMs: bigint;
+};
+
+/**
+ * Describes the message cleanup.v1.Report.
+ * Use `create(ReportSchema)` to create a new message.
+ */
+export const ReportSchema: GenMessage<Report> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 5);
+
+/**
+ * @generated from message cleanup.v1.ListReportsRequest
+ */
+export type ListReportsRequest = Message<"cleanup.v1.ListReportsRequest"> & {
+  /**
+   * 0 lists the reports of all policies
+   *
+   * @generated from field: uint64 policyId = 1;
+   */
+  policyId: bigint;
+
+  /**
+   * defaults to 100
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message cleanup.v1.ListReportsRequest.
+ * Use `create(ListReportsRequestSchema)` to create a new message.
+ */
+export const ListReportsRequestSchema: GenMessage<ListReportsRequest> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 6);
+
+/**
+ * @generated from message cleanup.v1.ListReportsResponse
+ */
+export type ListReportsResponse = Message<"cleanup.v1.ListReportsResponse"> & {
+  /**
+   * @generated from field: repeated cleanup.v1.Report reports = 1;
+   */
+  reports: Report[];
+};
+
+/**
+ * Describes the message cleanup.v1.ListReportsResponse.
+ * Use `create(ListReportsResponseSchema)` to create a new message.
+ */
+export const ListReportsResponseSchema: GenMessage<ListReportsResponse> = /*@__PURE__*/
+  messageDesc(file_cleanup_v1_cleanup, 7);
+
+/**
+ * @generated from service cleanup.v1.CleanupService
+ */
+export const CleanupService: GenService<{
+  /**
+   * @generated from rpc cleanup.v1.CleanupService.ListPolicies
+   */
+  listPolicies: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListPoliciesResponseSchema;
+  },
+  /**
+   * @generated from rpc cleanup.v1.CleanupService.SavePolicy
+   */
+  savePolicy: {
+    methodKind: "unary";
+    input: typeof PolicySchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * reports of the policy are kept
+   *
+   * @generated from rpc cleanup.v1.CleanupService.DeletePolicy
+   */
+  deletePolicy: {
+    methodKind: "unary";
+    input: typeof DeletePolicyRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * runs a policy now without changing its schedule
+   *
+   * @generated from rpc cleanup.v1.CleanupService.RunPolicy
+   */
+  runPolicy: {
+    methodKind: "unary";
+    input: typeof RunPolicyRequestSchema;
+    output: typeof ReportSchema;
+  },
+  /**
+   * reports newest first
+   *
+   * @generated from rpc cleanup.v1.CleanupService.ListReports
+   */
+  listReports: {
+    methodKind: "unary";
+    input: typeof ListReportsRequestSchema;
+    output: typeof ListReportsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_cleanup_v1_cleanup, 0);
+
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file config/v1/config.proto.
  */
 export const file_config_v1_config: GenFile = /*@__PURE__*/
-  fileDesc("ChZjb25maWcvdjEvY29uZmlnLnByb3RvEgljb25maWcudjEiTgoOU2V0VXNlclJlcXVlc3QSJQoGY29uZmlnGAEgASgLMhUuY29uZmlnLnYxLlVzZXJDb25maWcSFQoNdXBkYXRlVXBkYXRlchgCIAEoCCI6CgpVc2VyQ29uZmlnEiwKB3VwZGF0ZXIYASABKAsyGy5jb25maWcudjEuQ29udGFpbmVyVXBkYXRlciJRChBDb250YWluZXJVcGRhdGVyEg4KBkVuYWJsZRgBIAEoCBISCgpOb3RpZnlPbmx5GAIgASgIEhkKEUludGVydmFsSW5TZWNvbmRzGAMgASgDIgcKBUVtcHR5MosBCg1Db25maWdTZXJ2aWNlEjoKDUdldFVzZXJDb25maWcSEC5jb25maWcudjEuRW1wdHkaFS5jb25maWcudjEuVXNlckNvbmZpZyIAEj4KDVNldFVzZXJDb25maWcSGS5jb25maWcudjEuU2V0VXNlclJlcXVlc3QaEC5jb25maWcudjEuRW1wdHkiAEKPAQoNY29tLmNvbmZpZy52MUILQ29uZmlnUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9jb25maWcvdjGiAgNDWFiqAglDb25maWcuVjHKAglDb25maWdcVjHiAhVDb25maWdcVjFcR1BCTWV0YWRhdGHqAgpDb25maWc6OlYxYgZwcm90bzM");
+  fileDesc("ChZjb25maWcvdjEvY29uZmlnLnByb3RvEgljb25maWcudjEiTgoOU2V0VXNlclJlcXVlc3QSJQoGY29uZmlnGAEgASgLMhUuY29uZmlnLnYxLlVzZXJDb25maWcSFQoNdXBkYXRlVXBkYXRlchgCIAEoCCI6CgpVc2VyQ29uZmlnEiwKB3VwZGF0ZXIYASABKAsyGy5jb25maWcudjEuQ29udGFpbmVyVXBkYXRlciJRChBDb250YWluZXJVcGRhdGVyEg4KBkVuYWJsZRgBIAEoCBISCgpOb3RpZnlPbmx5GAIgASgIEhkKEUludGVydmFsSW5TZWNvbmRzGAMgASgDIgcKBUVtcHR5Mo4BCg1Db25maWdTZXJ2aWNlEj0KDUdldFVzZXJDb25maWcSEC5jb25maWcudjEuRW1wdHkaFS5jb25maWcudjEuVXNlckNvbmZpZyIDkAIBEj4KDVNldFVzZXJDb25maWcSGS5jb25maWcudjEuU2V0VXNlclJlcXVlc3QaEC5jb25maWcudjEuRW1wdHkiAEKPAQoNY29tLmNvbmZpZy52MUILQ29uZmlnUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9jb25maWcvdjGiAgNDWFiqAglDb25maWcuVjHKAglDb25maWdcVjHiAhVDb25maWdcVjFcR1BCTWV0YWRhdGHqAgpDb25maWc6OlYxYgZwcm90bzM");
 
 /**
  * @generated from message config.v1.SetUserRequest
@@ -10,653 +10,2271 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file docker/v1/docker.proto.
  */
 export const file_docker_v1_docker: GenFile = /*@__PURE__*/
-  fileDesc("ChZkb2NrZXIvdjEvZG9ja2VyLnByb3RvEglkb2NrZXIudjEiJwoXQ29tcG9zZVZhbGlkYXRlUmVzcG9uc2USDAoEZXJycxgBIAMoCSI9ChVDb250YWluZXJFeGVjQ21kSW5wdXQSDwoHdXNlckNtZBgBIAEoCRITCgtjb250YWluZXJJRBgCIAEoCSI8ChRDb250YWluZXJFeGVjUmVxdWVzdBITCgtjb250YWluZXJJRBgBIAEoCRIPCgdleGVjQ21kGAIgAygJIrYCCgVJbWFnZRISCgpjb250YWluZXJzGAEgASgDEg8KB2NyZWF0ZWQYAiABKAMSCgoCaWQYAyABKAkSLAoGbGFiZWxzGAQgAygLMhwuZG9ja2VyLnYxLkltYWdlLkxhYmVsc0VudHJ5EhEKCXBhcmVudF9pZBgFIAEoCRItCgltYW5pZmVzdHMYByADKAsyGi5kb2NrZXIudjEuTWFuaWZlc3RTdW1tYXJ5EhQKDHJlcG9fZGlnZXN0cxgIIAMoCRIRCglyZXBvX3RhZ3MYCSADKAkSEwoLc2hhcmVkX3NpemUYCiABKAMSDAoEc2l6ZRgLIAEoAxIRCgl1cGRhdGVSZWYYDCABKAkaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJDCg9NYW5pZmVzdFN1bW1hcnkSDgoGZGlnZXN0GAEgASgJEhIKCm1lZGlhX3R5cGUYAiABKAkSDAoEc2l6ZRgDIAEoAyITChFMaXN0SW1hZ2VzUmVxdWVzdCKEAQoSTGlzdEltYWdlc1Jlc3BvbnNlEhYKDnRvdGFsRGlza1VzYWdlGAEgASgDEhgKEHVudXNlZEltYWdlQ291bnQYAiABKAMSGgoSdW50YWdnZWRJbWFnZUNvdW50GAMgASgDEiAKBmltYWdlcxgEIAMoCzIQLmRvY2tlci52MS5JbWFnZSImChJSZW1vdmVJbWFnZVJlcXVlc3QSEAoIaW1hZ2VJZHMYASADKAkiFQoTUmVtb3ZlSW1hZ2VSZXNwb25zZSJXChJJbWFnZVBydW5lUmVzcG9uc2USFgoOU3BhY2VSZWNsYWltZWQYASABKAQSKQoHZGVsZXRlZBgCIAMoCzIYLmRvY2tlci52MS5JbWFnZXNEZWxldGVkIiUKEUltYWdlUHJ1bmVSZXF1ZXN0EhAKCHBydW5lQWxsGAEgASgIIjIKDUltYWdlc0RlbGV0ZWQSDwoHRGVsZXRlZBgBIAEoCRIQCghVbnRhZ2dlZBgCIAEoCSKhAQoGVm9sdW1lEgwKBG5hbWUYASABKAkSEwoLY29udGFpbmVySUQYAiABKAkSEQoJY3JlYXRlZEF0GAMgASgJEhIKCm1vdW50UG9pbnQYBCABKAkSDAoEc2l6ZRgFIAEoAxIOCgZsYWJlbHMYBiABKAkSEwoLY29tcG9zZVBhdGgYByABKAkSGgoSY29tcG9zZVByb2plY3ROYW1lGAggASgJIhQKEkxpc3RWb2x1bWVzUmVxdWVzdCI5ChNMaXN0Vm9sdW1lc1Jlc3BvbnNlEiIKB3ZvbHVtZXMYASADKAsyES5kb2NrZXIudjEuVm9sdW1lIhUKE0NyZWF0ZVZvbHVtZVJlcXVlc3QiFgoUQ3JlYXRlVm9sdW1lUmVzcG9uc2UiRgoTRGVsZXRlVm9sdW1lUmVxdWVzdBIRCgl2b2x1bWVJZHMYASADKAkSDAoEYW5vbhgCIAEoCBIOCgZ1bnVzZWQYAyABKAgiFgoURGVsZXRlVm9sdW1lUmVzcG9uc2Ui4wEKB05ldHdvcmsSDAoEbmFtZRgBIAEoCRIKCgJpZBgCIAEoCRIOCgZzdWJuZXQYAyABKAkSDQoFc2NvcGUYBCABKAkSDgoGZHJpdmVyGAUgASgJEhMKC2VuYWJsZV9pcHY0GAYgASgIEhMKC2VuYWJsZV9pcHY2GAcgASgIEhAKCGludGVybmFsGAkgASgIEhIKCmF0dGFjaGFibGUYCiABKAgSEQoJY3JlYXRlZEF0GAsgASgJEhYKDmNvbXBvc2VQcm9qZWN0GAwgASgJEhQKDGNvbnRhaW5lcklkcxgNIAMoCSIVChNMaXN0TmV0d29ya3NSZXF1ZXN0IjwKFExpc3ROZXR3b3Jrc1Jlc3BvbnNlEiQKCG5ldHdvcmtzGAEgAygLMhIuZG9ja2VyLnYxLk5ldHdvcmsiFgoUQ3JlYXRlTmV0d29ya1JlcXVlc3QiFwoVQ3JlYXRlTmV0d29ya1Jlc3BvbnNlIjkKFERlbGV0ZU5ldHdvcmtSZXF1ZXN0EhIKCm5ldHdvcmtJZHMYASADKAkSDQoFcHJ1bmUYAiABKAgiFwoVRGVsZXRlTmV0d29ya1Jlc3BvbnNlIisKFENvbnRhaW5lckxvZ3NSZXF1ZXN0EhMKC2NvbnRhaW5lcklEGAEgASgJIh4KC0xvZ3NNZXNzYWdlEg8KB21lc3NhZ2UYASABKAkiZQoNU3RhdHNSZXNwb25zZRIlCgZzeXN0ZW0YASABKAsyFS5kb2NrZXIudjEuU3lzdGVtSW5mbxItCgpjb250YWluZXJzGAIgAygLMhkuZG9ja2VyLnYxLkNvbnRhaW5lclN0YXRzInwKDFN0YXRzUmVxdWVzdBIkCgRmaWxlGAEgASgLMhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlEiUKBnNvcnRCeRgCIAEoDjIVLmRvY2tlci52MS5TT1JUX0ZJRUxEEh8KBW9yZGVyGAMgASgOMhAuZG9ja2VyLnYxLk9SREVSIi0KClN5c3RlbUluZm8SCwoDQ1BVGAEgASgBEhIKCm1lbUluQnl0ZXMYAiABKAQiNgoMTGlzdFJlc3BvbnNlEiYKBGxpc3QYASADKAsyGC5kb2NrZXIudjEuQ29udGFpbmVyTGlzdCLkAQoNQ29udGFpbmVyTGlzdBIKCgJpZBgBIAEoCRIPCgdpbWFnZUlEGAIgASgJEhEKCWltYWdlTmFtZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSDAoEbmFtZRgFIAEoCRIPCgdjcmVhdGVkGAYgASgJEh4KBXBvcnRzGAcgAygLMg8uZG9ja2VyLnYxLlBvcnQSEwoLc2VydmljZU5hbWUYCCABKAkSEwoLc2VydmljZVBhdGgYCSABKAkSEQoJc3RhY2tOYW1lGAogASgJEhcKD3VwZGF0ZUF2YWlsYWJsZRgLIAEoCSK6AQoOQ29udGFpbmVyU3RhdHMSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCgljcHVfdXNhZ2UYAyABKAESFAoMbWVtb3J5X3VzYWdlGAQgASgEEhQKDG1lbW9yeV9saW1pdBgFIAEoBBISCgpuZXR3b3JrX3J4GAYgASgEEhIKCm5ldHdvcmtfdHgYByABKAQSEgoKYmxvY2tfcmVhZBgIIAEoBBITCgtibG9ja193cml0ZRgJIAEoBCJDCgRQb3J0Eg4KBnB1YmxpYxgBIAEoBRIPCgdwcml2YXRlGAIgASgFEgwKBGhvc3QYAyABKAkSDAoEdHlwZRgEIAEoCSIHCgVFbXB0eSIoChBDb250YWluZXJSZXF1ZXN0EhQKDGNvbnRhaW5lcklkcxgBIAMoCSI5CgtDb21wb3NlRmlsZRIQCghmaWxlbmFtZRgBIAEoCRIYChBzZWxlY3RlZFNlcnZpY2VzGAIgAygJKmAKClNPUlRfRklFTEQSCAoETkFNRRAAEgcKA0NQVRABEgcKA01FTRACEg4KCk5FVFdPUktfUlgQAxIOCgpORVRXT1JLX1RYEAQSCgoGRElTS19SEAUSCgoGRElTS19XEAYqGQoFT1JERVISBwoDRFNDEAASBwoDQVNDEAEyuA8KDURvY2tlclNlcnZpY2USRwoOQ29udGFpbmVyU3RhcnQSGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAEkYKDUNvbnRhaW5lclN0b3ASGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAEkgKD0NvbnRhaW5lclJlbW92ZRIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgASSQoQQ29udGFpbmVyUmVzdGFydBIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgASQgoPQ29udGFpbmVyVXBkYXRlEhsuZG9ja2VyLnYxLkNvbnRhaW5lclJlcXVlc3QaEC5kb2NrZXIudjEuRW1wdHkiABI8Cg1Db250YWluZXJMaXN0EhAuZG9ja2VyLnYxLkVtcHR5GhcuZG9ja2VyLnYxLkxpc3RSZXNwb25zZSIAEkUKDkNvbnRhaW5lclN0YXRzEhcuZG9ja2VyLnYxLlN0YXRzUmVxdWVzdBoYLmRvY2tlci52MS5TdGF0c1Jlc3BvbnNlIgASTAoNQ29udGFpbmVyTG9ncxIfLmRvY2tlci52MS5Db250YWluZXJMb2dzUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESUgoTQ29udGFpbmVyRXhlY091dHB1dBIfLmRvY2tlci52MS5Db250YWluZXJFeGVjUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESSgoSQ29udGFpbmVyRXhlY0lucHV0EiAuZG9ja2VyLnYxLkNvbnRhaW5lckV4ZWNDbWRJbnB1dBoQLmRvY2tlci52MS5FbXB0eSIAEkIKDENvbXBvc2VTdGFydBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESQQoLQ29tcG9zZVN0b3ASFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkMKDUNvbXBvc2VSZW1vdmUSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkQKDkNvbXBvc2VSZXN0YXJ0EhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJDCg1Db21wb3NlVXBkYXRlEhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJACgtDb21wb3NlTGlzdBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoXLmRvY2tlci52MS5MaXN0UmVzcG9uc2UiABJPCg9Db21wb3NlVmFsaWRhdGUSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaIi5kb2NrZXIudjEuQ29tcG9zZVZhbGlkYXRlUmVzcG9uc2UiABJKCglJbWFnZUxpc3QSHC5kb2NrZXIudjEuTGlzdEltYWdlc1JlcXVlc3QaHS5kb2NrZXIudjEuTGlzdEltYWdlc1Jlc3BvbnNlIgASTgoLSW1hZ2VSZW1vdmUSHS5kb2NrZXIudjEuUmVtb3ZlSW1hZ2VSZXF1ZXN0Gh4uZG9ja2VyLnYxLlJlbW92ZUltYWdlUmVzcG9uc2UiABJRChBJbWFnZVBydW5lVW51c2VkEhwuZG9ja2VyLnYxLkltYWdlUHJ1bmVSZXF1ZXN0Gh0uZG9ja2VyLnYxLkltYWdlUHJ1bmVSZXNwb25zZSIAEk0KClZvbHVtZUxpc3QSHS5kb2NrZXIudjEuTGlzdFZvbHVtZXNSZXF1ZXN0Gh4uZG9ja2VyLnYxLkxpc3RWb2x1bWVzUmVzcG9uc2UiABJRCgxWb2x1bWVDcmVhdGUSHi5kb2NrZXIudjEuQ3JlYXRlVm9sdW1lUmVxdWVzdBofLmRvY2tlci52MS5DcmVhdGVWb2x1bWVSZXNwb25zZSIAElEKDFZvbHVtZURlbGV0ZRIeLmRvY2tlci52MS5EZWxldGVWb2x1bWVSZXF1ZXN0Gh8uZG9ja2VyLnYxLkRlbGV0ZVZvbHVtZVJlc3BvbnNlIgASUAoLTmV0d29ya0xpc3QSHi5kb2NrZXIudjEuTGlzdE5ldHdvcmtzUmVxdWVzdBofLmRvY2tlci52MS5MaXN0TmV0d29ya3NSZXNwb25zZSIAElQKDU5ldHdvcmtDcmVhdGUSHy5kb2NrZXIudjEuQ3JlYXRlTmV0d29ya1JlcXVlc3QaIC5kb2NrZXIudjEuQ3JlYXRlTmV0d29ya1Jlc3BvbnNlIgASVAoNTmV0d29ya0RlbGV0ZRIfLmRvY2tlci52MS5EZWxldGVOZXR3b3JrUmVxdWVzdBogLmRvY2tlci52MS5EZWxldGVOZXR3b3JrUmVzcG9uc2UiAEKPAQoNY29tLmRvY2tlci52MUILRG9ja2VyUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9kb2NrZXIvdjGiAgNEWFiqAglEb2NrZXIuVjHKAglEb2NrZXJcVjHiAhVEb2NrZXJcVjFcR1BCTWV0YWRhdGHqAgpEb2NrZXI6OlYxYgZwcm90bzM");
+  fileDesc("ChZkb2NrZXIvdjEvZG9ja2VyLnByb3RvEglkb2NrZXIudjEiMQoNRXZlbnRzUmVxdWVzdBINCgV0eXBlcxgBIAMoCRIRCglzdGFja05hbWUYAiABKAki/QEKC0RvY2tlckV2ZW50EgwKBHR5cGUYASABKAkSDgoGYWN0aW9uGAIgASgJEg8KB2FjdG9ySUQYAyABKAkSDAoEbmFtZRgEIAEoCRI6CgphdHRyaWJ1dGVzGAUgAygLMiYuZG9ja2VyLnYxLkRvY2tlckV2ZW50LkF0dHJpYnV0ZXNFbnRyeRIRCglzdGFja05hbWUYBiABKAkSEwoLc2VydmljZU5hbWUYByABKAkSDAoEdGltZRgIIAEoCRIMCgRob3N0GAkgASgJGjEKD0F0dHJpYnV0ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkEKF0NvbXBvc2VPdmVydmlld1Jlc3BvbnNlEiYKBnN0YWNrcxgBIAMoCzIWLmRvY2tlci52MS5TdGFja1N0YXR1cyK3AQoLU3RhY2tTdGF0dXMSEAoIZmlsZW5hbWUYASABKAkSEQoJc3RhY2tOYW1lGAIgASgJEg0KBXN0YXRlGAMgASgJEhgKEGV4cGVjdGVkU2VydmljZXMYBCABKAUSFwoPcnVubmluZ1NlcnZpY2VzGAUgASgFEhcKD2RyaWZ0ZWRTZXJ2aWNlcxgGIAMoCRIZChF1bmhlYWx0aHlTZXJ2aWNlcxgHIAMoCRINCgVlcnJvchgIIAEoCSJSChRDb21wb3NlRHJpZnRSZXNwb25zZRIPCgdkcmlmdGVkGAEgASgIEikKCHNlcnZpY2VzGAIgAygLMhcuZG9ja2VyLnYxLlNlcnZpY2VEcmlmdCKUAQoMU2VydmljZURyaWZ0Eg8KB3NlcnZpY2UYASABKAkSFQoNY29udGFpbmVyTmFtZRgCIAEoCRINCgVzdGF0ZRgDIAEoCRIUCgxleHBlY3RlZEhhc2gYBCABKAkSEgoKYWN0dWFsSGFzaBgFIAEoCRIjCgVkaWZmcxgGIAMoCzIULmRvY2tlci52MS5GaWVsZERpZmYiPAoJRmllbGREaWZmEg0KBWZpZWxkGAEgASgJEhAKCGV4cGVjdGVkGAIgASgJEg4KBmFjdHVhbBgDIAEoCSKdAQoTQ29tcG9zZVBsYW5SZXNwb25zZRISCgpoYXNDaGFuZ2VzGAEgASgIEi8KCmNvbnRhaW5lcnMYAiADKAsyGy5kb2NrZXIudjEuUGxhbm5lZENvbnRhaW5lchISCgpwdWxsSW1hZ2VzGAMgAygJEhYKDmNyZWF0ZU5ldHdvcmtzGAQgAygJEhUKDWNyZWF0ZVZvbHVtZXMYBSADKAkiWgoQUGxhbm5lZENvbnRhaW5lchIPCgdzZXJ2aWNlGAEgASgJEhUKDWNvbnRhaW5lck5hbWUYAiABKAkSDgoGYWN0aW9uGAMgASgJEg4KBnJlYXNvbhgEIAEoCSJTChVDb21wb3NlQ29uZmlnUmVzcG9uc2USDAoEeWFtbBgBIAEoCRIsCgl2YXJpYWJsZXMYAiADKAsyGS5kb2NrZXIudjEuQ29uZmlnVmFyaWFibGUiYAoOQ29uZmlnVmFyaWFibGUSDAoEbmFtZRgBIAEoCRINCgV2YWx1ZRgCIAEoCRIOCgZzb3VyY2UYAyABKAkSDwoHZGVmYXVsdBgEIAEoCRIQCghyZXF1aXJlZBgFIAEoCCKGAQoSU3RhY2tHcmFwaFJlc3BvbnNlEiMKBW5vZGVzGAEgAygLMhQuZG9ja2VyLnYxLkdyYXBoTm9kZRIjCgVlZGdlcxgCIAMoCzIULmRvY2tlci52MS5HcmFwaEVkZ2USEgoKc3RhcnRPcmRlchgDIAMoCRISCgpvcmRlckVycm9yGAQgASgJIkMKCUdyYXBoTm9kZRIKCgJpZBgBIAEoCRIMCgRraW5kGAIgASgJEg0KBWxhYmVsGAMgASgJEg0KBWVycm9yGAQgASgJIkIKCUdyYXBoRWRnZRIMCgRmcm9tGAEgASgJEgoKAnRvGAIgASgJEgwKBGtpbmQYAyABKAkSDQoFbGFiZWwYBCABKAkiVwoXQ29tcG9zZVZhbGlkYXRlUmVzcG9uc2USDAoEZXJycxgBIAMoCRIuCghmaW5kaW5ncxgCIAMoCzIcLmRvY2tlci52MS5WYWxpZGF0aW9uRmluZGluZyJWChFWYWxpZGF0aW9uRmluZGluZxIQCghzZXZlcml0eRgBIAEoCRINCgVjaGVjaxgCIAEoCRIPCgdzZXJ2aWNlGAMgASgJEg8KB21lc3NhZ2UYBCABKAkiPQoVQ29udGFpbmVyRXhlY0NtZElucHV0Eg8KB3VzZXJDbWQYASABKAkSEwoLY29udGFpbmVySUQYAiABKAkiPAoUQ29udGFpbmVyRXhlY1JlcXVlc3QSEwoLY29udGFpbmVySUQYASABKAkSDwoHZXhlY0NtZBgCIAMoCSK2AgoFSW1hZ2USEgoKY29udGFpbmVycxgBIAEoAxIPCgdjcmVhdGVkGAIgASgDEgoKAmlkGAMgASgJEiwKBmxhYmVscxgEIAMoCzIcLmRvY2tlci52MS5JbWFnZS5MYWJlbHNFbnRyeRIRCglwYXJlbnRfaWQYBSABKAkSLQoJbWFuaWZlc3RzGAcgAygLMhouZG9ja2VyLnYxLk1hbmlmZXN0U3VtbWFyeRIUCgxyZXBvX2RpZ2VzdHMYCCADKAkSEQoJcmVwb190YWdzGAkgAygJEhMKC3NoYXJlZF9zaXplGAogASgDEgwKBHNpemUYCyABKAMSEQoJdXBkYXRlUmVmGAwgASgJGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiQwoPTWFuaWZlc3RTdW1tYXJ5Eg4KBmRpZ2VzdBgBIAEoCRISCgptZWRpYV90eXBlGAIgASgJEgwKBHNpemUYAyABKAMiEwoRTGlzdEltYWdlc1JlcXVlc3QihAEKEkxpc3RJbWFnZXNSZXNwb25zZRIWCg50b3RhbERpc2tVc2FnZRgBIAEoAxIYChB1bnVzZWRJbWFnZUNvdW50GAIgASgDEhoKEnVudGFnZ2VkSW1hZ2VDb3VudBgDIAEoAxIgCgZpbWFnZXMYBCADKAsyEC5kb2NrZXIudjEuSW1hZ2UiJgoSUmVtb3ZlSW1hZ2VSZXF1ZXN0EhAKCGltYWdlSWRzGAEgAygJIhUKE1JlbW92ZUltYWdlUmVzcG9uc2UiVwoSSW1hZ2VQcnVuZVJlc3BvbnNlEhYKDlNwYWNlUmVjbGFpbWVkGAEgASgEEikKB2RlbGV0ZWQYAiADKAsyGC5kb2NrZXIudjEuSW1hZ2VzRGVsZXRlZCIlChFJbWFnZVBydW5lUmVxdWVzdBIQCghwcnVuZUFsbBgBIAEoCCIuChBEaXNrVXNhZ2VSZXF1ZXN0EgwKBGhvc3QYASABKAkSDAoEdG9wThgCIAEoBSLEAQoRRGlza1VzYWdlUmVzcG9uc2USKAoGaW1hZ2VzGAEgASgLMhguZG9ja2VyLnYxLlVzYWdlQ2F0ZWdvcnkSLAoKY29udGFpbmVycxgCIAEoCzIYLmRvY2tlci52MS5Vc2FnZUNhdGVnb3J5EikKB3ZvbHVtZXMYAyABKAsyGC5kb2NrZXIudjEuVXNhZ2VDYXRlZ29yeRIsCgpidWlsZENhY2hlGAQgASgLMhguZG9ja2VyLnYxLlVzYWdlQ2F0ZWdvcnkidQoNVXNhZ2VDYXRlZ29yeRINCgVjb3VudBgBIAEoAxIOCgZhY3RpdmUYAiABKAMSDQoFdG90YWwYAyABKAMSEwoLcmVjbGFpbWFibGUYBCABKAMSIQoDdG9wGAUgAygLMhQuZG9ja2VyLnYxLlVzYWdlSXRlbSJICglVc2FnZUl0ZW0SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgRzaXplGAMgASgDEhMKC3JlY2xhaW1hYmxlGAQgASgIIr0BCgxQcnVuZVJlcXVlc3QSDAoEaG9zdBgBIAEoCRISCgpjb250YWluZXJzGAIgASgIEg4KBmltYWdlcxgDIAEoCBIRCglhbGxJbWFnZXMYBCABKAgSDwoHdm9sdW1lcxgFIAEoCBISCgphbGxWb2x1bWVzGAYgASgIEhAKCG5ldHdvcmtzGAcgASgIEhIKCmJ1aWxkQ2FjaGUYCCABKAgSDQoFdW50aWwYCSABKAkSDgoGbGFiZWxzGAogAygJIsIBCg1QcnVuZVJlc3BvbnNlEhkKEWNvbnRhaW5lcnNEZWxldGVkGAEgAygJEhUKDWltYWdlc0RlbGV0ZWQYAiADKAkSFgoOdm9sdW1lc0RlbGV0ZWQYAyADKAkSFwoPbmV0d29ya3NEZWxldGVkGAQgAygJEhUKDWNhY2hlc0RlbGV0ZWQYBSADKAkSFgoOc3BhY2VSZWNsYWltZWQYBiABKAQSDwoHc2tpcHBlZBgHIAMoCRIOCgZlcnJvcnMYCCADKAkiMgoNSW1hZ2VzRGVsZXRlZBIPCgdEZWxldGVkGAEgASgJEhAKCFVudGFnZ2VkGAIgASgJIqEBCgZWb2x1bWUSDAoEbmFtZRgBIAEoCRITCgtjb250YWluZXJJRBgCIAEoCRIRCgljcmVhdGVkQXQYAyABKAkSEgoKbW91bnRQb2ludBgEIAEoCRIMCgRzaXplGAUgASgDEg4KBmxhYmVscxgGIAEoCRITCgtjb21wb3NlUGF0aBgHIAEoCRIaChJjb21wb3NlUHJvamVjdE5hbWUYCCABKAkiFAoSTGlzdFZvbHVtZXNSZXF1ZXN0IjUKFlZvbHVtZUxpc3RGaWxlc1JlcXVlc3QSDgoGdm9sdW1lGAEgASgJEgsKA2RpchgCIAEoCSI+ChdWb2x1bWVMaXN0RmlsZXNSZXNwb25zZRIjCgVmaWxlcxgBIAMoCzIULmRvY2tlci52MS5GaWxlRW50cnkikQEKCUZpbGVFbnRyeRIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAiABKAkSDAoEc2l6ZRgDIAEoAxINCgVpc0RpchgEIAEoCBIMCgRtb2RlGAUgASgJEg8KB21vZFRpbWUYBiABKAkSCwoDdWlkGAcgASgFEgsKA2dpZBgIIAEoBRISCgpsaW5rVGFyZ2V0GAkgASgJIjEKEVZvbHVtZUZpbGVSZXF1ZXN0Eg4KBnZvbHVtZRgBIAEoCRIMCgRwYXRoGAIgASgJIjQKElZvbHVtZUZpbGVDb250ZW50cxIMCgRwYXRoGAEgASgJEhAKCGNvbnRlbnRzGAIgASgMIlYKFlZvbHVtZVdyaXRlRmlsZVJlcXVlc3QSDgoGdm9sdW1lGAEgASgJEgwKBHBhdGgYAiABKAkSEAoIY29udGVudHMYAyABKAwSDAoEbW9kZRgEIAEoDSJKChdWb2x1bWVEZWxldGVGaWxlUmVxdWVzdBIOCgZ2b2x1bWUYASABKAkSDAoEcGF0aBgCIAEoCRIRCglyZWN1cnNpdmUYAyABKAgiOQoTTGlzdFZvbHVtZXNSZXNwb25zZRIiCgd2b2x1bWVzGAEgAygLMhEuZG9ja2VyLnYxLlZvbHVtZSKVAgoTQ3JlYXRlVm9sdW1lUmVxdWVzdBIMCgRuYW1lGAEgASgJEg4KBmRyaXZlchgCIAEoCRJCCgpkcml2ZXJPcHRzGAMgAygLMi4uZG9ja2VyLnYxLkNyZWF0ZVZvbHVtZVJlcXVlc3QuRHJpdmVyT3B0c0VudHJ5EjoKBmxhYmVscxgEIAMoCzIqLmRvY2tlci52MS5DcmVhdGVWb2x1bWVSZXF1ZXN0LkxhYmVsc0VudHJ5GjEKD0RyaXZlck9wdHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiOQoUQ3JlYXRlVm9sdW1lUmVzcG9uc2USIQoGdm9sdW1lGAEgASgLMhEuZG9ja2VyLnYxLlZvbHVtZSJGChNEZWxldGVWb2x1bWVSZXF1ZXN0EhEKCXZvbHVtZUlkcxgBIAMoCRIMCgRhbm9uGAIgASgIEg4KBnVudXNlZBgDIAEoCCIWChREZWxldGVWb2x1bWVSZXNwb25zZSLjAQoHTmV0d29yaxIMCgRuYW1lGAEgASgJEgoKAmlkGAIgASgJEg4KBnN1Ym5ldBgDIAEoCRINCgVzY29wZRgEIAEoCRIOCgZkcml2ZXIYBSABKAkSEwoLZW5hYmxlX2lwdjQYBiABKAgSEwoLZW5hYmxlX2lwdjYYByABKAgSEAoIaW50ZXJuYWwYCSABKAgSEgoKYXR0YWNoYWJsZRgKIAEoCBIRCgljcmVhdGVkQXQYCyABKAkSFgoOY29tcG9zZVByb2plY3QYDCABKAkSFAoMY29udGFpbmVySWRzGA0gAygJIhUKE0xpc3ROZXR3b3Jrc1JlcXVlc3QiPAoUTGlzdE5ldHdvcmtzUmVzcG9uc2USJAoIbmV0d29ya3MYASADKAsyEi5kb2NrZXIudjEuTmV0d29yayKkAwoUQ3JlYXRlTmV0d29ya1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZkcml2ZXIYAiABKAkSPQoHb3B0aW9ucxgDIAMoCzIsLmRvY2tlci52MS5DcmVhdGVOZXR3b3JrUmVxdWVzdC5PcHRpb25zRW50cnkSDgoGc3VibmV0GAQgASgJEg8KB2dhdGV3YXkYBSABKAkSDwoHaXBSYW5nZRgGIAEoCRIQCghpbnRlcm5hbBgHIAEoCBISCgphdHRhY2hhYmxlGAggASgIEhIKCmVuYWJsZUlwdjYYCSABKAgSEgoKaXB2NlN1Ym5ldBgKIAEoCRITCgtpcHY2R2F0ZXdheRgLIAEoCRI7CgZsYWJlbHMYDCADKAsyKy5kb2NrZXIudjEuQ3JlYXRlTmV0d29ya1JlcXVlc3QuTGFiZWxzRW50cnkaLgoMT3B0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI8ChVDcmVhdGVOZXR3b3JrUmVzcG9uc2USIwoHbmV0d29yaxgBIAEoCzISLmRvY2tlci52MS5OZXR3b3JrIjkKFERlbGV0ZU5ldHdvcmtSZXF1ZXN0EhIKCm5ldHdvcmtJZHMYASADKAkSDQoFcHJ1bmUYAiABKAgiFwoVRGVsZXRlTmV0d29ya1Jlc3BvbnNlIkMKF05ldHdvcmtUb3BvbG9neVJlc3BvbnNlEigKCG5ldHdvcmtzGAEgAygLMhYuZG9ja2VyLnYxLk5ldHdvcmtOb2RlIoIBCgtOZXR3b3JrTm9kZRIjCgduZXR3b3JrGAEgASgLMhIuZG9ja2VyLnYxLk5ldHdvcmsSLQoJZW5kcG9pbnRzGAIgAygLMhouZG9ja2VyLnYxLk5ldHdvcmtFbmRwb2ludBIOCgZ1bnVzZWQYAyABKAgSDwoHYnVpbHRpbhgEIAEoCCLLAQoPTmV0d29ya0VuZHBvaW50EhMKC2NvbnRhaW5lcklkGAEgASgJEhUKDWNvbnRhaW5lck5hbWUYAiABKAkSDQoFc3RhdGUYAyABKAkSEwoLaXB2NEFkZHJlc3MYBCABKAkSEwoLaXB2NkFkZHJlc3MYBSABKAkSEgoKbWFjQWRkcmVzcxgGIAEoCRIPCgdhbGlhc2VzGAcgAygJEhYKDmNvbXBvc2VQcm9qZWN0GAggASgJEhYKDmNvbXBvc2VTZXJ2aWNlGAkgASgJInoKFU5ldHdvcmtDb25uZWN0UmVxdWVzdBIRCgluZXR3b3JrSWQYASABKAkSEwoLY29udGFpbmVySWQYAiABKAkSDwoHYWxpYXNlcxgDIAMoCRITCgtpcHY0QWRkcmVzcxgEIAEoCRITCgtpcHY2QWRkcmVzcxgFIAEoCSJRChhOZXR3b3JrRGlzY29ubmVjdFJlcXVlc3QSEQoJbmV0d29ya0lkGAEgASgJEhMKC2NvbnRhaW5lcklkGAIgASgJEg0KBWZvcmNlGAMgASgIIisKFENvbnRhaW5lckxvZ3NSZXF1ZXN0EhMKC2NvbnRhaW5lcklEGAEgASgJIh4KC0xvZ3NNZXNzYWdlEg8KB21lc3NhZ2UYASABKAki4wEKEUltYWdlQnVpbGRSZXF1ZXN0EgsKA2RpchgBIAEoCRISCgpkb2NrZXJmaWxlGAIgASgJEgwKBHRhZ3MYAyADKAkSPgoJYnVpbGRBcmdzGAQgAygLMisuZG9ja2VyLnYxLkltYWdlQnVpbGRSZXF1ZXN0LkJ1aWxkQXJnc0VudHJ5Eg4KBnRhcmdldBgFIAEoCRIMCgRwdWxsGAYgASgIEg8KB25vQ2FjaGUYByABKAgaMAoOQnVpbGRBcmdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJFChBJbWFnZVB1c2hSZXF1ZXN0Eg0KBWltYWdlGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJIiIKEEltYWdlU2F2ZVJlcXVlc3QSDgoGaW1hZ2VzGAEgAygJIk4KFEltYWdlVHJhbnNmZXJSZXF1ZXN0Eg4KBmltYWdlcxgBIAMoCRISCgpzb3VyY2VIb3N0GAIgASgJEhIKCnRhcmdldEhvc3QYAyABKAkiiQEKDUltYWdlUHJvZ3Jlc3MSCgoCaWQYASABKAkSDgoGc3RhdHVzGAIgASgJEg4KBnN0cmVhbRgDIAEoCRIQCghwcm9ncmVzcxgEIAEoCRIPCgdjdXJyZW50GAUgASgDEg0KBXRvdGFsGAYgASgDEg0KBWVycm9yGAcgASgJEgsKA2F1eBgIIAEoCSI9ChlDb250YWluZXJMaXN0RmlsZXNSZXF1ZXN0EhMKC2NvbnRhaW5lcklEGAEgASgJEgsKA2RpchgCIAEoCSJUChpDb250YWluZXJMaXN0RmlsZXNSZXNwb25zZRIjCgVmaWxlcxgBIAMoCzIULmRvY2tlci52MS5GaWxlRW50cnkSEQoJdHJ1bmNhdGVkGAIgASgIIjkKFENvbnRhaW5lckZpbGVSZXF1ZXN0EhMKC2NvbnRhaW5lcklEGAEgASgJEgwKBHBhdGgYAiABKAkiLgoMQXJjaGl2ZUNodW5rEhAKCGZpbGVuYW1lGAEgASgJEgwKBGRhdGEYAiABKAwiWwoWQ29udGFpbmVyVXBsb2FkUmVxdWVzdBITCgtjb250YWluZXJJRBgBIAEoCRIMCgRwYXRoGAIgASgJEhAKCGNvbnRlbnRzGAMgASgMEgwKBG1vZGUYBCABKA0iZQoNU3RhdHNSZXNwb25zZRIlCgZzeXN0ZW0YASABKAsyFS5kb2NrZXIudjEuU3lzdGVtSW5mbxItCgpjb250YWluZXJzGAIgAygLMhkuZG9ja2VyLnYxLkNvbnRhaW5lclN0YXRzInwKDFN0YXRzUmVxdWVzdBIkCgRmaWxlGAEgASgLMhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlEiUKBnNvcnRCeRgCIAEoDjIVLmRvY2tlci52MS5TT1JUX0ZJRUxEEh8KBW9yZGVyGAMgASgOMhAuZG9ja2VyLnYxLk9SREVSIi0KClN5c3RlbUluZm8SCwoDQ1BVGAEgASgBEhIKCm1lbUluQnl0ZXMYAiABKAQiNgoMTGlzdFJlc3BvbnNlEiYKBGxpc3QYASADKAsyGC5kb2NrZXIudjEuQ29udGFpbmVyTGlzdCLkAQoNQ29udGFpbmVyTGlzdBIKCgJpZBgBIAEoCRIPCgdpbWFnZUlEGAIgASgJEhEKCWltYWdlTmFtZRgDIAEoCRIOCgZzdGF0dXMYBCABKAkSDAoEbmFtZRgFIAEoCRIPCgdjcmVhdGVkGAYgASgJEh4KBXBvcnRzGAcgAygLMg8uZG9ja2VyLnYxLlBvcnQSEwoLc2VydmljZU5hbWUYCCABKAkSEwoLc2VydmljZVBhdGgYCSABKAkSEQoJc3RhY2tOYW1lGAogASgJEhcKD3VwZGF0ZUF2YWlsYWJsZRgLIAEoCSK6AQoOQ29udGFpbmVyU3RhdHMSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIRCgljcHVfdXNhZ2UYAyABKAESFAoMbWVtb3J5X3VzYWdlGAQgASgEEhQKDG1lbW9yeV9saW1pdBgFIAEoBBISCgpuZXR3b3JrX3J4GAYgASgEEhIKCm5ldHdvcmtfdHgYByABKAQSEgoKYmxvY2tfcmVhZBgIIAEoBBITCgtibG9ja193cml0ZRgJIAEoBCJDCgRQb3J0Eg4KBnB1YmxpYxgBIAEoBRIPCgdwcml2YXRlGAIgASgFEgwKBGhvc3QYAyABKAkSDAoEdHlwZRgEIAEoCSIHCgVFbXB0eSIoChBDb250YWluZXJSZXF1ZXN0EhQKDGNvbnRhaW5lcklkcxgBIAMoCSJAChZDb250YWluZXJJbXBvcnRSZXF1ZXN0EhQKDGNvbnRhaW5lcklkcxgBIAMoCRIQCghmaWxlbmFtZRgCIAEoCSI5ChdDb250YWluZXJJbXBvcnRSZXNwb25zZRIQCghmaWxlbmFtZRgBIAEoCRIMCgR5YW1sGAIgASgJImEKEkJ1bGtDb21wb3NlUmVxdWVzdBIOCgZhY3Rpb24YASABKAkSJgoHdGFyZ2V0cxgCIAMoCzIVLmRvY2tlci52MS5CdWxrVGFyZ2V0EhMKC2NvbmN1cnJlbmN5GAMgASgFIkAKCkJ1bGtUYXJnZXQSDAoEaG9zdBgBIAEoCRIkCgRmaWxlGAIgASgLMhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlIk8KDEJ1bGtQcm9ncmVzcxIMCgRob3N0GAEgASgJEhAKCGZpbGVuYW1lGAIgASgJEg4KBnN0YXR1cxgDIAEoCRIPCgdtZXNzYWdlGAQgASgJIloKE0NvbXBvc2VCdWlsZFJlcXVlc3QSJAoEZmlsZRgBIAEoCzIWLmRvY2tlci52MS5Db21wb3NlRmlsZRIMCgRwdWxsGAIgASgIEg8KB25vQ2FjaGUYAyABKAgiXwoLQ29tcG9zZUZpbGUSEAoIZmlsZW5hbWUYASABKAkSGAoQc2VsZWN0ZWRTZXJ2aWNlcxgCIAMoCRISCgpleHRyYUZpbGVzGAMgAygJEhAKCHByb2ZpbGVzGAQgAygJKmAKClNPUlRfRklFTEQSCAoETkFNRRAAEgcKA0NQVRABEgcKA01FTRACEg4KCk5FVFdPUktfUlgQAxIOCgpORVRXT1JLX1RYEAQSCgoGRElTS19SEAUSCgoGRElTS19XEAYqGQoFT1JERVISBwoDRFNDEAASBwoDQVNDEAEy7x8KDURvY2tlclNlcnZpY2USRwoOQ29udGFpbmVyU3RhcnQSGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAEkYKDUNvbnRhaW5lclN0b3ASGy5kb2NrZXIudjEuQ29udGFpbmVyUmVxdWVzdBoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAEkgKD0NvbnRhaW5lclJlbW92ZRIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgASSQoQQ29udGFpbmVyUmVzdGFydBIbLmRvY2tlci52MS5Db250YWluZXJSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgASQgoPQ29udGFpbmVyVXBkYXRlEhsuZG9ja2VyLnYxLkNvbnRhaW5lclJlcXVlc3QaEC5kb2NrZXIudjEuRW1wdHkiABJaCg9Db250YWluZXJJbXBvcnQSIS5kb2NrZXIudjEuQ29udGFpbmVySW1wb3J0UmVxdWVzdBoiLmRvY2tlci52MS5Db250YWluZXJJbXBvcnRSZXNwb25zZSIAEj8KDUNvbnRhaW5lckxpc3QSEC5kb2NrZXIudjEuRW1wdHkaFy5kb2NrZXIudjEuTGlzdFJlc3BvbnNlIgOQAgESSAoOQ29udGFpbmVyU3RhdHMSFy5kb2NrZXIudjEuU3RhdHNSZXF1ZXN0GhguZG9ja2VyLnYxLlN0YXRzUmVzcG9uc2UiA5ACARJPCg1Db250YWluZXJMb2dzEh8uZG9ja2VyLnYxLkNvbnRhaW5lckxvZ3NSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgOQAgEwARJSChNDb250YWluZXJFeGVjT3V0cHV0Eh8uZG9ja2VyLnYxLkNvbnRhaW5lckV4ZWNSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJKChJDb250YWluZXJFeGVjSW5wdXQSIC5kb2NrZXIudjEuQ29udGFpbmVyRXhlY0NtZElucHV0GhAuZG9ja2VyLnYxLkVtcHR5IgASZgoSQ29udGFpbmVyTGlzdEZpbGVzEiQuZG9ja2VyLnYxLkNvbnRhaW5lckxpc3RGaWxlc1JlcXVlc3QaJS5kb2NrZXIudjEuQ29udGFpbmVyTGlzdEZpbGVzUmVzcG9uc2UiA5ACARJUChFDb250YWluZXJEb3dubG9hZBIfLmRvY2tlci52MS5Db250YWluZXJGaWxlUmVxdWVzdBoXLmRvY2tlci52MS5BcmNoaXZlQ2h1bmsiA5ACATABEkgKD0NvbnRhaW5lclVwbG9hZBIhLmRvY2tlci52MS5Db250YWluZXJVcGxvYWRSZXF1ZXN0GhAuZG9ja2VyLnYxLkVtcHR5IgASQgoMQ29tcG9zZVN0YXJ0EhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJBCgtDb21wb3NlU3RvcBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESQwoNQ29tcG9zZVJlbW92ZRIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESRAoOQ29tcG9zZVJlc3RhcnQSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkoKDENvbXBvc2VCdWlsZBIeLmRvY2tlci52MS5Db21wb3NlQnVpbGRSZXF1ZXN0GhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJDCg1Db21wb3NlVXBkYXRlEhYuZG9ja2VyLnYxLkNvbXBvc2VGaWxlGhYuZG9ja2VyLnYxLkxvZ3NNZXNzYWdlIgAwARJDCgtDb21wb3NlTGlzdBIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoXLmRvY2tlci52MS5MaXN0UmVzcG9uc2UiA5ACARJSCg9Db21wb3NlVmFsaWRhdGUSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaIi5kb2NrZXIudjEuQ29tcG9zZVZhbGlkYXRlUmVzcG9uc2UiA5ACARJMCg9Db21wb3NlT3ZlcnZpZXcSEC5kb2NrZXIudjEuRW1wdHkaIi5kb2NrZXIudjEuQ29tcG9zZU92ZXJ2aWV3UmVzcG9uc2UiA5ACARJMCgxDb21wb3NlRHJpZnQSFi5kb2NrZXIudjEuQ29tcG9zZUZpbGUaHy5kb2NrZXIudjEuQ29tcG9zZURyaWZ0UmVzcG9uc2UiA5ACARJKCgtDb21wb3NlUGxhbhIWLmRvY2tlci52MS5Db21wb3NlRmlsZRoeLmRvY2tlci52MS5Db21wb3NlUGxhblJlc3BvbnNlIgOQAgESTgoNQ29tcG9zZUNvbmZpZxIWLmRvY2tlci52MS5Db21wb3NlRmlsZRogLmRvY2tlci52MS5Db21wb3NlQ29uZmlnUmVzcG9uc2UiA5ACARJCCgpTdGFja0dyYXBoEhAuZG9ja2VyLnYxLkVtcHR5Gh0uZG9ja2VyLnYxLlN0YWNrR3JhcGhSZXNwb25zZSIDkAIBEj8KD0NvbXBvc2VTdGFydEFsbBIQLmRvY2tlci52MS5FbXB0eRoWLmRvY2tlci52MS5Mb2dzTWVzc2FnZSIAMAESPgoOQ29tcG9zZVN0b3BBbGwSEC5kb2NrZXIudjEuRW1wdHkaFi5kb2NrZXIudjEuTG9nc01lc3NhZ2UiADABEkkKC0NvbXBvc2VCdWxrEh0uZG9ja2VyLnYxLkJ1bGtDb21wb3NlUmVxdWVzdBoXLmRvY2tlci52MS5CdWxrUHJvZ3Jlc3MiADABEk0KCUltYWdlTGlzdBIcLmRvY2tlci52MS5MaXN0SW1hZ2VzUmVxdWVzdBodLmRvY2tlci52MS5MaXN0SW1hZ2VzUmVzcG9uc2UiA5ACARJOCgtJbWFnZVJlbW92ZRIdLmRvY2tlci52MS5SZW1vdmVJbWFnZVJlcXVlc3QaHi5kb2NrZXIudjEuUmVtb3ZlSW1hZ2VSZXNwb25zZSIAElEKEEltYWdlUHJ1bmVVbnVzZWQSHC5kb2NrZXIudjEuSW1hZ2VQcnVuZVJlcXVlc3QaHS5kb2NrZXIudjEuSW1hZ2VQcnVuZVJlc3BvbnNlIgASSwoJRGlza1VzYWdlEhsuZG9ja2VyLnYxLkRpc2tVc2FnZVJlcXVlc3QaHC5kb2NrZXIudjEuRGlza1VzYWdlUmVzcG9uc2UiA5ACARI8CgVQcnVuZRIXLmRvY2tlci52MS5QcnVuZVJlcXVlc3QaGC5kb2NrZXIudjEuUHJ1bmVSZXNwb25zZSIAEkgKCkltYWdlQnVpbGQSHC5kb2NrZXIudjEuSW1hZ2VCdWlsZFJlcXVlc3QaGC5kb2NrZXIudjEuSW1hZ2VQcm9ncmVzcyIAMAESRgoJSW1hZ2VQdXNoEhsuZG9ja2VyLnYxLkltYWdlUHVzaFJlcXVlc3QaGC5kb2NrZXIudjEuSW1hZ2VQcm9ncmVzcyIAMAESSAoJSW1hZ2VTYXZlEhsuZG9ja2VyLnYxLkltYWdlU2F2ZVJlcXVlc3QaFy5kb2NrZXIudjEuQXJjaGl2ZUNodW5rIgOQAgEwARJOCg1JbWFnZVRyYW5zZmVyEh8uZG9ja2VyLnYxLkltYWdlVHJhbnNmZXJSZXF1ZXN0GhguZG9ja2VyLnYxLkltYWdlUHJvZ3Jlc3MiADABElAKClZvbHVtZUxpc3QSHS5kb2NrZXIudjEuTGlzdFZvbHVtZXNSZXF1ZXN0Gh4uZG9ja2VyLnYxLkxpc3RWb2x1bWVzUmVzcG9uc2UiA5ACARJRCgxWb2x1bWVDcmVhdGUSHi5kb2NrZXIudjEuQ3JlYXRlVm9sdW1lUmVxdWVzdBofLmRvY2tlci52MS5DcmVhdGVWb2x1bWVSZXNwb25zZSIAElEKDFZvbHVtZURlbGV0ZRIeLmRvY2tlci52MS5EZWxldGVWb2x1bWVSZXF1ZXN0Gh8uZG9ja2VyLnYxLkRlbGV0ZVZvbHVtZVJlc3BvbnNlIgASXQoPVm9sdW1lTGlzdEZpbGVzEiEuZG9ja2VyLnYxLlZvbHVtZUxpc3RGaWxlc1JlcXVlc3QaIi5kb2NrZXIudjEuVm9sdW1lTGlzdEZpbGVzUmVzcG9uc2UiA5ACARJSCg5Wb2x1bWVSZWFkRmlsZRIcLmRvY2tlci52MS5Wb2x1bWVGaWxlUmVxdWVzdBodLmRvY2tlci52MS5Wb2x1bWVGaWxlQ29udGVudHMiA5ACARJICg9Wb2x1bWVXcml0ZUZpbGUSIS5kb2NrZXIudjEuVm9sdW1lV3JpdGVGaWxlUmVxdWVzdBoQLmRvY2tlci52MS5FbXB0eSIAEkoKEFZvbHVtZURlbGV0ZUZpbGUSIi5kb2NrZXIudjEuVm9sdW1lRGVsZXRlRmlsZVJlcXVlc3QaEC5kb2NrZXIudjEuRW1wdHkiABJTCgtOZXR3b3JrTGlzdBIeLmRvY2tlci52MS5MaXN0TmV0d29ya3NSZXF1ZXN0Gh8uZG9ja2VyLnYxLkxpc3ROZXR3b3Jrc1Jlc3BvbnNlIgOQAgESVAoNTmV0d29ya0NyZWF0ZRIfLmRvY2tlci52MS5DcmVhdGVOZXR3b3JrUmVxdWVzdBogLmRvY2tlci52MS5DcmVhdGVOZXR3b3JrUmVzcG9uc2UiABJUCg1OZXR3b3JrRGVsZXRlEh8uZG9ja2VyLnYxLkRlbGV0ZU5ldHdvcmtSZXF1ZXN0GiAuZG9ja2VyLnYxLkRlbGV0ZU5ldHdvcmtSZXNwb25zZSIAEkwKD05ldHdvcmtUb3BvbG9neRIQLmRvY2tlci52MS5FbXB0eRoiLmRvY2tlci52MS5OZXR3b3JrVG9wb2xvZ3lSZXNwb25zZSIDkAIBEkYKDk5ldHdvcmtDb25uZWN0EiAuZG9ja2VyLnYxLk5ldHdvcmtDb25uZWN0UmVxdWVzdBoQLmRvY2tlci52MS5FbXB0eSIAEkwKEU5ldHdvcmtEaXNjb25uZWN0EiMuZG9ja2VyLnYxLk5ldHdvcmtEaXNjb25uZWN0UmVxdWVzdBoQLmRvY2tlci52MS5FbXB0eSIAEkEKBkV2ZW50cxIYLmRvY2tlci52MS5FdmVudHNSZXF1ZXN0GhYuZG9ja2VyLnYxLkRvY2tlckV2ZW50IgOQAgEwAUKPAQoNY29tLmRvY2tlci52MUILRG9ja2VyUHJvdG9QAVosZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9kb2NrZXIvdjGiAgNEWFiqAglEb2NrZXIuVjHKAglEb2NrZXJcVjHiAhVEb2NrZXJcVjFcR1BCTWV0YWRhdGHqAgpEb2NrZXI6OlYxYgZwcm90bzM");
 
 /**
- * @generated from message docker.v1.ComposeValidateResponse
+ * @generated from message docker.v1.EventsRequest
  */
-export type ComposeValidateResponse = Message<"docker.v1.ComposeValidateResponse"> & {
+export type EventsRequest = Message<"docker.v1.EventsRequest"> & {
   /**
-   * @generated from field: repeated string errs = 1;
+   * container|image|network|volume, empty for all
+   *
+   * @generated from field: repeated string types = 1;
    */
-  errs: string[];
+  types: string[];
+
+  /**
+   * only send events for this compose project, empty for all
+   *
+   * @generated from field: string stackName = 2;
+   */
+  stackName: string;
 };
 
 /**
- * Describes the message docker.v1.ComposeValidateResponse.
- * Use `create(ComposeValidateResponseSchema)` to create a new message.
+ * Describes the message docker.v1.EventsRequest.
+ * Use `create(EventsRequestSchema)` to create a new message.
  */
-export const ComposeValidateResponseSchema: GenMessage<ComposeValidateResponse> = /*@__PURE__*/
+export const EventsRequestSchema: GenMessage<EventsRequest> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 0);
 
 /**
- * forwards commands from user to a running session
- *
- * @generated from message docker.v1.ContainerExecCmdInput
+ * @generated from message docker.v1.DockerEvent
  */
-export type ContainerExecCmdInput = Message<"docker.v1.ContainerExecCmdInput"> & {
+export type DockerEvent = Message<"docker.v1.DockerEvent"> & {
   /**
-   * @generated from field: string userCmd = 1;
+   * container|image|network|volume
+   *
+   * @generated from field: string type = 1;
    */
-  userCmd: string;
+  type: string;
 
   /**
-   * @generated from field: string containerID = 2;
+   * create|start|die|destroy|pull|connect etc
+   *
+   * @generated from field: string action = 2;
    */
-  containerID: string;
+  action: string;
+
+  /**
+   * @generated from field: string actorID = 3;
+   */
+  actorID: string;
+
+  /**
+   * name of the container/image/network/volume
+   *
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * @generated from field: map<string, string> attributes = 5;
+   */
+  attributes: { [key: string]: string };
+
+  /**
+   * @generated from field: string stackName = 6;
+   */
+  stackName: string;
+
+  /**
+   * @generated from field: string serviceName = 7;
+   */
+  serviceName: string;
+
+  /**
+   * @generated from field: string time = 8;
+   */
+  time: string;
+
+  /**
+   * @generated from field: string host = 9;
+   */
+  host: string;
 };
 
 /**
- * Describes the message docker.v1.ContainerExecCmdInput.
- * Use `create(ContainerExecCmdInputSchema)` to create a new message.
+ * Describes the message docker.v1.DockerEvent.
+ * Use `create(DockerEventSchema)` to create a new message.
  */
-export const ContainerExecCmdInputSchema: GenMessage<ContainerExecCmdInput> = /*@__PURE__*/
+export const DockerEventSchema: GenMessage<DockerEvent> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 1);
 
 /**
- * @generated from message docker.v1.ContainerExecRequest
+ * @generated from message docker.v1.ComposeOverviewResponse
  */
-export type ContainerExecRequest = Message<"docker.v1.ContainerExecRequest"> & {
-  /**
-   * @generated from field: string containerID = 1;
-   */
-  containerID: string;
-
+export type ComposeOverviewResponse = Message<"docker.v1.ComposeOverviewResponse"> & {
   /**
-   * if not provided uses '/bin/sh' as default
-   *
-   * @generated from field: repeated string execCmd = 2;
+   * @generated from field: repeated docker.v1.StackStatus stacks = 1;
    */
-  execCmd: string[];
+  stacks: StackStatus[];
 };
 
 /**
- * Describes the message docker.v1.ContainerExecRequest.
- * Use `create(ContainerExecRequestSchema)` to create a new message.
+ * Describes the message docker.v1.ComposeOverviewResponse.
+ * Use `create(ComposeOverviewResponseSchema)` to create a new message.
  */
-export const ContainerExecRequestSchema: GenMessage<ContainerExecRequest> = /*@__PURE__*/
+export const ComposeOverviewResponseSchema: GenMessage<ComposeOverviewResponse> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 2);
 
 /**
- * Image-related messages
- *
- * @generated from message docker.v1.Image
+ * @generated from message docker.v1.StackStatus
  */
-export type Image = Message<"docker.v1.Image"> & {
+export type StackStatus = Message<"docker.v1.StackStatus"> & {
   /**
-   * @generated from field: int64 containers = 1;
+   * @generated from field: string filename = 1;
    */
-  containers: bigint;
+  filename: string;
 
   /**
-   * @generated from field: int64 created = 2;
+   * @generated from field: string stackName = 2;
    */
-  created: bigint;
+  stackName: string;
 
   /**
-   * @generated from field: string id = 3;
+   * running|partial|stopped|not_deployed|invalid
+   *
+   * @generated from field: string state = 3;
    */
-  id: string;
+  state: string;
 
   /**
-   * @generated from field: map<string, string> labels = 4;
+   * @generated from field: int32 expectedServices = 4;
    */
-  labels: { [key: string]: string };
+  expectedServices: number;
 
   /**
-   * @generated from field: string parent_id = 5;
+   * @generated from field: int32 runningServices = 5;
    */
-  parentId: string;
+  runningServices: number;
 
   /**
-   * @generated from field: repeated docker.v1.ManifestSummary manifests = 7;
+   * services whose container runs a different image than the one currently tagged
+   *
+   * @generated from field: repeated string driftedServices = 6;
    */
-  manifests: ManifestSummary[];
+  driftedServices: string[];
 
   /**
-   * @generated from field: repeated string repo_digests = 8;
+   * @generated from field: repeated string unhealthyServices = 7;
    */
-  repoDigests: string[];
+  unhealthyServices: string[];
 
   /**
-   * @generated from field: repeated string repo_tags = 9;
+   * set when the file could not be loaded
+   *
+   * @generated from field: string error = 8;
    */
-  repoTags: string[];
+  error: string;
+};
 
-  /**
-   * @generated from field: int64 shared_size = 10;
-   */
-  sharedSize: bigint;
+/**
+ * Describes the message docker.v1.StackStatus.
+ * Use `create(StackStatusSchema)` to create a new message.
+ */
+export const StackStatusSchema: GenMessage<StackStatus> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 3);
 
+/**
+ * @generated from message docker.v1.ComposeDriftResponse
+ */
+export type ComposeDriftResponse = Message<"docker.v1.ComposeDriftResponse"> & {
   /**
-   * @generated from field: int64 size = 11;
+   * true if any service is not in sync
+   *
+   * @generated from field: bool drifted = 1;
    */
-  size: bigint;
+  drifted: boolean;
 
   /**
-   * @generated from field: string updateRef = 12;
+   * @generated from field: repeated docker.v1.ServiceDrift services = 2;
    */
-  updateRef: string;
+  services: ServiceDrift[];
 };
 
 /**
- * Describes the message docker.v1.Image.
- * Use `create(ImageSchema)` to create a new message.
+ * Describes the message docker.v1.ComposeDriftResponse.
+ * Use `create(ComposeDriftResponseSchema)` to create a new message.
  */
-export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 3);
+export const ComposeDriftResponseSchema: GenMessage<ComposeDriftResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 4);
 
 /**
- * @generated from message docker.v1.ManifestSummary
+ * @generated from message docker.v1.ServiceDrift
  */
-export type ManifestSummary = Message<"docker.v1.ManifestSummary"> & {
+export type ServiceDrift = Message<"docker.v1.ServiceDrift"> & {
   /**
-   * @generated from field: string digest = 1;
+   * @generated from field: string service = 1;
    */
-  digest: string;
+  service: string;
 
   /**
-   * @generated from field: string media_type = 2;
+   * @generated from field: string containerName = 2;
    */
-  mediaType: string;
+  containerName: string;
 
   /**
-   * @generated from field: int64 size = 3;
+   * in_sync|drifted|missing|orphan
+   *
+   * @generated from field: string state = 3;
    */
-  size: bigint;
-};
+  state: string;
 
-/**
- * Describes the message docker.v1.ManifestSummary.
- * Use `create(ManifestSummarySchema)` to create a new message.
- */
-export const ManifestSummarySchema: GenMessage<ManifestSummary> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 4);
+  /**
+   * @generated from field: string expectedHash = 4;
+   */
+  expectedHash: string;
 
-/**
- * @generated from message docker.v1.ListImagesRequest
- */
-export type ListImagesRequest = Message<"docker.v1.ListImagesRequest"> & {
+  /**
+   * @generated from field: string actualHash = 5;
+   */
+  actualHash: string;
+
+  /**
+   * @generated from field: repeated docker.v1.FieldDiff diffs = 6;
+   */
+  diffs: FieldDiff[];
 };
 
 /**
- * Describes the message docker.v1.ListImagesRequest.
- * Use `create(ListImagesRequestSchema)` to create a new message.
+ * Describes the message docker.v1.ServiceDrift.
+ * Use `create(ServiceDriftSchema)` to create a new message.
  */
-export const ListImagesRequestSchema: GenMessage<ListImagesRequest> = /*@__PURE__*/
+export const ServiceDriftSchema: GenMessage<ServiceDrift> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 5);
 
 /**
- * @generated from message docker.v1.ListImagesResponse
+ * @generated from message docker.v1.FieldDiff
  */
-export type ListImagesResponse = Message<"docker.v1.ListImagesResponse"> & {
-  /**
-   * @generated from field: int64 totalDiskUsage = 1;
-   */
-  totalDiskUsage: bigint;
-
+export type FieldDiff = Message<"docker.v1.FieldDiff"> & {
   /**
-   * @generated from field: int64 unusedImageCount = 2;
+   * @generated from field: string field = 1;
    */
-  unusedImageCount: bigint;
+  field: string;
 
   /**
-   * @generated from field: int64 untaggedImageCount = 3;
+   * @generated from field: string expected = 2;
    */
-  untaggedImageCount: bigint;
+  expected: string;
 
   /**
-   * @generated from field: repeated docker.v1.Image images = 4;
+   * @generated from field: string actual = 3;
    */
-  images: Image[];
+  actual: string;
 };
 
 /**
- * Describes the message docker.v1.ListImagesResponse.
- * Use `create(ListImagesResponseSchema)` to create a new message.
+ * Describes the message docker.v1.FieldDiff.
+ * Use `create(FieldDiffSchema)` to create a new message.
  */
-export const ListImagesResponseSchema: GenMessage<ListImagesResponse> = /*@__PURE__*/
+export const FieldDiffSchema: GenMessage<FieldDiff> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 6);
 
 /**
- * @generated from message docker.v1.RemoveImageRequest
+ * @generated from message docker.v1.ComposePlanResponse
  */
-export type RemoveImageRequest = Message<"docker.v1.RemoveImageRequest"> & {
+export type ComposePlanResponse = Message<"docker.v1.ComposePlanResponse"> & {
   /**
-   * @generated from field: repeated string imageIds = 1;
+   * false if applying the plan would not change anything
+   *
+   * @generated from field: bool hasChanges = 1;
    */
-  imageIds: string[];
-};
+  hasChanges: boolean;
 
-/**
- * Describes the message docker.v1.RemoveImageRequest.
- * Use `create(RemoveImageRequestSchema)` to create a new message.
- */
-export const RemoveImageRequestSchema: GenMessage<RemoveImageRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 7);
+  /**
+   * @generated from field: repeated docker.v1.PlannedContainer containers = 2;
+   */
+  containers: PlannedContainer[];
 
-/**
- * @generated from message docker.v1.RemoveImageResponse
- */
-export type RemoveImageResponse = Message<"docker.v1.RemoveImageResponse"> & {
+  /**
+   * @generated from field: repeated string pullImages = 3;
+   */
+  pullImages: string[];
+
+  /**
+   * @generated from field: repeated string createNetworks = 4;
+   */
+  createNetworks: string[];
+
+  /**
+   * @generated from field: repeated string createVolumes = 5;
+   */
+  createVolumes: string[];
 };
 
 /**
- * Describes the message docker.v1.RemoveImageResponse.
- * Use `create(RemoveImageResponseSchema)` to create a new message.
+ * Describes the message docker.v1.ComposePlanResponse.
+ * Use `create(ComposePlanResponseSchema)` to create a new message.
  */
-export const RemoveImageResponseSchema: GenMessage<RemoveImageResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 8);
+export const ComposePlanResponseSchema: GenMessage<ComposePlanResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 7);
 
 /**
- * @generated from message docker.v1.ImagePruneResponse
+ * @generated from message docker.v1.PlannedContainer
  */
-export type ImagePruneResponse = Message<"docker.v1.ImagePruneResponse"> & {
+export type PlannedContainer = Message<"docker.v1.PlannedContainer"> & {
   /**
-   * @generated from field: uint64 SpaceReclaimed = 1;
+   * @generated from field: string service = 1;
    */
-  SpaceReclaimed: bigint;
+  service: string;
 
   /**
-   * @generated from field: repeated docker.v1.ImagesDeleted deleted = 2;
+   * empty for containers that will be created
+   *
+   * @generated from field: string containerName = 2;
    */
-  deleted: ImagesDeleted[];
-};
+  containerName: string;
 
-/**
- * Describes the message docker.v1.ImagePruneResponse.
- * Use `create(ImagePruneResponseSchema)` to create a new message.
- */
-export const ImagePruneResponseSchema: GenMessage<ImagePruneResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 9);
+  /**
+   * create|recreate|remove|start|unchanged
+   *
+   * @generated from field: string action = 3;
+   */
+  action: string;
 
-/**
- * @generated from message docker.v1.ImagePruneRequest
- */
-export type ImagePruneRequest = Message<"docker.v1.ImagePruneRequest"> & {
   /**
-   * @generated from field: bool pruneAll = 1;
+   * @generated from field: string reason = 4;
    */
-  pruneAll: boolean;
+  reason: string;
 };
 
 /**
- * Describes the message docker.v1.ImagePruneRequest.
- * Use `create(ImagePruneRequestSchema)` to create a new message.
+ * Describes the message docker.v1.PlannedContainer.
+ * Use `create(PlannedContainerSchema)` to create a new message.
  */
-export const ImagePruneRequestSchema: GenMessage<ImagePruneRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 10);
+export const PlannedContainerSchema: GenMessage<PlannedContainer> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 8);
 
 /**
- * @generated from message docker.v1.ImagesDeleted
+ * @generated from message docker.v1.ComposeConfigResponse
  */
-export type ImagesDeleted = Message<"docker.v1.ImagesDeleted"> & {
+export type ComposeConfigResponse = Message<"docker.v1.ComposeConfigResponse"> & {
   /**
-   * @generated from field: string Deleted = 1;
+   * @generated from field: string yaml = 1;
    */
-  Deleted: string;
+  yaml: string;
 
   /**
-   * @generated from field: string Untagged = 2;
+   * @generated from field: repeated docker.v1.ConfigVariable variables = 2;
    */
-  Untagged: string;
+  variables: ConfigVariable[];
 };
 
 /**
- * Describes the message docker.v1.ImagesDeleted.
- * Use `create(ImagesDeletedSchema)` to create a new message.
+ * Describes the message docker.v1.ComposeConfigResponse.
+ * Use `create(ComposeConfigResponseSchema)` to create a new message.
  */
-export const ImagesDeletedSchema: GenMessage<ImagesDeleted> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 11);
+export const ComposeConfigResponseSchema: GenMessage<ComposeConfigResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 9);
 
 /**
- * Volume-related messages
- *
- * @generated from message docker.v1.Volume
+ * @generated from message docker.v1.ConfigVariable
  */
-export type Volume = Message<"docker.v1.Volume"> & {
+export type ConfigVariable = Message<"docker.v1.ConfigVariable"> & {
   /**
    * @generated from field: string name = 1;
    */
   name: string;
 
   /**
-   * @generated from field: string containerID = 2;
+   * @generated from field: string value = 2;
    */
-  containerID: string;
+  value: string;
 
   /**
-   * @generated from field: string createdAt = 3;
+   * root_env|folder_env|os_env|default|unset
+   *
+   * @generated from field: string source = 3;
    */
-  createdAt: string;
+  source: string;
 
   /**
-   * @generated from field: string mountPoint = 4;
+   * @generated from field: string default = 4;
    */
-  mountPoint: string;
+  default: string;
 
   /**
-   * @generated from field: int64 size = 5;
+   * @generated from field: bool required = 5;
    */
-  size: bigint;
+  required: boolean;
+};
+
+/**
+ * Describes the message docker.v1.ConfigVariable.
+ * Use `create(ConfigVariableSchema)` to create a new message.
+ */
+export const ConfigVariableSchema: GenMessage<ConfigVariable> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 10);
 
+/**
+ * @generated from message docker.v1.StackGraphResponse
+ */
+export type StackGraphResponse = Message<"docker.v1.StackGraphResponse"> & {
   /**
-   * @generated from field: string labels = 6;
+   * @generated from field: repeated docker.v1.GraphNode nodes = 1;
    */
-  labels: string;
+  nodes: GraphNode[];
 
   /**
-   * @generated from field: string composePath = 7;
+   * @generated from field: repeated docker.v1.GraphEdge edges = 2;
    */
-  composePath: string;
+  edges: GraphEdge[];
 
   /**
-   * @generated from field: string composeProjectName = 8;
+   * compose files in start order, stop order is the reverse
+   *
+   * @generated from field: repeated string startOrder = 3;
    */
-  composeProjectName: string;
+  startOrder: string[];
+
+  /**
+   * set if the stacks depend on each other in a cycle
+   *
+   * @generated from field: string orderError = 4;
+   */
+  orderError: string;
 };
 
 /**
- * Describes the message docker.v1.Volume.
- * Use `create(VolumeSchema)` to create a new message.
+ * Describes the message docker.v1.StackGraphResponse.
+ * Use `create(StackGraphResponseSchema)` to create a new message.
  */
-export const VolumeSchema: GenMessage<Volume> = /*@__PURE__*/
+export const StackGraphResponseSchema: GenMessage<StackGraphResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 11);
+
+/**
+ * @generated from message docker.v1.GraphNode
+ */
+export type GraphNode = Message<"docker.v1.GraphNode"> & {
+  /**
+   * compose file for stacks, file:service for services
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * stack|service|external
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * set if the stack could not be loaded
+   *
+   * @generated from field: string error = 4;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message docker.v1.GraphNode.
+ * Use `create(GraphNodeSchema)` to create a new message.
+ */
+export const GraphNodeSchema: GenMessage<GraphNode> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 12);
 
 /**
- * @generated from message docker.v1.ListVolumesRequest
+ * @generated from message docker.v1.GraphEdge
  */
-export type ListVolumesRequest = Message<"docker.v1.ListVolumesRequest"> & {
+export type GraphEdge = Message<"docker.v1.GraphEdge"> & {
+  /**
+   * @generated from field: string from = 1;
+   */
+  from: string;
+
+  /**
+   * @generated from field: string to = 2;
+   */
+  to: string;
+
+  /**
+   * depends_on|contains|network|volume|shared_path
+   *
+   * @generated from field: string kind = 3;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string label = 4;
+   */
+  label: string;
 };
 
 /**
- * Describes the message docker.v1.ListVolumesRequest.
- * Use `create(ListVolumesRequestSchema)` to create a new message.
+ * Describes the message docker.v1.GraphEdge.
+ * Use `create(GraphEdgeSchema)` to create a new message.
  */
-export const ListVolumesRequestSchema: GenMessage<ListVolumesRequest> = /*@__PURE__*/
+export const GraphEdgeSchema: GenMessage<GraphEdge> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 13);
 
 /**
- * @generated from message docker.v1.ListVolumesResponse
+ * @generated from message docker.v1.ComposeValidateResponse
  */
-export type ListVolumesResponse = Message<"docker.v1.ListVolumesResponse"> & {
+export type ComposeValidateResponse = Message<"docker.v1.ComposeValidateResponse"> & {
   /**
-   * @generated from field: repeated docker.v1.Volume volumes = 1;
+   * messages of all findings with error severity
+   *
+   * @generated from field: repeated string errs = 1;
    */
-  volumes: Volume[];
+  errs: string[];
+
+  /**
+   * @generated from field: repeated docker.v1.ValidationFinding findings = 2;
+   */
+  findings: ValidationFinding[];
 };
 
 /**
- * Describes the message docker.v1.ListVolumesResponse.
- * Use `create(ListVolumesResponseSchema)` to create a new message.
+ * Describes the message docker.v1.ComposeValidateResponse.
+ * Use `create(ComposeValidateResponseSchema)` to create a new message.
  */
-export const ListVolumesResponseSchema: GenMessage<ListVolumesResponse> = /*@__PURE__*/
+export const ComposeValidateResponseSchema: GenMessage<ComposeValidateResponse> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 14);
 
 /**
- * @generated from message docker.v1.CreateVolumeRequest
+ * @generated from message docker.v1.ValidationFinding
  */
-export type CreateVolumeRequest = Message<"docker.v1.CreateVolumeRequest"> & {
+export type ValidationFinding = Message<"docker.v1.ValidationFinding"> & {
+  /**
+   * error|warning
+   *
+   * @generated from field: string severity = 1;
+   */
+  severity: string;
+
+  /**
+   * load|ports|bind_mounts|networks|volumes|container_name|env_files|images
+   *
+   * @generated from field: string check = 2;
+   */
+  check: string;
+
+  /**
+   * empty if the finding concerns the whole project,
+   * comma separated if it concerns multiple services
+   *
+   * @generated from field: string service = 3;
+   */
+  service: string;
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message: string;
 };
 
 /**
- * Describes the message docker.v1.CreateVolumeRequest.
- * Use `create(CreateVolumeRequestSchema)` to create a new message.
+ * Describes the message docker.v1.ValidationFinding.
+ * Use `create(ValidationFindingSchema)` to create a new message.
  */
-export const CreateVolumeRequestSchema: GenMessage<CreateVolumeRequest> = /*@__PURE__*/
+export const ValidationFindingSchema: GenMessage<ValidationFinding> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 15);
 
 /**
- * @generated from message docker.v1.CreateVolumeResponse
+ * forwards commands from user to a running session
+ *
+ * @generated from message docker.v1.ContainerExecCmdInput
  */
-export type CreateVolumeResponse = Message<"docker.v1.CreateVolumeResponse"> & {
+export type ContainerExecCmdInput = Message<"docker.v1.ContainerExecCmdInput"> & {
+  /**
+   * @generated from field: string userCmd = 1;
+   */
+  userCmd: string;
+
+  /**
+   * @generated from field: string containerID = 2;
+   */
+  containerID: string;
 };
 
 /**
- * Describes the message docker.v1.CreateVolumeResponse.
- * Use `create(CreateVolumeResponseSchema)` to create a new message.
+ * Describes the message docker.v1.ContainerExecCmdInput.
+ * Use `create(ContainerExecCmdInputSchema)` to create a new message.
  */
-export const CreateVolumeResponseSchema: GenMessage<CreateVolumeResponse> = /*@__PURE__*/
+export const ContainerExecCmdInputSchema: GenMessage<ContainerExecCmdInput> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 16);
 
 /**
- * @generated from message docker.v1.DeleteVolumeRequest
+ * @generated from message docker.v1.ContainerExecRequest
  */
-export type DeleteVolumeRequest = Message<"docker.v1.DeleteVolumeRequest"> & {
-  /**
-   * @generated from field: repeated string volumeIds = 1;
-   */
-  volumeIds: string[];
-
+export type ContainerExecRequest = Message<"docker.v1.ContainerExecRequest"> & {
   /**
-   * @generated from field: bool anon = 2;
+   * @generated from field: string containerID = 1;
    */
-  anon: boolean;
+  containerID: string;
 
   /**
-   * @generated from field: bool unused = 3;
+   * if not provided uses '/bin/sh' as default
+   *
+   * @generated from field: repeated string execCmd = 2;
    */
-  unused: boolean;
+  execCmd: string[];
 };
 
 /**
- * Describes the message docker.v1.DeleteVolumeRequest.
- * Use `create(DeleteVolumeRequestSchema)` to create a new message.
+ * Describes the message docker.v1.ContainerExecRequest.
+ * Use `create(ContainerExecRequestSchema)` to create a new message.
  */
-export const DeleteVolumeRequestSchema: GenMessage<DeleteVolumeRequest> = /*@__PURE__*/
+export const ContainerExecRequestSchema: GenMessage<ContainerExecRequest> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 17);
 
 /**
- * @generated from message docker.v1.DeleteVolumeResponse
+ * Image-related messages
+ *
+ * @generated from message docker.v1.Image
  */
-export type DeleteVolumeResponse = Message<"docker.v1.DeleteVolumeResponse"> & {
+export type Image = Message<"docker.v1.Image"> & {
+  /**
+   * @generated from field: int64 containers = 1;
+   */
+  containers: bigint;
+
+  /**
+   * @generated from field: int64 created = 2;
+   */
+  created: bigint;
+
+  /**
+   * @generated from field: string id = 3;
+   */
+  id: string;
+
+  /**
+   * @generated from field: map<string, string> labels = 4;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * @generated from field: string parent_id = 5;
+   */
+  parentId: string;
+
+  /**
+   * @generated from field: repeated docker.v1.ManifestSummary manifests = 7;
+   */
+  manifests: ManifestSummary[];
+
+  /**
+   * @generated from field: repeated string repo_digests = 8;
+   */
+  repoDigests: string[];
+
+  /**
+   * @generated from field: repeated string repo_tags = 9;
+   */
+  repoTags: string[];
+
+  /**
+   * @generated from field: int64 shared_size = 10;
+   */
+  sharedSize: bigint;
+
+  /**
+   * @generated from field: int64 size = 11;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: string updateRef = 12;
+   */
+  updateRef: string;
 };
 
 /**
- * Describes the message docker.v1.DeleteVolumeResponse.
- * Use `create(DeleteVolumeResponseSchema)` to create a new message.
+ * Describes the message docker.v1.Image.
+ * Use `create(ImageSchema)` to create a new message.
  */
-export const DeleteVolumeResponseSchema: GenMessage<DeleteVolumeResponse> = /*@__PURE__*/
+export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
   messageDesc(file_docker_v1_docker, 18);
 
 /**
- * Network-related messages
- *
- * @generated from message docker.v1.Network
+ * @generated from message docker.v1.ManifestSummary
  */
-export type Network = Message<"docker.v1.Network"> & {
+export type ManifestSummary = Message<"docker.v1.ManifestSummary"> & {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: string digest = 1;
    */
-  name: string;
+  digest: string;
 
   /**
-   * @generated from field: string id = 2;
+   * @generated from field: string media_type = 2;
    */
-  id: string;
+  mediaType: string;
 
   /**
-   * @generated from field: string subnet = 3;
+   * @generated from field: int64 size = 3;
    */
-  subnet: string;
+  size: bigint;
+};
+
+/**
+ * Describes the message docker.v1.ManifestSummary.
+ * Use `create(ManifestSummarySchema)` to create a new message.
+ */
+export const ManifestSummarySchema: GenMessage<ManifestSummary> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 19);
 
+/**
+ * @generated from message docker.v1.ListImagesRequest
+ */
+export type ListImagesRequest = Message<"docker.v1.ListImagesRequest"> & {
+};
+
+/**
+ * Describes the message docker.v1.ListImagesRequest.
+ * Use `create(ListImagesRequestSchema)` to create a new message.
+ */
+export const ListImagesRequestSchema: GenMessage<ListImagesRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 20);
+
+/**
+ * @generated from message docker.v1.ListImagesResponse
+ */
+export type ListImagesResponse = Message<"docker.v1.ListImagesResponse"> & {
   /**
-   * @generated from field: string scope = 4;
+   * @generated from field: int64 totalDiskUsage = 1;
    */
-  scope: string;
+  totalDiskUsage: bigint;
 
   /**
-   * @generated from field: string driver = 5;
+   * @generated from field: int64 unusedImageCount = 2;
    */
-  driver: string;
+  unusedImageCount: bigint;
+
+  /**
+   * @generated from field: int64 untaggedImageCount = 3;
+   */
+  untaggedImageCount: bigint;
+
+  /**
+   * @generated from field: repeated docker.v1.Image images = 4;
+   */
+  images: Image[];
+};
+
+/**
+ * Describes the message docker.v1.ListImagesResponse.
+ * Use `create(ListImagesResponseSchema)` to create a new message.
+ */
+export const ListImagesResponseSchema: GenMessage<ListImagesResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 21);
+
+/**
+ * @generated from message docker.v1.RemoveImageRequest
+ */
+export type RemoveImageRequest = Message<"docker.v1.RemoveImageRequest"> & {
+  /**
+   * @generated from field: repeated string imageIds = 1;
+   */
+  imageIds: string[];
+};
+
+/**
+ * Describes the message docker.v1.RemoveImageRequest.
+ * Use `create(RemoveImageRequestSchema)` to create a new message.
+ */
+export const RemoveImageRequestSchema: GenMessage<RemoveImageRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 22);
+
+/**
+ * @generated from message docker.v1.RemoveImageResponse
+ */
+export type RemoveImageResponse = Message<"docker.v1.RemoveImageResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.RemoveImageResponse.
+ * Use `create(RemoveImageResponseSchema)` to create a new message.
+ */
+export const RemoveImageResponseSchema: GenMessage<RemoveImageResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 23);
+
+/**
+ * @generated from message docker.v1.ImagePruneResponse
+ */
+export type ImagePruneResponse = Message<"docker.v1.ImagePruneResponse"> & {
+  /**
+   * @generated from field: uint64 SpaceReclaimed = 1;
+   */
+  SpaceReclaimed: bigint;
+
+  /**
+   * @generated from field: repeated docker.v1.ImagesDeleted deleted = 2;
+   */
+  deleted: ImagesDeleted[];
+};
+
+/**
+ * Describes the message docker.v1.ImagePruneResponse.
+ * Use `create(ImagePruneResponseSchema)` to create a new message.
+ */
+export const ImagePruneResponseSchema: GenMessage<ImagePruneResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 24);
+
+/**
+ * @generated from message docker.v1.ImagePruneRequest
+ */
+export type ImagePruneRequest = Message<"docker.v1.ImagePruneRequest"> & {
+  /**
+   * @generated from field: bool pruneAll = 1;
+   */
+  pruneAll: boolean;
+};
+
+/**
+ * Describes the message docker.v1.ImagePruneRequest.
+ * Use `create(ImagePruneRequestSchema)` to create a new message.
+ */
+export const ImagePruneRequestSchema: GenMessage<ImagePruneRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 25);
+
+/**
+ * @generated from message docker.v1.DiskUsageRequest
+ */
+export type DiskUsageRequest = Message<"docker.v1.DiskUsageRequest"> & {
+  /**
+   * empty for the active host
+   *
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * largest items listed per category, defaults to 10
+   *
+   * @generated from field: int32 topN = 2;
+   */
+  topN: number;
+};
+
+/**
+ * Describes the message docker.v1.DiskUsageRequest.
+ * Use `create(DiskUsageRequestSchema)` to create a new message.
+ */
+export const DiskUsageRequestSchema: GenMessage<DiskUsageRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 26);
+
+/**
+ * @generated from message docker.v1.DiskUsageResponse
+ */
+export type DiskUsageResponse = Message<"docker.v1.DiskUsageResponse"> & {
+  /**
+   * @generated from field: docker.v1.UsageCategory images = 1;
+   */
+  images?: UsageCategory;
+
+  /**
+   * @generated from field: docker.v1.UsageCategory containers = 2;
+   */
+  containers?: UsageCategory;
+
+  /**
+   * @generated from field: docker.v1.UsageCategory volumes = 3;
+   */
+  volumes?: UsageCategory;
+
+  /**
+   * @generated from field: docker.v1.UsageCategory buildCache = 4;
+   */
+  buildCache?: UsageCategory;
+};
+
+/**
+ * Describes the message docker.v1.DiskUsageResponse.
+ * Use `create(DiskUsageResponseSchema)` to create a new message.
+ */
+export const DiskUsageResponseSchema: GenMessage<DiskUsageResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 27);
+
+/**
+ * @generated from message docker.v1.UsageCategory
+ */
+export type UsageCategory = Message<"docker.v1.UsageCategory"> & {
+  /**
+   * @generated from field: int64 count = 1;
+   */
+  count: bigint;
+
+  /**
+   * in use by a container or build, running for containers
+   *
+   * @generated from field: int64 active = 2;
+   */
+  active: bigint;
+
+  /**
+   * @generated from field: int64 total = 3;
+   */
+  total: bigint;
+
+  /**
+   * @generated from field: int64 reclaimable = 4;
+   */
+  reclaimable: bigint;
+
+  /**
+   * largest first
+   *
+   * @generated from field: repeated docker.v1.UsageItem top = 5;
+   */
+  top: UsageItem[];
+};
+
+/**
+ * Describes the message docker.v1.UsageCategory.
+ * Use `create(UsageCategorySchema)` to create a new message.
+ */
+export const UsageCategorySchema: GenMessage<UsageCategory> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 28);
+
+/**
+ * @generated from message docker.v1.UsageItem
+ */
+export type UsageItem = Message<"docker.v1.UsageItem"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 size = 3;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: bool reclaimable = 4;
+   */
+  reclaimable: boolean;
+};
+
+/**
+ * Describes the message docker.v1.UsageItem.
+ * Use `create(UsageItemSchema)` to create a new message.
+ */
+export const UsageItemSchema: GenMessage<UsageItem> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 29);
+
+/**
+ * @generated from message docker.v1.PruneRequest
+ */
+export type PruneRequest = Message<"docker.v1.PruneRequest"> & {
+  /**
+   * empty for the active host
+   *
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * @generated from field: bool containers = 2;
+   */
+  containers: boolean;
+
+  /**
+   * @generated from field: bool images = 3;
+   */
+  images: boolean;
+
+  /**
+   * also tagged images without a container, otherwise only dangling
+   *
+   * @generated from field: bool allImages = 4;
+   */
+  allImages: boolean;
+
+  /**
+   * @generated from field: bool volumes = 5;
+   */
+  volumes: boolean;
+
+  /**
+   * also named volumes, otherwise only anonymous
+   *
+   * @generated from field: bool allVolumes = 6;
+   */
+  allVolumes: boolean;
+
+  /**
+   * @generated from field: bool networks = 7;
+   */
+  networks: boolean;
+
+  /**
+   * @generated from field: bool buildCache = 8;
+   */
+  buildCache: boolean;
+
+  /**
+   * timestamp or duration eg: 24h, volumes are skipped if set
+   *
+   * @generated from field: string until = 9;
+   */
+  until: string;
+
+  /**
+   * key or key=value, prefix with ! to exclude, build cache is skipped if set
+   *
+   * @generated from field: repeated string labels = 10;
+   */
+  labels: string[];
+};
+
+/**
+ * Describes the message docker.v1.PruneRequest.
+ * Use `create(PruneRequestSchema)` to create a new message.
+ */
+export const PruneRequestSchema: GenMessage<PruneRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 30);
+
+/**
+ * @generated from message docker.v1.PruneResponse
+ */
+export type PruneResponse = Message<"docker.v1.PruneResponse"> & {
+  /**
+   * @generated from field: repeated string containersDeleted = 1;
+   */
+  containersDeleted: string[];
+
+  /**
+   * @generated from field: repeated string imagesDeleted = 2;
+   */
+  imagesDeleted: string[];
+
+  /**
+   * @generated from field: repeated string volumesDeleted = 3;
+   */
+  volumesDeleted: string[];
+
+  /**
+   * @generated from field: repeated string networksDeleted = 4;
+   */
+  networksDeleted: string[];
+
+  /**
+   * @generated from field: repeated string cachesDeleted = 5;
+   */
+  cachesDeleted: string[];
+
+  /**
+   * @generated from field: uint64 spaceReclaimed = 6;
+   */
+  spaceReclaimed: bigint;
+
+  /**
+   * object types skipped because a filter does not apply to them
+   *
+   * @generated from field: repeated string skipped = 7;
+   */
+  skipped: string[];
+
+  /**
+   * object types that failed, the others are still pruned
+   *
+   * @generated from field: repeated string errors = 8;
+   */
+  errors: string[];
+};
+
+/**
+ * Describes the message docker.v1.PruneResponse.
+ * Use `create(PruneResponseSchema)` to create a new message.
+ */
+export const PruneResponseSchema: GenMessage<PruneResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 31);
+
+/**
+ * @generated from message docker.v1.ImagesDeleted
+ */
+export type ImagesDeleted = Message<"docker.v1.ImagesDeleted"> & {
+  /**
+   * @generated from field: string Deleted = 1;
+   */
+  Deleted: string;
+
+  /**
+   * @generated from field: string Untagged = 2;
+   */
+  Untagged: string;
+};
+
+/**
+ * Describes the message docker.v1.ImagesDeleted.
+ * Use `create(ImagesDeletedSchema)` to create a new message.
+ */
+export const ImagesDeletedSchema: GenMessage<ImagesDeleted> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 32);
+
+/**
+ * Volume-related messages
+ *
+ * @generated from message docker.v1.Volume
+ */
+export type Volume = Message<"docker.v1.Volume"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string containerID = 2;
+   */
+  containerID: string;
+
+  /**
+   * @generated from field: string createdAt = 3;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string mountPoint = 4;
+   */
+  mountPoint: string;
+
+  /**
+   * @generated from field: int64 size = 5;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: string labels = 6;
+   */
+  labels: string;
+
+  /**
+   * @generated from field: string composePath = 7;
+   */
+  composePath: string;
+
+  /**
+   * @generated from field: string composeProjectName = 8;
+   */
+  composeProjectName: string;
+};
+
+/**
+ * Describes the message docker.v1.Volume.
+ * Use `create(VolumeSchema)` to create a new message.
+ */
+export const VolumeSchema: GenMessage<Volume> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 33);
+
+/**
+ * @generated from message docker.v1.ListVolumesRequest
+ */
+export type ListVolumesRequest = Message<"docker.v1.ListVolumesRequest"> & {
+};
+
+/**
+ * Describes the message docker.v1.ListVolumesRequest.
+ * Use `create(ListVolumesRequestSchema)` to create a new message.
+ */
+export const ListVolumesRequestSchema: GenMessage<ListVolumesRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 34);
+
+/**
+ * @generated from message docker.v1.VolumeListFilesRequest
+ */
+export type VolumeListFilesRequest = Message<"docker.v1.VolumeListFilesRequest"> & {
+  /**
+   * @generated from field: string volume = 1;
+   */
+  volume: string;
+
+  /**
+   * empty for the volume root
+   *
+   * @generated from field: string dir = 2;
+   */
+  dir: string;
+};
+
+/**
+ * Describes the message docker.v1.VolumeListFilesRequest.
+ * Use `create(VolumeListFilesRequestSchema)` to create a new message.
+ */
+export const VolumeListFilesRequestSchema: GenMessage<VolumeListFilesRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 35);
+
+/**
+ * @generated from message docker.v1.VolumeListFilesResponse
+ */
+export type VolumeListFilesResponse = Message<"docker.v1.VolumeListFilesResponse"> & {
+  /**
+   * @generated from field: repeated docker.v1.FileEntry files = 1;
+   */
+  files: FileEntry[];
+};
+
+/**
+ * Describes the message docker.v1.VolumeListFilesResponse.
+ * Use `create(VolumeListFilesResponseSchema)` to create a new message.
+ */
+export const VolumeListFilesResponseSchema: GenMessage<VolumeListFilesResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 36);
+
+/**
+ * @generated from message docker.v1.FileEntry
+ */
+export type FileEntry = Message<"docker.v1.FileEntry"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * @generated from field: int64 size = 3;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: bool isDir = 4;
+   */
+  isDir: boolean;
+
+  /**
+   * permission and type string e.g. drwxr-xr-x
+   *
+   * @generated from field: string mode = 5;
+   */
+  mode: string;
+
+  /**
+   * @generated from field: string modTime = 6;
+   */
+  modTime: string;
+
+  /**
+   * @generated from field: int32 uid = 7;
+   */
+  uid: number;
+
+  /**
+   * @generated from field: int32 gid = 8;
+   */
+  gid: number;
+
+  /**
+   * only set for symlinks in containers
+   *
+   * @generated from field: string linkTarget = 9;
+   */
+  linkTarget: string;
+};
+
+/**
+ * Describes the message docker.v1.FileEntry.
+ * Use `create(FileEntrySchema)` to create a new message.
+ */
+export const FileEntrySchema: GenMessage<FileEntry> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 37);
+
+/**
+ * @generated from message docker.v1.VolumeFileRequest
+ */
+export type VolumeFileRequest = Message<"docker.v1.VolumeFileRequest"> & {
+  /**
+   * @generated from field: string volume = 1;
+   */
+  volume: string;
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path: string;
+};
+
+/**
+ * Describes the message docker.v1.VolumeFileRequest.
+ * Use `create(VolumeFileRequestSchema)` to create a new message.
+ */
+export const VolumeFileRequestSchema: GenMessage<VolumeFileRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 38);
+
+/**
+ * files are limited to 10 MiB
+ *
+ * @generated from message docker.v1.VolumeFileContents
+ */
+export type VolumeFileContents = Message<"docker.v1.VolumeFileContents"> & {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * @generated from field: bytes contents = 2;
+   */
+  contents: Uint8Array;
+};
+
+/**
+ * Describes the message docker.v1.VolumeFileContents.
+ * Use `create(VolumeFileContentsSchema)` to create a new message.
+ */
+export const VolumeFileContentsSchema: GenMessage<VolumeFileContents> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 39);
+
+/**
+ * @generated from message docker.v1.VolumeWriteFileRequest
+ */
+export type VolumeWriteFileRequest = Message<"docker.v1.VolumeWriteFileRequest"> & {
+  /**
+   * @generated from field: string volume = 1;
+   */
+  volume: string;
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * @generated from field: bytes contents = 3;
+   */
+  contents: Uint8Array;
+
+  /**
+   * octal permissions, 0 defaults to 0644
+   *
+   * @generated from field: uint32 mode = 4;
+   */
+  mode: number;
+};
+
+/**
+ * Describes the message docker.v1.VolumeWriteFileRequest.
+ * Use `create(VolumeWriteFileRequestSchema)` to create a new message.
+ */
+export const VolumeWriteFileRequestSchema: GenMessage<VolumeWriteFileRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 40);
+
+/**
+ * @generated from message docker.v1.VolumeDeleteFileRequest
+ */
+export type VolumeDeleteFileRequest = Message<"docker.v1.VolumeDeleteFileRequest"> & {
+  /**
+   * @generated from field: string volume = 1;
+   */
+  volume: string;
+
+  /**
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * required to delete directories
+   *
+   * @generated from field: bool recursive = 3;
+   */
+  recursive: boolean;
+};
+
+/**
+ * Describes the message docker.v1.VolumeDeleteFileRequest.
+ * Use `create(VolumeDeleteFileRequestSchema)` to create a new message.
+ */
+export const VolumeDeleteFileRequestSchema: GenMessage<VolumeDeleteFileRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 41);
+
+/**
+ * @generated from message docker.v1.ListVolumesResponse
+ */
+export type ListVolumesResponse = Message<"docker.v1.ListVolumesResponse"> & {
+  /**
+   * @generated from field: repeated docker.v1.Volume volumes = 1;
+   */
+  volumes: Volume[];
+};
+
+/**
+ * Describes the message docker.v1.ListVolumesResponse.
+ * Use `create(ListVolumesResponseSchema)` to create a new message.
+ */
+export const ListVolumesResponseSchema: GenMessage<ListVolumesResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 42);
+
+/**
+ * @generated from message docker.v1.CreateVolumeRequest
+ */
+export type CreateVolumeRequest = Message<"docker.v1.CreateVolumeRequest"> & {
+  /**
+   * generated by docker if empty
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * defaults to local
+   *
+   * @generated from field: string driver = 2;
+   */
+  driver: string;
+
+  /**
+   * e.g. for an nfs share with the local driver
+   * type=nfs o=addr=10.0.0.2,rw device=:/exports/data
+   *
+   * @generated from field: map<string, string> driverOpts = 3;
+   */
+  driverOpts: { [key: string]: string };
+
+  /**
+   * @generated from field: map<string, string> labels = 4;
+   */
+  labels: { [key: string]: string };
+};
+
+/**
+ * Describes the message docker.v1.CreateVolumeRequest.
+ * Use `create(CreateVolumeRequestSchema)` to create a new message.
+ */
+export const CreateVolumeRequestSchema: GenMessage<CreateVolumeRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 43);
+
+/**
+ * @generated from message docker.v1.CreateVolumeResponse
+ */
+export type CreateVolumeResponse = Message<"docker.v1.CreateVolumeResponse"> & {
+  /**
+   * @generated from field: docker.v1.Volume volume = 1;
+   */
+  volume?: Volume;
+};
+
+/**
+ * Describes the message docker.v1.CreateVolumeResponse.
+ * Use `create(CreateVolumeResponseSchema)` to create a new message.
+ */
+export const CreateVolumeResponseSchema: GenMessage<CreateVolumeResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 44);
+
+/**
+ * @generated from message docker.v1.DeleteVolumeRequest
+ */
+export type DeleteVolumeRequest = Message<"docker.v1.DeleteVolumeRequest"> & {
+  /**
+   * @generated from field: repeated string volumeIds = 1;
+   */
+  volumeIds: string[];
+
+  /**
+   * @generated from field: bool anon = 2;
+   */
+  anon: boolean;
+
+  /**
+   * @generated from field: bool unused = 3;
+   */
+  unused: boolean;
+};
+
+/**
+ * Describes the message docker.v1.DeleteVolumeRequest.
+ * Use `create(DeleteVolumeRequestSchema)` to create a new message.
+ */
+export const DeleteVolumeRequestSchema: GenMessage<DeleteVolumeRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 45);
+
+/**
+ * @generated from message docker.v1.DeleteVolumeResponse
+ */
+export type DeleteVolumeResponse = Message<"docker.v1.DeleteVolumeResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.DeleteVolumeResponse.
+ * Use `create(DeleteVolumeResponseSchema)` to create a new message.
+ */
+export const DeleteVolumeResponseSchema: GenMessage<DeleteVolumeResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 46);
+
+/**
+ * Network-related messages
+ *
+ * @generated from message docker.v1.Network
+ */
+export type Network = Message<"docker.v1.Network"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string id = 2;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string subnet = 3;
+   */
+  subnet: string;
+
+  /**
+   * @generated from field: string scope = 4;
+   */
+  scope: string;
+
+  /**
+   * @generated from field: string driver = 5;
+   */
+  driver: string;
+
+  /**
+   * @generated from field: bool enable_ipv4 = 6;
+   */
+  enableIpv4: boolean;
+
+  /**
+   * @generated from field: bool enable_ipv6 = 7;
+   */
+  enableIpv6: boolean;
+
+  /**
+   * @generated from field: bool internal = 9;
+   */
+  internal: boolean;
+
+  /**
+   * @generated from field: bool attachable = 10;
+   */
+  attachable: boolean;
+
+  /**
+   * @generated from field: string createdAt = 11;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string composeProject = 12;
+   */
+  composeProject: string;
+
+  /**
+   * @generated from field: repeated string containerIds = 13;
+   */
+  containerIds: string[];
+};
+
+/**
+ * Describes the message docker.v1.Network.
+ * Use `create(NetworkSchema)` to create a new message.
+ */
+export const NetworkSchema: GenMessage<Network> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 47);
+
+/**
+ * @generated from message docker.v1.ListNetworksRequest
+ */
+export type ListNetworksRequest = Message<"docker.v1.ListNetworksRequest"> & {
+};
+
+/**
+ * Describes the message docker.v1.ListNetworksRequest.
+ * Use `create(ListNetworksRequestSchema)` to create a new message.
+ */
+export const ListNetworksRequestSchema: GenMessage<ListNetworksRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 48);
+
+/**
+ * @generated from message docker.v1.ListNetworksResponse
+ */
+export type ListNetworksResponse = Message<"docker.v1.ListNetworksResponse"> & {
+  /**
+   * @generated from field: repeated docker.v1.Network networks = 1;
+   */
+  networks: Network[];
+};
+
+/**
+ * Describes the message docker.v1.ListNetworksResponse.
+ * Use `create(ListNetworksResponseSchema)` to create a new message.
+ */
+export const ListNetworksResponseSchema: GenMessage<ListNetworksResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 49);
+
+/**
+ * @generated from message docker.v1.CreateNetworkRequest
+ */
+export type CreateNetworkRequest = Message<"docker.v1.CreateNetworkRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * bridge|macvlan|ipvlan|overlay, defaults to bridge
+   *
+   * @generated from field: string driver = 2;
+   */
+  driver: string;
+
+  /**
+   * driver specific options e.g. parent=eth0 for macvlan
+   *
+   * @generated from field: map<string, string> options = 3;
+   */
+  options: { [key: string]: string };
+
+  /**
+   * ipv4 ipam, docker picks a subnet if empty
+   *
+   * @generated from field: string subnet = 4;
+   */
+  subnet: string;
+
+  /**
+   * @generated from field: string gateway = 5;
+   */
+  gateway: string;
+
+  /**
+   * @generated from field: string ipRange = 6;
+   */
+  ipRange: string;
+
+  /**
+   * @generated from field: bool internal = 7;
+   */
+  internal: boolean;
+
+  /**
+   * @generated from field: bool attachable = 8;
+   */
+  attachable: boolean;
+
+  /**
+   * @generated from field: bool enableIpv6 = 9;
+   */
+  enableIpv6: boolean;
+
+  /**
+   * @generated from field: string ipv6Subnet = 10;
+   */
+  ipv6Subnet: string;
+
+  /**
+   * @generated from field: string ipv6Gateway = 11;
+   */
+  ipv6Gateway: string;
+
+  /**
+   * @generated from field: map<string, string> labels = 12;
+   */
+  labels: { [key: string]: string };
+};
+
+/**
+ * Describes the message docker.v1.CreateNetworkRequest.
+ * Use `create(CreateNetworkRequestSchema)` to create a new message.
+ */
+export const CreateNetworkRequestSchema: GenMessage<CreateNetworkRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 50);
+
+/**
+ * @generated from message docker.v1.CreateNetworkResponse
+ */
+export type CreateNetworkResponse = Message<"docker.v1.CreateNetworkResponse"> & {
+  /**
+   * @generated from field: docker.v1.Network network = 1;
+   */
+  network?: Network;
+};
+
+/**
+ * Describes the message docker.v1.CreateNetworkResponse.
+ * Use `create(CreateNetworkResponseSchema)` to create a new message.
+ */
+export const CreateNetworkResponseSchema: GenMessage<CreateNetworkResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 51);
+
+/**
+ * @generated from message docker.v1.DeleteNetworkRequest
+ */
+export type DeleteNetworkRequest = Message<"docker.v1.DeleteNetworkRequest"> & {
+  /**
+   * @generated from field: repeated string networkIds = 1;
+   */
+  networkIds: string[];
+
+  /**
+   * @generated from field: bool prune = 2;
+   */
+  prune: boolean;
+};
+
+/**
+ * Describes the message docker.v1.DeleteNetworkRequest.
+ * Use `create(DeleteNetworkRequestSchema)` to create a new message.
+ */
+export const DeleteNetworkRequestSchema: GenMessage<DeleteNetworkRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 52);
+
+/**
+ * @generated from message docker.v1.DeleteNetworkResponse
+ */
+export type DeleteNetworkResponse = Message<"docker.v1.DeleteNetworkResponse"> & {
+};
+
+/**
+ * Describes the message docker.v1.DeleteNetworkResponse.
+ * Use `create(DeleteNetworkResponseSchema)` to create a new message.
+ */
+export const DeleteNetworkResponseSchema: GenMessage<DeleteNetworkResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 53);
+
+/**
+ * @generated from message docker.v1.NetworkTopologyResponse
+ */
+export type NetworkTopologyResponse = Message<"docker.v1.NetworkTopologyResponse"> & {
+  /**
+   * @generated from field: repeated docker.v1.NetworkNode networks = 1;
+   */
+  networks: NetworkNode[];
+};
+
+/**
+ * Describes the message docker.v1.NetworkTopologyResponse.
+ * Use `create(NetworkTopologyResponseSchema)` to create a new message.
+ */
+export const NetworkTopologyResponseSchema: GenMessage<NetworkTopologyResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 54);
+
+/**
+ * @generated from message docker.v1.NetworkNode
+ */
+export type NetworkNode = Message<"docker.v1.NetworkNode"> & {
+  /**
+   * @generated from field: docker.v1.Network network = 1;
+   */
+  network?: Network;
+
+  /**
+   * @generated from field: repeated docker.v1.NetworkEndpoint endpoints = 2;
+   */
+  endpoints: NetworkEndpoint[];
+
+  /**
+   * no container, running or stopped, is attached
+   *
+   * @generated from field: bool unused = 3;
+   */
+  unused: boolean;
+
+  /**
+   * bridge, host and none are created by docker and cannot be removed
+   *
+   * @generated from field: bool builtin = 4;
+   */
+  builtin: boolean;
+};
+
+/**
+ * Describes the message docker.v1.NetworkNode.
+ * Use `create(NetworkNodeSchema)` to create a new message.
+ */
+export const NetworkNodeSchema: GenMessage<NetworkNode> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 55);
+
+/**
+ * addresses are empty for stopped containers unless they are static
+ *
+ * @generated from message docker.v1.NetworkEndpoint
+ */
+export type NetworkEndpoint = Message<"docker.v1.NetworkEndpoint"> & {
+  /**
+   * @generated from field: string containerId = 1;
+   */
+  containerId: string;
+
+  /**
+   * @generated from field: string containerName = 2;
+   */
+  containerName: string;
+
+  /**
+   * @generated from field: string state = 3;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string ipv4Address = 4;
+   */
+  ipv4Address: string;
+
+  /**
+   * @generated from field: string ipv6Address = 5;
+   */
+  ipv6Address: string;
+
+  /**
+   * @generated from field: string macAddress = 6;
+   */
+  macAddress: string;
+
+  /**
+   * @generated from field: repeated string aliases = 7;
+   */
+  aliases: string[];
+
+  /**
+   * @generated from field: string composeProject = 8;
+   */
+  composeProject: string;
+
+  /**
+   * @generated from field: string composeService = 9;
+   */
+  composeService: string;
+};
+
+/**
+ * Describes the message docker.v1.NetworkEndpoint.
+ * Use `create(NetworkEndpointSchema)` to create a new message.
+ */
+export const NetworkEndpointSchema: GenMessage<NetworkEndpoint> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 56);
+
+/**
+ * @generated from message docker.v1.NetworkConnectRequest
+ */
+export type NetworkConnectRequest = Message<"docker.v1.NetworkConnectRequest"> & {
+  /**
+   * @generated from field: string networkId = 1;
+   */
+  networkId: string;
+
+  /**
+   * @generated from field: string containerId = 2;
+   */
+  containerId: string;
+
+  /**
+   * @generated from field: repeated string aliases = 3;
+   */
+  aliases: string[];
+
+  /**
+   * optional static addresses, require a network with a user defined subnet
+   *
+   * @generated from field: string ipv4Address = 4;
+   */
+  ipv4Address: string;
+
+  /**
+   * @generated from field: string ipv6Address = 5;
+   */
+  ipv6Address: string;
+};
+
+/**
+ * Describes the message docker.v1.NetworkConnectRequest.
+ * Use `create(NetworkConnectRequestSchema)` to create a new message.
+ */
+export const NetworkConnectRequestSchema: GenMessage<NetworkConnectRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 57);
+
+/**
+ * @generated from message docker.v1.NetworkDisconnectRequest
+ */
+export type NetworkDisconnectRequest = Message<"docker.v1.NetworkDisconnectRequest"> & {
+  /**
+   * @generated from field: string networkId = 1;
+   */
+  networkId: string;
+
+  /**
+   * @generated from field: string containerId = 2;
+   */
+  containerId: string;
+
+  /**
+   * @generated from field: bool force = 3;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message docker.v1.NetworkDisconnectRequest.
+ * Use `create(NetworkDisconnectRequestSchema)` to create a new message.
+ */
+export const NetworkDisconnectRequestSchema: GenMessage<NetworkDisconnectRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 58);
+
+/**
+ * @generated from message docker.v1.ContainerLogsRequest
+ */
+export type ContainerLogsRequest = Message<"docker.v1.ContainerLogsRequest"> & {
+  /**
+   * @generated from field: string containerID = 1;
+   */
+  containerID: string;
+};
+
+/**
+ * Describes the message docker.v1.ContainerLogsRequest.
+ * Use `create(ContainerLogsRequestSchema)` to create a new message.
+ */
+export const ContainerLogsRequestSchema: GenMessage<ContainerLogsRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 59);
+
+/**
+ * @generated from message docker.v1.LogsMessage
+ */
+export type LogsMessage = Message<"docker.v1.LogsMessage"> & {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message docker.v1.LogsMessage.
+ * Use `create(LogsMessageSchema)` to create a new message.
+ */
+export const LogsMessageSchema: GenMessage<LogsMessage> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 60);
+
+/**
+ * @generated from message docker.v1.ImageBuildRequest
+ */
+export type ImageBuildRequest = Message<"docker.v1.ImageBuildRequest"> & {
+  /**
+   * build context relative to the compose root
+   *
+   * @generated from field: string dir = 1;
+   */
+  dir: string;
+
+  /**
+   * relative to dir, defaults to Dockerfile
+   *
+   * @generated from field: string dockerfile = 2;
+   */
+  dockerfile: string;
+
+  /**
+   * @generated from field: repeated string tags = 3;
+   */
+  tags: string[];
+
+  /**
+   * @generated from field: map<string, string> buildArgs = 4;
+   */
+  buildArgs: { [key: string]: string };
+
+  /**
+   * build stage to stop at
+   *
+   * @generated from field: string target = 5;
+   */
+  target: string;
+
+  /**
+   * @generated from field: bool pull = 6;
+   */
+  pull: boolean;
+
+  /**
+   * @generated from field: bool noCache = 7;
+   */
+  noCache: boolean;
+};
+
+/**
+ * Describes the message docker.v1.ImageBuildRequest.
+ * Use `create(ImageBuildRequestSchema)` to create a new message.
+ */
+export const ImageBuildRequestSchema: GenMessage<ImageBuildRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 61);
+
+/**
+ * @generated from message docker.v1.ImagePushRequest
+ */
+export type ImagePushRequest = Message<"docker.v1.ImagePushRequest"> & {
+  /**
+   * @generated from field: string image = 1;
+   */
+  image: string;
+
+  /**
+   * leave empty to use the credentials of docker login on the dockman host
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message docker.v1.ImagePushRequest.
+ * Use `create(ImagePushRequestSchema)` to create a new message.
+ */
+export const ImagePushRequestSchema: GenMessage<ImagePushRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 62);
+
+/**
+ * @generated from message docker.v1.ImageSaveRequest
+ */
+export type ImageSaveRequest = Message<"docker.v1.ImageSaveRequest"> & {
+  /**
+   * @generated from field: repeated string images = 1;
+   */
+  images: string[];
+};
+
+/**
+ * Describes the message docker.v1.ImageSaveRequest.
+ * Use `create(ImageSaveRequestSchema)` to create a new message.
+ */
+export const ImageSaveRequestSchema: GenMessage<ImageSaveRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 63);
+
+/**
+ * @generated from message docker.v1.ImageTransferRequest
+ */
+export type ImageTransferRequest = Message<"docker.v1.ImageTransferRequest"> & {
+  /**
+   * @generated from field: repeated string images = 1;
+   */
+  images: string[];
+
+  /**
+   * empty for the active host
+   *
+   * @generated from field: string sourceHost = 2;
+   */
+  sourceHost: string;
+
+  /**
+   * @generated from field: string targetHost = 3;
+   */
+  targetHost: string;
+};
+
+/**
+ * Describes the message docker.v1.ImageTransferRequest.
+ * Use `create(ImageTransferRequestSchema)` to create a new message.
+ */
+export const ImageTransferRequestSchema: GenMessage<ImageTransferRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 64);
+
+/**
+ * a single json progress message of the daemon
+ *
+ * @generated from message docker.v1.ImageProgress
+ */
+export type ImageProgress = Message<"docker.v1.ImageProgress"> & {
+  /**
+   * layer id for pull and push progress
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
 
   /**
-   * @generated from field: bool enable_ipv4 = 6;
+   * @generated from field: string status = 2;
    */
-  enableIpv4: boolean;
+  status: string;
 
   /**
-   * @generated from field: bool enable_ipv6 = 7;
+   * build output
+   *
+   * @generated from field: string stream = 3;
    */
-  enableIpv6: boolean;
+  stream: string;
 
   /**
-   * @generated from field: bool internal = 9;
+   * rendered progress bar
+   *
+   * @generated from field: string progress = 4;
    */
-  internal: boolean;
+  progress: string;
 
   /**
-   * @generated from field: bool attachable = 10;
+   * @generated from field: int64 current = 5;
    */
-  attachable: boolean;
+  current: bigint;
 
   /**
-   * @generated from field: string createdAt = 11;
+   * @generated from field: int64 total = 6;
    */
-  createdAt: string;
+  total: bigint;
 
   /**
-   * @generated from field: string composeProject = 12;
+   * set on the final message if the build or push failed
+   *
+   * @generated from field: string error = 7;
    */
-  composeProject: string;
+  error: string;
 
   /**
-   * @generated from field: repeated string containerIds = 13;
+   * auxiliary data as json, holds the image id after a build and the digest after a push
+   *
+   * @generated from field: string aux = 8;
    */
-  containerIds: string[];
+  aux: string;
 };
 
 /**
- * Describes the message docker.v1.Network.
- * Use `create(NetworkSchema)` to create a new message.
- */
-export const NetworkSchema: GenMessage<Network> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 19);
-
-/**
- * @generated from message docker.v1.ListNetworksRequest
+ * Describes the message docker.v1.ImageProgress.
+ * Use `create(ImageProgressSchema)` to create a new message.
  */
-export type ListNetworksRequest = Message<"docker.v1.ListNetworksRequest"> & {
-};
+export const ImageProgressSchema: GenMessage<ImageProgress> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 65);
 
 /**
- * Describes the message docker.v1.ListNetworksRequest.
- * Use `create(ListNetworksRequestSchema)` to create a new message.
+ * @generated from message docker.v1.ContainerListFilesRequest
  */
-export const ListNetworksRequestSchema: GenMessage<ListNetworksRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 20);
+export type ContainerListFilesRequest = Message<"docker.v1.ContainerListFilesRequest"> & {
+  /**
+   * @generated from field: string containerID = 1;
+   */
+  containerID: string;
 
-/**
- * @generated from message docker.v1.ListNetworksResponse
- */
-export type ListNetworksResponse = Message<"docker.v1.ListNetworksResponse"> & {
   /**
-   * @generated from field: repeated docker.v1.Network networks = 1;
+   * empty for the container root
+   *
+   * @generated from field: string dir = 2;
    */
-  networks: Network[];
+  dir: string;
 };
 
 /**
- * Describes the message docker.v1.ListNetworksResponse.
- * Use `create(ListNetworksResponseSchema)` to create a new message.
- */
-export const ListNetworksResponseSchema: GenMessage<ListNetworksResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 21);
-
-/**
- * @generated from message docker.v1.CreateNetworkRequest
+ * Describes the message docker.v1.ContainerListFilesRequest.
+ * Use `create(ContainerListFilesRequestSchema)` to create a new message.
  */
-export type CreateNetworkRequest = Message<"docker.v1.CreateNetworkRequest"> & {
-};
+export const ContainerListFilesRequestSchema: GenMessage<ContainerListFilesRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 66);
 
 /**
- * Describes the message docker.v1.CreateNetworkRequest.
- * Use `create(CreateNetworkRequestSchema)` to create a new message.
+ * @generated from message docker.v1.ContainerListFilesResponse
  */
-export const CreateNetworkRequestSchema: GenMessage<CreateNetworkRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 22);
+export type ContainerListFilesResponse = Message<"docker.v1.ContainerListFilesResponse"> & {
+  /**
+   * @generated from field: repeated docker.v1.FileEntry files = 1;
+   */
+  files: FileEntry[];
 
-/**
- * @generated from message docker.v1.CreateNetworkResponse
- */
-export type CreateNetworkResponse = Message<"docker.v1.CreateNetworkResponse"> & {
+  /**
+   * set when the directory was too large to list completely through the archive api
+   *
+   * @generated from field: bool truncated = 2;
+   */
+  truncated: boolean;
 };
 
 /**
- * Describes the message docker.v1.CreateNetworkResponse.
- * Use `create(CreateNetworkResponseSchema)` to create a new message.
+ * Describes the message docker.v1.ContainerListFilesResponse.
+ * Use `create(ContainerListFilesResponseSchema)` to create a new message.
  */
-export const CreateNetworkResponseSchema: GenMessage<CreateNetworkResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 23);
+export const ContainerListFilesResponseSchema: GenMessage<ContainerListFilesResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 67);
 
 /**
- * @generated from message docker.v1.DeleteNetworkRequest
+ * @generated from message docker.v1.ContainerFileRequest
  */
-export type DeleteNetworkRequest = Message<"docker.v1.DeleteNetworkRequest"> & {
+export type ContainerFileRequest = Message<"docker.v1.ContainerFileRequest"> & {
   /**
-   * @generated from field: repeated string networkIds = 1;
+   * @generated from field: string containerID = 1;
    */
-  networkIds: string[];
+  containerID: string;
 
   /**
-   * @generated from field: bool prune = 2;
+   * @generated from field: string path = 2;
    */
-  prune: boolean;
+  path: string;
 };
 
 /**
- * Describes the message docker.v1.DeleteNetworkRequest.
- * Use `create(DeleteNetworkRequestSchema)` to create a new message.
+ * Describes the message docker.v1.ContainerFileRequest.
+ * Use `create(ContainerFileRequestSchema)` to create a new message.
  */
-export const DeleteNetworkRequestSchema: GenMessage<DeleteNetworkRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 24);
+export const ContainerFileRequestSchema: GenMessage<ContainerFileRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 68);
 
 /**
- * @generated from message docker.v1.DeleteNetworkResponse
+ * @generated from message docker.v1.ArchiveChunk
  */
-export type DeleteNetworkResponse = Message<"docker.v1.DeleteNetworkResponse"> & {
+export type ArchiveChunk = Message<"docker.v1.ArchiveChunk"> & {
+  /**
+   * suggested file name for the archive, only set on the first chunk
+   *
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bytes data = 2;
+   */
+  data: Uint8Array;
 };
 
 /**
- * Describes the message docker.v1.DeleteNetworkResponse.
- * Use `create(DeleteNetworkResponseSchema)` to create a new message.
+ * Describes the message docker.v1.ArchiveChunk.
+ * Use `create(ArchiveChunkSchema)` to create a new message.
  */
-export const DeleteNetworkResponseSchema: GenMessage<DeleteNetworkResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 25);
+export const ArchiveChunkSchema: GenMessage<ArchiveChunk> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 69);
 
 /**
- * @generated from message docker.v1.ContainerLogsRequest
+ * @generated from message docker.v1.ContainerUploadRequest
  */
-export type ContainerLogsRequest = Message<"docker.v1.ContainerLogsRequest"> & {
+export type ContainerUploadRequest = Message<"docker.v1.ContainerUploadRequest"> & {
   /**
    * @generated from field: string containerID = 1;
    */
   containerID: string;
-};
 
-/**
- * Describes the message docker.v1.ContainerLogsRequest.
- * Use `create(ContainerLogsRequestSchema)` to create a new message.
- */
-export const ContainerLogsRequestSchema: GenMessage<ContainerLogsRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 26);
+  /**
+   * the parent directory must exist
+   *
+   * @generated from field: string path = 2;
+   */
+  path: string;
 
-/**
- * @generated from message docker.v1.LogsMessage
- */
-export type LogsMessage = Message<"docker.v1.LogsMessage"> & {
   /**
-   * @generated from field: string message = 1;
+   * @generated from field: bytes contents = 3;
    */
-  message: string;
+  contents: Uint8Array;
+
+  /**
+   * octal permissions, 0 defaults to 0644
+   *
+   * @generated from field: uint32 mode = 4;
+   */
+  mode: number;
 };
 
 /**
- * Describes the message docker.v1.LogsMessage.
- * Use `create(LogsMessageSchema)` to create a new message.
+ * Describes the message docker.v1.ContainerUploadRequest.
+ * Use `create(ContainerUploadRequestSchema)` to create a new message.
  */
-export const LogsMessageSchema: GenMessage<LogsMessage> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 27);
+export const ContainerUploadRequestSchema: GenMessage<ContainerUploadRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 70);
 
 /**
  * @generated from message docker.v1.StatsResponse
@@ -678,7 +2296,7 @@ export type StatsResponse = Message<"docker.v1.StatsResponse"> & {
  * Use `create(StatsResponseSchema)` to create a new message.
  */
 export const StatsResponseSchema: GenMessage<StatsResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 28);
+  messageDesc(file_docker_v1_docker, 71);
 
 /**
  * @generated from message docker.v1.StatsRequest
@@ -705,7 +2323,7 @@ export type StatsRequest = Message<"docker.v1.StatsRequest"> & {
  * Use `create(StatsRequestSchema)` to create a new message.
  */
 export const StatsRequestSchema: GenMessage<StatsRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 29);
+  messageDesc(file_docker_v1_docker, 72);
 
 /**
  * @generated from message docker.v1.SystemInfo
@@ -729,7 +2347,7 @@ export type SystemInfo = Message<"docker.v1.SystemInfo"> & {
  * Use `create(SystemInfoSchema)` to create a new message.
  */
 export const SystemInfoSchema: GenMessage<SystemInfo> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 30);
+  messageDesc(file_docker_v1_docker, 73);
 
 /**
  * @generated from message docker.v1.ListResponse
@@ -746,7 +2364,7 @@ export type ListResponse = Message<"docker.v1.ListResponse"> & {
  * Use `create(ListResponseSchema)` to create a new message.
  */
 export const ListResponseSchema: GenMessage<ListResponse> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 31);
+  messageDesc(file_docker_v1_docker, 74);
 
 /**
  * @generated from message docker.v1.ContainerList
@@ -815,7 +2433,7 @@ export type ContainerList = Message<"docker.v1.ContainerList"> & {
  * Use `create(ContainerListSchema)` to create a new message.
  */
 export const ContainerListSchema: GenMessage<ContainerList> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 32);
+  messageDesc(file_docker_v1_docker, 75);
 
 /**
  * ContainerInfo holds metrics for a single Docker container.
@@ -831,130 +2449,298 @@ export type ContainerStats = Message<"docker.v1.ContainerStats"> & {
   id: string;
 
   /**
-   * Name of the container.
+   * Name of the container.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * CPU usage as a percentage.
+   *
+   * @generated from field: double cpu_usage = 3;
+   */
+  cpuUsage: number;
+
+  /**
+   * Current memory usage in bytes.
+   *
+   * @generated from field: uint64 memory_usage = 4;
+   */
+  memoryUsage: bigint;
+
+  /**
+   * Maximum memory limit in bytes.
+   *
+   * @generated from field: uint64 memory_limit = 5;
+   */
+  memoryLimit: bigint;
+
+  /**
+   * Total bytes received over the network.
+   *
+   * @generated from field: uint64 network_rx = 6;
+   */
+  networkRx: bigint;
+
+  /**
+   * Total bytes sent over the network.
+   *
+   * @generated from field: uint64 network_tx = 7;
+   */
+  networkTx: bigint;
+
+  /**
+   * Total bytes read from block devices.
+   *
+   * @generated from field: uint64 block_read = 8;
+   */
+  blockRead: bigint;
+
+  /**
+   * Total bytes written to block devices.
+   *
+   * @generated from field: uint64 block_write = 9;
+   */
+  blockWrite: bigint;
+};
+
+/**
+ * Describes the message docker.v1.ContainerStats.
+ * Use `create(ContainerStatsSchema)` to create a new message.
+ */
+export const ContainerStatsSchema: GenMessage<ContainerStats> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 76);
+
+/**
+ * @generated from message docker.v1.Port
+ */
+export type Port = Message<"docker.v1.Port"> & {
+  /**
+   * @generated from field: int32 public = 1;
+   */
+  public: number;
+
+  /**
+   * @generated from field: int32 private = 2;
+   */
+  private: number;
+
+  /**
+   * @generated from field: string host = 3;
+   */
+  host: string;
+
+  /**
+   * @generated from field: string type = 4;
+   */
+  type: string;
+};
+
+/**
+ * Describes the message docker.v1.Port.
+ * Use `create(PortSchema)` to create a new message.
+ */
+export const PortSchema: GenMessage<Port> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 77);
+
+/**
+ * @generated from message docker.v1.Empty
+ */
+export type Empty = Message<"docker.v1.Empty"> & {
+};
+
+/**
+ * Describes the message docker.v1.Empty.
+ * Use `create(EmptySchema)` to create a new message.
+ */
+export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 78);
+
+/**
+ * @generated from message docker.v1.ContainerRequest
+ */
+export type ContainerRequest = Message<"docker.v1.ContainerRequest"> & {
+  /**
+   * @generated from field: repeated string containerIds = 1;
+   */
+  containerIds: string[];
+};
+
+/**
+ * Describes the message docker.v1.ContainerRequest.
+ * Use `create(ContainerRequestSchema)` to create a new message.
+ */
+export const ContainerRequestSchema: GenMessage<ContainerRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 79);
+
+/**
+ * @generated from message docker.v1.ContainerImportRequest
+ */
+export type ContainerImportRequest = Message<"docker.v1.ContainerImportRequest"> & {
+  /**
+   * @generated from field: repeated string containerIds = 1;
+   */
+  containerIds: string[];
+
+  /**
+   * compose file to create, relative to the compose root
    *
-   * @generated from field: string name = 2;
+   * @generated from field: string filename = 2;
    */
-  name: string;
+  filename: string;
+};
+
+/**
+ * Describes the message docker.v1.ContainerImportRequest.
+ * Use `create(ContainerImportRequestSchema)` to create a new message.
+ */
+export const ContainerImportRequestSchema: GenMessage<ContainerImportRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 80);
 
+/**
+ * @generated from message docker.v1.ContainerImportResponse
+ */
+export type ContainerImportResponse = Message<"docker.v1.ContainerImportResponse"> & {
   /**
-   * CPU usage as a percentage.
-   *
-   * @generated from field: double cpu_usage = 3;
+   * @generated from field: string filename = 1;
    */
-  cpuUsage: number;
+  filename: string;
 
   /**
-   * Current memory usage in bytes.
-   *
-   * @generated from field: uint64 memory_usage = 4;
+   * @generated from field: string yaml = 2;
    */
-  memoryUsage: bigint;
+  yaml: string;
+};
+
+/**
+ * Describes the message docker.v1.ContainerImportResponse.
+ * Use `create(ContainerImportResponseSchema)` to create a new message.
+ */
+export const ContainerImportResponseSchema: GenMessage<ContainerImportResponse> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 81);
 
+/**
+ * @generated from message docker.v1.BulkComposeRequest
+ */
+export type BulkComposeRequest = Message<"docker.v1.BulkComposeRequest"> & {
   /**
-   * Maximum memory limit in bytes.
+   * start|stop|restart|update
    *
-   * @generated from field: uint64 memory_limit = 5;
+   * @generated from field: string action = 1;
    */
-  memoryLimit: bigint;
+  action: string;
 
   /**
-   * Total bytes received over the network.
-   *
-   * @generated from field: uint64 network_rx = 6;
+   * @generated from field: repeated docker.v1.BulkTarget targets = 2;
    */
-  networkRx: bigint;
+  targets: BulkTarget[];
 
   /**
-   * Total bytes sent over the network.
+   * max stacks processed at once, defaults to 4
    *
-   * @generated from field: uint64 network_tx = 7;
+   * @generated from field: int32 concurrency = 3;
    */
-  networkTx: bigint;
+  concurrency: number;
+};
+
+/**
+ * Describes the message docker.v1.BulkComposeRequest.
+ * Use `create(BulkComposeRequestSchema)` to create a new message.
+ */
+export const BulkComposeRequestSchema: GenMessage<BulkComposeRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 82);
 
+/**
+ * @generated from message docker.v1.BulkTarget
+ */
+export type BulkTarget = Message<"docker.v1.BulkTarget"> & {
   /**
-   * Total bytes read from block devices.
+   * empty for the active host
    *
-   * @generated from field: uint64 block_read = 8;
+   * @generated from field: string host = 1;
    */
-  blockRead: bigint;
+  host: string;
 
   /**
-   * Total bytes written to block devices.
-   *
-   * @generated from field: uint64 block_write = 9;
+   * @generated from field: docker.v1.ComposeFile file = 2;
    */
-  blockWrite: bigint;
+  file?: ComposeFile;
 };
 
 /**
- * Describes the message docker.v1.ContainerStats.
- * Use `create(ContainerStatsSchema)` to create a new message.
+ * Describes the message docker.v1.BulkTarget.
+ * Use `create(BulkTargetSchema)` to create a new message.
  */
-export const ContainerStatsSchema: GenMessage<ContainerStats> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 33);
+export const BulkTargetSchema: GenMessage<BulkTarget> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 83);
 
 /**
- * @generated from message docker.v1.Port
+ * @generated from message docker.v1.BulkProgress
  */
-export type Port = Message<"docker.v1.Port"> & {
+export type BulkProgress = Message<"docker.v1.BulkProgress"> & {
   /**
-   * @generated from field: int32 public = 1;
+   * @generated from field: string host = 1;
    */
-  public: number;
+  host: string;
 
   /**
-   * @generated from field: int32 private = 2;
+   * @generated from field: string filename = 2;
    */
-  private: number;
+  filename: string;
 
   /**
-   * @generated from field: string host = 3;
+   * queued|running|log|done|failed
+   *
+   * @generated from field: string status = 3;
    */
-  host: string;
+  status: string;
 
   /**
-   * @generated from field: string type = 4;
+   * compose output for log, error for failed
+   *
+   * @generated from field: string message = 4;
    */
-  type: string;
+  message: string;
 };
 
 /**
- * Describes the message docker.v1.Port.
- * Use `create(PortSchema)` to create a new message.
+ * Describes the message docker.v1.BulkProgress.
+ * Use `create(BulkProgressSchema)` to create a new message.
  */
-export const PortSchema: GenMessage<Port> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 34);
+export const BulkProgressSchema: GenMessage<BulkProgress> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 84);
 
 /**
- * @generated from message docker.v1.Empty
+ * @generated from message docker.v1.ComposeBuildRequest
  */
-export type Empty = Message<"docker.v1.Empty"> & {
-};
+export type ComposeBuildRequest = Message<"docker.v1.ComposeBuildRequest"> & {
+  /**
+   * selectedServices limits the build to those services
+   *
+   * @generated from field: docker.v1.ComposeFile file = 1;
+   */
+  file?: ComposeFile;
 
-/**
- * Describes the message docker.v1.Empty.
- * Use `create(EmptySchema)` to create a new message.
- */
-export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 35);
+  /**
+   * always attempt to pull newer base images
+   *
+   * @generated from field: bool pull = 2;
+   */
+  pull: boolean;
 
-/**
- * @generated from message docker.v1.ContainerRequest
- */
-export type ContainerRequest = Message<"docker.v1.ContainerRequest"> & {
   /**
-   * @generated from field: repeated string containerIds = 1;
+   * @generated from field: bool noCache = 3;
    */
-  containerIds: string[];
+  noCache: boolean;
 };
 
 /**
- * Describes the message docker.v1.ContainerRequest.
- * Use `create(ContainerRequestSchema)` to create a new message.
+ * Describes the message docker.v1.ComposeBuildRequest.
+ * Use `create(ComposeBuildRequestSchema)` to create a new message.
  */
-export const ContainerRequestSchema: GenMessage<ContainerRequest> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 36);
+export const ComposeBuildRequestSchema: GenMessage<ComposeBuildRequest> = /*@__PURE__*/
+  messageDesc(file_docker_v1_docker, 85);
 
 /**
  * @generated from message docker.v1.ComposeFile
@@ -969,6 +2755,21 @@ export type ComposeFile = Message<"docker.v1.ComposeFile"> & {
    * @generated from field: repeated string selectedServices = 2;
    */
   selectedServices: string[];
+
+  /**
+   * additional compose files relative to the compose root, merged in order after
+   * the main file and its override file (compose.override.yaml) which is detected automatically
+   *
+   * @generated from field: repeated string extraFiles = 3;
+   */
+  extraFiles: string[];
+
+  /**
+   * active profiles, defaults to COMPOSE_PROFILES if empty
+   *
+   * @generated from field: repeated string profiles = 4;
+   */
+  profiles: string[];
 };
 
 /**
@@ -976,7 +2777,7 @@ export type ComposeFile = Message<"docker.v1.ComposeFile"> & {
  * Use `create(ComposeFileSchema)` to create a new message.
  */
 export const ComposeFileSchema: GenMessage<ComposeFile> = /*@__PURE__*/
-  messageDesc(file_docker_v1_docker, 37);
+  messageDesc(file_docker_v1_docker, 86);
 
 /**
  * @generated from enum docker.v1.SORT_FIELD
@@ -1093,6 +2894,16 @@ export const DockerService: GenService<{
     input: typeof ContainerRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * generate a compose file from containers not managed by compose
+   *
+   * @generated from rpc docker.v1.DockerService.ContainerImport
+   */
+  containerImport: {
+    methodKind: "unary";
+    input: typeof ContainerImportRequestSchema;
+    output: typeof ContainerImportResponseSchema;
+  },
   /**
    * @generated from rpc docker.v1.DockerService.ContainerList
    */
@@ -1137,6 +2948,35 @@ export const DockerService: GenService<{
     input: typeof ContainerExecCmdInputSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * container filesystem, running containers are listed with find and stat,
+   * stopped containers and images without them fall back to the archive api
+   *
+   * @generated from rpc docker.v1.DockerService.ContainerListFiles
+   */
+  containerListFiles: {
+    methodKind: "unary";
+    input: typeof ContainerListFilesRequestSchema;
+    output: typeof ContainerListFilesResponseSchema;
+  },
+  /**
+   * streams a file or folder as a tar archive
+   *
+   * @generated from rpc docker.v1.DockerService.ContainerDownload
+   */
+  containerDownload: {
+    methodKind: "server_streaming";
+    input: typeof ContainerFileRequestSchema;
+    output: typeof ArchiveChunkSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.ContainerUpload
+   */
+  containerUpload: {
+    methodKind: "unary";
+    input: typeof ContainerUploadRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * compose
    *
@@ -1171,6 +3011,14 @@ export const DockerService: GenService<{
     input: typeof ComposeFileSchema;
     output: typeof LogsMessageSchema;
   },
+  /**
+   * @generated from rpc docker.v1.DockerService.ComposeBuild
+   */
+  composeBuild: {
+    methodKind: "server_streaming";
+    input: typeof ComposeBuildRequestSchema;
+    output: typeof LogsMessageSchema;
+  },
   /**
    * @generated from rpc docker.v1.DockerService.ComposeUpdate
    */
@@ -1195,6 +3043,86 @@ export const DockerService: GenService<{
     input: typeof ComposeFileSchema;
     output: typeof ComposeValidateResponseSchema;
   },
+  /**
+   * status of every compose file in the compose root
+   *
+   * @generated from rpc docker.v1.DockerService.ComposeOverview
+   */
+  composeOverview: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ComposeOverviewResponseSchema;
+  },
+  /**
+   * compare deployed containers with the current compose file
+   *
+   * @generated from rpc docker.v1.DockerService.ComposeDrift
+   */
+  composeDrift: {
+    methodKind: "unary";
+    input: typeof ComposeFileSchema;
+    output: typeof ComposeDriftResponseSchema;
+  },
+  /**
+   * preview the changes ComposeStart/ComposeUpdate would make without applying them
+   *
+   * @generated from rpc docker.v1.DockerService.ComposePlan
+   */
+  composePlan: {
+    methodKind: "unary";
+    input: typeof ComposeFileSchema;
+    output: typeof ComposePlanResponseSchema;
+  },
+  /**
+   * fully rendered compose file, same as docker compose config
+   *
+   * @generated from rpc docker.v1.DockerService.ComposeConfig
+   */
+  composeConfig: {
+    methodKind: "unary";
+    input: typeof ComposeFileSchema;
+    output: typeof ComposeConfigResponseSchema;
+  },
+  /**
+   * dependencies between stacks through depends_on, external networks/volumes and shared bind mounts
+   *
+   * @generated from rpc docker.v1.DockerService.StackGraph
+   */
+  stackGraph: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof StackGraphResponseSchema;
+  },
+  /**
+   * start every stack, stacks providing networks and volumes first
+   *
+   * @generated from rpc docker.v1.DockerService.ComposeStartAll
+   */
+  composeStartAll: {
+    methodKind: "server_streaming";
+    input: typeof EmptySchema;
+    output: typeof LogsMessageSchema;
+  },
+  /**
+   * stop every stack in reverse start order
+   *
+   * @generated from rpc docker.v1.DockerService.ComposeStopAll
+   */
+  composeStopAll: {
+    methodKind: "server_streaming";
+    input: typeof EmptySchema;
+    output: typeof LogsMessageSchema;
+  },
+  /**
+   * run the same action on many stacks across hosts
+   *
+   * @generated from rpc docker.v1.DockerService.ComposeBulk
+   */
+  composeBulk: {
+    methodKind: "server_streaming";
+    input: typeof BulkComposeRequestSchema;
+    output: typeof BulkProgressSchema;
+  },
   /**
    * images
    *
@@ -1214,6 +3142,8 @@ export const DockerService: GenService<{
     output: typeof RemoveImageResponseSchema;
   },
   /**
+   * superseded by Prune, kept for older clients
+   *
    * @generated from rpc docker.v1.DockerService.ImagePruneUnused
    */
   imagePruneUnused: {
@@ -1221,6 +3151,65 @@ export const DockerService: GenService<{
     input: typeof ImagePruneRequestSchema;
     output: typeof ImagePruneResponseSchema;
   },
+  /**
+   * space used by images, containers, volumes and build cache
+   *
+   * @generated from rpc docker.v1.DockerService.DiskUsage
+   */
+  diskUsage: {
+    methodKind: "unary";
+    input: typeof DiskUsageRequestSchema;
+    output: typeof DiskUsageResponseSchema;
+  },
+  /**
+   * removes unused objects of the selected types in one call
+   *
+   * @generated from rpc docker.v1.DockerService.Prune
+   */
+  prune: {
+    methodKind: "unary";
+    input: typeof PruneRequestSchema;
+    output: typeof PruneResponseSchema;
+  },
+  /**
+   * builds from a directory under the compose root, streams the daemon progress
+   *
+   * @generated from rpc docker.v1.DockerService.ImageBuild
+   */
+  imageBuild: {
+    methodKind: "server_streaming";
+    input: typeof ImageBuildRequestSchema;
+    output: typeof ImageProgressSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.ImagePush
+   */
+  imagePush: {
+    methodKind: "server_streaming";
+    input: typeof ImagePushRequestSchema;
+    output: typeof ImageProgressSchema;
+  },
+  /**
+   * streams the images as a docker save tar archive,
+   * archives are loaded back with a POST of the raw tar to /api/docker/image/load
+   *
+   * @generated from rpc docker.v1.DockerService.ImageSave
+   */
+  imageSave: {
+    methodKind: "server_streaming";
+    input: typeof ImageSaveRequestSchema;
+    output: typeof ArchiveChunkSchema;
+  },
+  /**
+   * copies images between two connected hosts without a registry
+   *
+   * @generated from rpc docker.v1.DockerService.ImageTransfer
+   */
+  imageTransfer: {
+    methodKind: "server_streaming";
+    input: typeof ImageTransferRequestSchema;
+    output: typeof ImageProgressSchema;
+  },
   /**
    * volumes
    *
@@ -1247,6 +3236,40 @@ export const DockerService: GenService<{
     input: typeof DeleteVolumeRequestSchema;
     output: typeof DeleteVolumeResponseSchema;
   },
+  /**
+   * browse files inside a named volume, paths are relative to the volume root
+   *
+   * @generated from rpc docker.v1.DockerService.VolumeListFiles
+   */
+  volumeListFiles: {
+    methodKind: "unary";
+    input: typeof VolumeListFilesRequestSchema;
+    output: typeof VolumeListFilesResponseSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.VolumeReadFile
+   */
+  volumeReadFile: {
+    methodKind: "unary";
+    input: typeof VolumeFileRequestSchema;
+    output: typeof VolumeFileContentsSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.VolumeWriteFile
+   */
+  volumeWriteFile: {
+    methodKind: "unary";
+    input: typeof VolumeWriteFileRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.VolumeDeleteFile
+   */
+  volumeDeleteFile: {
+    methodKind: "unary";
+    input: typeof VolumeDeleteFileRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * networks
    *
@@ -1273,6 +3296,44 @@ export const DockerService: GenService<{
     input: typeof DeleteNetworkRequestSchema;
     output: typeof DeleteNetworkResponseSchema;
   },
+  /**
+   * networks with the containers attached to them
+   *
+   * @generated from rpc docker.v1.DockerService.NetworkTopology
+   */
+  networkTopology: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof NetworkTopologyResponseSchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.NetworkConnect
+   */
+  networkConnect: {
+    methodKind: "unary";
+    input: typeof NetworkConnectRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc docker.v1.DockerService.NetworkDisconnect
+   */
+  networkDisconnect: {
+    methodKind: "unary";
+    input: typeof NetworkDisconnectRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * events
+   * streams daemon events of the active host,
+   * reconnects and replays missed events if the connection to the daemon drops
+   *
+   * @generated from rpc docker.v1.DockerService.Events
+   */
+  events: {
+    methodKind: "server_streaming";
+    input: typeof EventsRequestSchema;
+    output: typeof DockerEventSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_docker_v1_docker, 0);
 
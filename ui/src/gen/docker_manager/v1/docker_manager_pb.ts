@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file docker_manager/v1/docker_manager.proto.
  */
 export const file_docker_manager_v1_docker_manager: GenFile = /*@__PURE__*/
-  fileDesc("CiZkb2NrZXJfbWFuYWdlci92MS9kb2NrZXJfbWFuYWdlci5wcm90bxIRZG9ja2VyX21hbmFnZXIudjEiGgoKR2V0TWFjaGluZRIMCgRuYW1lGAEgASgJIi0KDVRvZ2dsZVJlcWV1c3QSDgoGZW5hYmxlGAEgASgIEgwKBG5hbWUYAiABKAkiPAoTTGlzdENsaWVudHNSZXNwb25zZRIUCgxhY3RpdmVDbGllbnQYASABKAkSDwoHY2xpZW50cxgCIAMoCSI7CgtMaXN0TWFjaGluZRIsCghtYWNoaW5lcxgCIAMoCzIaLmRvY2tlcl9tYW5hZ2VyLnYxLk1hY2hpbmUijAEKB01hY2hpbmUSCgoCaWQYASABKAQSDAoEbmFtZRgCIAEoCRIOCgZlbmFibGUYAyABKAgSDAoEaG9zdBgEIAEoCRIMCgRwb3J0GAUgASgFEgwKBHVzZXIYBiABKAkSEAoIcGFzc3dvcmQYByABKAkSGwoTdXNlX3B1YmxpY19rZXlfYXV0aBgIIAEoCCIiCg1Td2l0Y2hSZXF1ZXN0EhEKCW1hY2hpbmVJRBgBIAEoCSIHCgVFbXB0eTKqBQoURG9ja2VyTWFuYWdlclNlcnZpY2USQwoLU3RhcnRVcGRhdGUSGC5kb2NrZXJfbWFuYWdlci52MS5FbXB0eRoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASTAoMU3dpdGNoQ2xpZW50EiAuZG9ja2VyX21hbmFnZXIudjEuU3dpdGNoUmVxdWVzdBoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASUQoLTGlzdENsaWVudHMSGC5kb2NrZXJfbWFuYWdlci52MS5FbXB0eRomLmRvY2tlcl9tYW5hZ2VyLnYxLkxpc3RDbGllbnRzUmVzcG9uc2UiABJHCglMaXN0SG9zdHMSGC5kb2NrZXJfbWFuYWdlci52MS5FbXB0eRoeLmRvY2tlcl9tYW5hZ2VyLnYxLkxpc3RNYWNoaW5lIgASQgoDR2V0Eh0uZG9ja2VyX21hbmFnZXIudjEuR2V0TWFjaGluZRoaLmRvY2tlcl9tYW5hZ2VyLnYxLk1hY2hpbmUiABJDCglOZXdDbGllbnQSGi5kb2NrZXJfbWFuYWdlci52MS5NYWNoaW5lGhguZG9ja2VyX21hbmFnZXIudjEuRW1wdHkiABJECgpFZGl0Q2xpZW50EhouZG9ja2VyX21hbmFnZXIudjEuTWFjaGluZRoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASRgoMRGVsZXRlQ2xpZW50EhouZG9ja2VyX21hbmFnZXIudjEuTWFjaGluZRoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASTAoMVG9nZ2xlQ2xpZW50EiAuZG9ja2VyX21hbmFnZXIudjEuVG9nZ2xlUmVxZXVzdBoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgBCwgEKFWNvbS5kb2NrZXJfbWFuYWdlci52MUISRG9ja2VyTWFuYWdlclByb3RvUAFaNGdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZG9ja2VyX21hbmFnZXIvdjGiAgNEWFiqAhBEb2NrZXJNYW5hZ2VyLlYxygIQRG9ja2VyTWFuYWdlclxWMeICHERvY2tlck1hbmFnZXJcVjFcR1BCTWV0YWRhdGHqAhFEb2NrZXJNYW5hZ2VyOjpWMWIGcHJvdG8z");
+  fileDesc("CiZkb2NrZXJfbWFuYWdlci92MS9kb2NrZXJfbWFuYWdlci5wcm90bxIRZG9ja2VyX21hbmFnZXIudjEiGgoKR2V0TWFjaGluZRIMCgRuYW1lGAEgASgJIi0KDVRvZ2dsZVJlcWV1c3QSDgoGZW5hYmxlGAEgASgIEgwKBG5hbWUYAiABKAkiPAoTTGlzdENsaWVudHNSZXNwb25zZRIUCgxhY3RpdmVDbGllbnQYASABKAkSDwoHY2xpZW50cxgCIAMoCSI7CgtMaXN0TWFjaGluZRIsCghtYWNoaW5lcxgCIAMoCzIaLmRvY2tlcl9tYW5hZ2VyLnYxLk1hY2hpbmUijAEKB01hY2hpbmUSCgoCaWQYASABKAQSDAoEbmFtZRgCIAEoCRIOCgZlbmFibGUYAyABKAgSDAoEaG9zdBgEIAEoCRIMCgRwb3J0GAUgASgFEgwKBHVzZXIYBiABKAkSEAoIcGFzc3dvcmQYByABKAkSGwoTdXNlX3B1YmxpY19rZXlfYXV0aBgIIAEoCCIiCg1Td2l0Y2hSZXF1ZXN0EhEKCW1hY2hpbmVJRBgBIAEoCSIHCgVFbXB0eTKzBQoURG9ja2VyTWFuYWdlclNlcnZpY2USQwoLU3RhcnRVcGRhdGUSGC5kb2NrZXJfbWFuYWdlci52MS5FbXB0eRoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASTAoMU3dpdGNoQ2xpZW50EiAuZG9ja2VyX21hbmFnZXIudjEuU3dpdGNoUmVxdWVzdBoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASVAoLTGlzdENsaWVudHMSGC5kb2NrZXJfbWFuYWdlci52MS5FbXB0eRomLmRvY2tlcl9tYW5hZ2VyLnYxLkxpc3RDbGllbnRzUmVzcG9uc2UiA5ACARJKCglMaXN0SG9zdHMSGC5kb2NrZXJfbWFuYWdlci52MS5FbXB0eRoeLmRvY2tlcl9tYW5hZ2VyLnYxLkxpc3RNYWNoaW5lIgOQAgESRQoDR2V0Eh0uZG9ja2VyX21hbmFnZXIudjEuR2V0TWFjaGluZRoaLmRvY2tlcl9tYW5hZ2VyLnYxLk1hY2hpbmUiA5ACARJDCglOZXdDbGllbnQSGi5kb2NrZXJfbWFuYWdlci52MS5NYWNoaW5lGhguZG9ja2VyX21hbmFnZXIudjEuRW1wdHkiABJECgpFZGl0Q2xpZW50EhouZG9ja2VyX21hbmFnZXIudjEuTWFjaGluZRoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASRgoMRGVsZXRlQ2xpZW50EhouZG9ja2VyX21hbmFnZXIudjEuTWFjaGluZRoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgASTAoMVG9nZ2xlQ2xpZW50EiAuZG9ja2VyX21hbmFnZXIudjEuVG9nZ2xlUmVxZXVzdBoYLmRvY2tlcl9tYW5hZ2VyLnYxLkVtcHR5IgBCwgEKFWNvbS5kb2NrZXJfbWFuYWdlci52MUISRG9ja2VyTWFuYWdlclByb3RvUAFaNGdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZG9ja2VyX21hbmFnZXIvdjGiAgNEWFiqAhBEb2NrZXJNYW5hZ2VyLlYxygIQRG9ja2VyTWFuYWdlclxWMeICHERvY2tlck1hbmFnZXJcVjFcR1BCTWV0YWRhdGHqAhFEb2NrZXJNYW5hZ2VyOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message docker_manager.v1.GetMachine
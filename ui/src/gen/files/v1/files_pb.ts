@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file files/v1/files.proto.
  */
 export const file_files_v1_files: GenFile = /*@__PURE__*/
-  fileDesc("ChRmaWxlcy92MS9maWxlcy5wcm90bxIIZmlsZXMudjEiMwoMTGlzdFJlc3BvbnNlEiMKBmdyb3VwcxgBIAMoCzITLmZpbGVzLnYxLkZpbGVHcm91cCIrCglGaWxlR3JvdXASDAoEcm9vdBgBIAEoCRIQCghzdWJGaWxlcxgCIAMoCSI2CgpSZW5hbWVGaWxlEhMKC29sZEZpbGVQYXRoGAEgASgJEhMKC25ld0ZpbGVQYXRoGAIgASgJIhgKBEZpbGUSEAoIZmlsZW5hbWUYASABKAkiBwoFRW1wdHkilgIKC0RvY2ttYW5ZYW1sEhkKEXVzZUNvbXBvc2VGb2xkZXJzGAEgASgIEiIKGmRpc2FibGVDb21wb3NlUXVpY2tBY3Rpb25zGAcgASgIEhAKCHRhYkxpbWl0GAYgASgFEiwKC3ZvbHVtZXNQYWdlGAIgASgLMhcuZmlsZXMudjEuVm9sdW1lc0NvbmZpZxIsCgtuZXR3b3JrUGFnZRgDIAEoCzIXLmZpbGVzLnYxLk5ldHdvcmtDb25maWcSKAoJaW1hZ2VQYWdlGAQgASgLMhUuZmlsZXMudjEuSW1hZ2VDb25maWcSMAoNY29udGFpbmVyUGFnZRgFIAEoCzIZLmZpbGVzLnYxLkNvbnRhaW5lckNvbmZpZyItCg1Wb2x1bWVzQ29uZmlnEhwKBHNvcnQYASABKAsyDi5maWxlcy52MS5Tb3J0Ii0KDU5ldHdvcmtDb25maWcSHAoEc29ydBgBIAEoCzIOLmZpbGVzLnYxLlNvcnQiKwoLSW1hZ2VDb25maWcSHAoEc29ydBgBIAEoCzIOLmZpbGVzLnYxLlNvcnQiLwoPQ29udGFpbmVyQ29uZmlnEhwKBHNvcnQYASABKAsyDi5maWxlcy52MS5Tb3J0IiwKBFNvcnQSEQoJc29ydE9yZGVyGAEgASgJEhEKCXNvcnRGaWVsZBgCIAEoCTK2AgoLRmlsZVNlcnZpY2USKwoGQ3JlYXRlEg4uZmlsZXMudjEuRmlsZRoPLmZpbGVzLnYxLkVtcHR5IgASMQoETGlzdBIPLmZpbGVzLnYxLkVtcHR5GhYuZmlsZXMudjEuTGlzdFJlc3BvbnNlIgASKwoGRGVsZXRlEg4uZmlsZXMudjEuRmlsZRoPLmZpbGVzLnYxLkVtcHR5IgASKwoGRXhpc3RzEg4uZmlsZXMudjEuRmlsZRoPLmZpbGVzLnYxLkVtcHR5IgASMQoGUmVuYW1lEhQuZmlsZXMudjEuUmVuYW1lRmlsZRoPLmZpbGVzLnYxLkVtcHR5IgASOgoOR2V0RG9ja21hbllhbWwSDy5maWxlcy52MS5FbXB0eRoVLmZpbGVzLnYxLkRvY2ttYW5ZYW1sIgBCiAEKDGNvbS5maWxlcy52MUIKRmlsZXNQcm90b1ABWitnaXRodWIuY29tL1JBMzQxL2RvY2ttYW4vZ2VuZXJhdGVkL2ZpbGVzL3YxogIDRlhYqgIIRmlsZXMuVjHKAghGaWxlc1xWMeICFEZpbGVzXFYxXEdQQk1ldGFkYXRh6gIJRmlsZXM6OlYxYgZwcm90bzM");
+  fileDesc("ChRmaWxlcy92MS9maWxlcy5wcm90bxIIZmlsZXMudjEiMwoMTGlzdFJlc3BvbnNlEiMKBmdyb3VwcxgBIAMoCzITLmZpbGVzLnYxLkZpbGVHcm91cCIrCglGaWxlR3JvdXASDAoEcm9vdBgBIAEoCRIQCghzdWJGaWxlcxgCIAMoCSI+ChVMaXN0VGVtcGxhdGVzUmVzcG9uc2USJQoJdGVtcGxhdGVzGAEgAygLMhIuZmlsZXMudjEuVGVtcGxhdGUiiAEKCFRlbXBsYXRlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDwoHY29tcG9zZRgEIAEoCRIPCgdidWlsdGluGAUgASgIEisKDHBsYWNlaG9sZGVycxgGIAMoCzIVLmZpbGVzLnYxLlBsYWNlaG9sZGVyImMKC1BsYWNlaG9sZGVyEgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDwoHZGVmYXVsdBgDIAEoCRIQCghyZXF1aXJlZBgEIAEoCBIOCgZyYW5kb20YBSABKAgisgEKGUNyZWF0ZUZyb21UZW1wbGF0ZVJlcXVlc3QSEgoKdGVtcGxhdGVJZBgBIAEoCRIRCglzdGFja05hbWUYAiABKAkSPwoGdmFsdWVzGAMgAygLMi8uZmlsZXMudjEuQ3JlYXRlRnJvbVRlbXBsYXRlUmVxdWVzdC5WYWx1ZXNFbnRyeRotCgtWYWx1ZXNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjYKClJlbmFtZUZpbGUSEwoLb2xkRmlsZVBhdGgYASABKAkSEwoLbmV3RmlsZVBhdGgYAiABKAkiGAoERmlsZRIQCghmaWxlbmFtZRgBIAEoCSIHCgVFbXB0eSKWAgoLRG9ja21hbllhbWwSGQoRdXNlQ29tcG9zZUZvbGRlcnMYASABKAgSIgoaZGlzYWJsZUNvbXBvc2VRdWlja0FjdGlvbnMYByABKAgSEAoIdGFiTGltaXQYBiABKAUSLAoLdm9sdW1lc1BhZ2UYAiABKAsyFy5maWxlcy52MS5Wb2x1bWVzQ29uZmlnEiwKC25ldHdvcmtQYWdlGAMgASgLMhcuZmlsZXMudjEuTmV0d29ya0NvbmZpZxIoCglpbWFnZVBhZ2UYBCABKAsyFS5maWxlcy52MS5JbWFnZUNvbmZpZxIwCg1jb250YWluZXJQYWdlGAUgASgLMhkuZmlsZXMudjEuQ29udGFpbmVyQ29uZmlnIi0KDVZvbHVtZXNDb25maWcSHAoEc29ydBgBIAEoCzIOLmZpbGVzLnYxLlNvcnQiLQoNTmV0d29ya0NvbmZpZxIcCgRzb3J0GAEgASgLMg4uZmlsZXMudjEuU29ydCIrCgtJbWFnZUNvbmZpZxIcCgRzb3J0GAEgASgLMg4uZmlsZXMudjEuU29ydCIvCg9Db250YWluZXJDb25maWcSHAoEc29ydBgBIAEoCzIOLmZpbGVzLnYxLlNvcnQiLAoEU29ydBIRCglzb3J0T3JkZXIYASABKAkSEQoJc29ydEZpZWxkGAIgASgJMtQDCgtGaWxlU2VydmljZRIrCgZDcmVhdGUSDi5maWxlcy52MS5GaWxlGg8uZmlsZXMudjEuRW1wdHkiABI0CgRMaXN0Eg8uZmlsZXMudjEuRW1wdHkaFi5maWxlcy52MS5MaXN0UmVzcG9uc2UiA5ACARIrCgZEZWxldGUSDi5maWxlcy52MS5GaWxlGg8uZmlsZXMudjEuRW1wdHkiABIuCgZFeGlzdHMSDi5maWxlcy52MS5GaWxlGg8uZmlsZXMudjEuRW1wdHkiA5ACARIxCgZSZW5hbWUSFC5maWxlcy52MS5SZW5hbWVGaWxlGg8uZmlsZXMudjEuRW1wdHkiABI9Cg5HZXREb2NrbWFuWWFtbBIPLmZpbGVzLnYxLkVtcHR5GhUuZmlsZXMudjEuRG9ja21hbllhbWwiA5ACARJGCg1MaXN0VGVtcGxhdGVzEg8uZmlsZXMudjEuRW1wdHkaHy5maWxlcy52MS5MaXN0VGVtcGxhdGVzUmVzcG9uc2UiA5ACARJLChJDcmVhdGVGcm9tVGVtcGxhdGUSIy5maWxlcy52MS5DcmVhdGVGcm9tVGVtcGxhdGVSZXF1ZXN0Gg4uZmlsZXMudjEuRmlsZSIAQogBCgxjb20uZmlsZXMudjFCCkZpbGVzUHJvdG9QAVorZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9maWxlcy92MaICA0ZYWKoCCEZpbGVzLlYxygIIRmlsZXNcVjHiAhRGaWxlc1xWMVxHUEJNZXRhZGF0YeoCCUZpbGVzOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message files.v1.ListResponse
@@ -51,6 +51,137 @@ export type FileGroup = Message<"files.v1.FileGroup"> & {
 export const FileGroupSchema: GenMessage<FileGroup> = /*@__PURE__*/
   messageDesc(file_files_v1_files, 1);
 
+/**
+ * @generated from message files.v1.ListTemplatesResponse
+ */
+export type ListTemplatesResponse = Message<"files.v1.ListTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated files.v1.Template templates = 1;
+   */
+  templates: Template[];
+};
+
+/**
+ * Describes the message files.v1.ListTemplatesResponse.
+ * Use `create(ListTemplatesResponseSchema)` to create a new message.
+ */
+export const ListTemplatesResponseSchema: GenMessage<ListTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 2);
+
+/**
+ * @generated from message files.v1.Template
+ */
+export type Template = Message<"files.v1.Template"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * main compose file in the template
+   *
+   * @generated from field: string compose = 4;
+   */
+  compose: string;
+
+  /**
+   * @generated from field: bool builtin = 5;
+   */
+  builtin: boolean;
+
+  /**
+   * @generated from field: repeated files.v1.Placeholder placeholders = 6;
+   */
+  placeholders: Placeholder[];
+};
+
+/**
+ * Describes the message files.v1.Template.
+ * Use `create(TemplateSchema)` to create a new message.
+ */
+export const TemplateSchema: GenMessage<Template> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 3);
+
+/**
+ * @generated from message files.v1.Placeholder
+ */
+export type Placeholder = Message<"files.v1.Placeholder"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string default = 3;
+   */
+  default: string;
+
+  /**
+   * @generated from field: bool required = 4;
+   */
+  required: boolean;
+
+  /**
+   * a random value is generated if left empty
+   *
+   * @generated from field: bool random = 5;
+   */
+  random: boolean;
+};
+
+/**
+ * Describes the message files.v1.Placeholder.
+ * Use `create(PlaceholderSchema)` to create a new message.
+ */
+export const PlaceholderSchema: GenMessage<Placeholder> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 4);
+
+/**
+ * @generated from message files.v1.CreateFromTemplateRequest
+ */
+export type CreateFromTemplateRequest = Message<"files.v1.CreateFromTemplateRequest"> & {
+  /**
+   * @generated from field: string templateId = 1;
+   */
+  templateId: string;
+
+  /**
+   * name of the folder created in the compose root
+   *
+   * @generated from field: string stackName = 2;
+   */
+  stackName: string;
+
+  /**
+   * placeholder name -> value
+   *
+   * @generated from field: map<string, string> values = 3;
+   */
+  values: { [key: string]: string };
+};
+
+/**
+ * Describes the message files.v1.CreateFromTemplateRequest.
+ * Use `create(CreateFromTemplateRequestSchema)` to create a new message.
+ */
+export const CreateFromTemplateRequestSchema: GenMessage<CreateFromTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_files_v1_files, 5);
+
 /**
  * @generated from message files.v1.RenameFile
  */
@@ -71,7 +202,7 @@ export type RenameFile = Message<"files.v1.RenameFile"> & {
  * Use `create(RenameFileSchema)` to create a new message.
  */
 export const RenameFileSchema: GenMessage<RenameFile> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 2);
+  messageDesc(file_files_v1_files, 6);
 
 /**
  * @generated from message files.v1.File
@@ -88,7 +219,7 @@ export type File = Message<"files.v1.File"> & {
  * Use `create(FileSchema)` to create a new message.
  */
 export const FileSchema: GenMessage<File> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 3);
+  messageDesc(file_files_v1_files, 7);
 
 /**
  * @generated from message files.v1.Empty
@@ -101,7 +232,7 @@ export type Empty = Message<"files.v1.Empty"> & {
  * Use `create(EmptySchema)` to create a new message.
  */
 export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 4);
+  messageDesc(file_files_v1_files, 8);
 
 /**
  * @generated from message files.v1.DockmanYaml
@@ -148,7 +279,7 @@ export type DockmanYaml = Message<"files.v1.DockmanYaml"> & {
  * Use `create(DockmanYamlSchema)` to create a new message.
  */
 export const DockmanYamlSchema: GenMessage<DockmanYaml> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 5);
+  messageDesc(file_files_v1_files, 9);
 
 /**
  * @generated from message files.v1.VolumesConfig
@@ -165,7 +296,7 @@ export type VolumesConfig = Message<"files.v1.VolumesConfig"> & {
  * Use `create(VolumesConfigSchema)` to create a new message.
  */
 export const VolumesConfigSchema: GenMessage<VolumesConfig> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 6);
+  messageDesc(file_files_v1_files, 10);
 
 /**
  * @generated from message files.v1.NetworkConfig
@@ -182,7 +313,7 @@ export type NetworkConfig = Message<"files.v1.NetworkConfig"> & {
  * Use `create(NetworkConfigSchema)` to create a new message.
  */
 export const NetworkConfigSchema: GenMessage<NetworkConfig> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 7);
+  messageDesc(file_files_v1_files, 11);
 
 /**
  * @generated from message files.v1.ImageConfig
@@ -199,7 +330,7 @@ export type ImageConfig = Message<"files.v1.ImageConfig"> & {
  * Use `create(ImageConfigSchema)` to create a new message.
  */
 export const ImageConfigSchema: GenMessage<ImageConfig> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 8);
+  messageDesc(file_files_v1_files, 12);
 
 /**
  * @generated from message files.v1.ContainerConfig
@@ -216,7 +347,7 @@ export type ContainerConfig = Message<"files.v1.ContainerConfig"> & {
  * Use `create(ContainerConfigSchema)` to create a new message.
  */
 export const ContainerConfigSchema: GenMessage<ContainerConfig> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 9);
+  messageDesc(file_files_v1_files, 13);
 
 /**
  * @generated from message files.v1.Sort
@@ -238,7 +369,7 @@ export type Sort = Message<"files.v1.Sort"> & {
  * Use `create(SortSchema)` to create a new message.
  */
 export const SortSchema: GenMessage<Sort> = /*@__PURE__*/
-  messageDesc(file_files_v1_files, 10);
+  messageDesc(file_files_v1_files, 14);
 
 /**
  * @generated from service files.v1.FileService
@@ -294,6 +425,26 @@ export const FileService: GenService<{
     input: typeof EmptySchema;
     output: typeof DockmanYamlSchema;
   },
+  /**
+   * stack templates
+   *
+   * @generated from rpc files.v1.FileService.ListTemplates
+   */
+  listTemplates: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListTemplatesResponseSchema;
+  },
+  /**
+   * returns the compose file of the new stack
+   *
+   * @generated from rpc files.v1.FileService.CreateFromTemplate
+   */
+  createFromTemplate: {
+    methodKind: "unary";
+    input: typeof CreateFromTemplateRequestSchema;
+    output: typeof FileSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_files_v1_files, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file git/v1/git.proto.
  */
 export const file_git_v1_git: GenFile = /*@__PURE__*/
-  fileDesc("ChBnaXQvdjEvZ2l0LnByb3RvEgZnaXQudjEiFQoTTGlzdEJyYW5jaGVzUmVxdWVzdCIoChRMaXN0QnJhbmNoZXNSZXNwb25zZRIQCghicmFuY2hlcxgBIAMoCSInChVCcmFuY2hMaXN0RmlsZVJlcXVlc3QSDgoGYnJhbmNoGAEgASgJIicKFkJyYW5jaExpc3RGaWxlUmVzcG9uc2USDQoFZmlsZXMYASADKAkiLwoLRmlsZVJlcXVlc3QSDgoGYnJhbmNoGAEgASgJEhAKCGZpbGVwYXRoGAIgAygJIjoKC0NvbW1pdFF1ZXJ5EhoKBGZpbGUYASABKAsyDC5naXQudjEuRmlsZRIPCgdtZXNzYWdlGAIgASgJIi0KCkNvbW1pdExpc3QSHwoHY29tbWl0cxgBIAMoCzIOLmdpdC52MS5Db21taXQiVAoGQ29tbWl0EgwKBGhhc2gYASABKAkSDgoGYXV0aG9yGAIgASgJEg0KBWVtYWlsGAQgASgJEgwKBHdoZW4YBSABKAkSDwoHbWVzc2FnZRgGIAEoCSIUCgRGaWxlEgwKBG5hbWUYASABKAkiBwoFRW1wdHkyxQIKCkdpdFNlcnZpY2USMQoLTGlzdENvbW1pdHMSDC5naXQudjEuRmlsZRoSLmdpdC52MS5Db21taXRMaXN0IgASLgoGQ29tbWl0EhMuZ2l0LnYxLkNvbW1pdFF1ZXJ5Gg0uZ2l0LnYxLkVtcHR5IgASMAoIU3luY0ZpbGUSEy5naXQudjEuRmlsZVJlcXVlc3QaDS5naXQudjEuRW1wdHkiABJVChJMaXN0RmlsZUZyb21CcmFuY2gSHS5naXQudjEuQnJhbmNoTGlzdEZpbGVSZXF1ZXN0Gh4uZ2l0LnYxLkJyYW5jaExpc3RGaWxlUmVzcG9uc2UiABJLCgxMaXN0QnJhbmNoZXMSGy5naXQudjEuTGlzdEJyYW5jaGVzUmVxdWVzdBocLmdpdC52MS5MaXN0QnJhbmNoZXNSZXNwb25zZSIAQnoKCmNvbS5naXQudjFCCEdpdFByb3RvUAFaKWdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZ2l0L3YxogIDR1hYqgIGR2l0LlYxygIGR2l0XFYx4gISR2l0XFYxXEdQQk1ldGFkYXRh6gIHR2l0OjpWMWIGcHJvdG8z");
+  fileDesc("ChBnaXQvdjEvZ2l0LnByb3RvEgZnaXQudjEiFQoTTGlzdEJyYW5jaGVzUmVxdWVzdCIoChRMaXN0QnJhbmNoZXNSZXNwb25zZRIQCghicmFuY2hlcxgBIAMoCSInChVCcmFuY2hMaXN0RmlsZVJlcXVlc3QSDgoGYnJhbmNoGAEgASgJIicKFkJyYW5jaExpc3RGaWxlUmVzcG9uc2USDQoFZmlsZXMYASADKAkiLwoLRmlsZVJlcXVlc3QSDgoGYnJhbmNoGAEgASgJEhAKCGZpbGVwYXRoGAIgAygJIjoKC0NvbW1pdFF1ZXJ5EhoKBGZpbGUYASABKAsyDC5naXQudjEuRmlsZRIPCgdtZXNzYWdlGAIgASgJIi0KCkNvbW1pdExpc3QSHwoHY29tbWl0cxgBIAMoCzIOLmdpdC52MS5Db21taXQiVAoGQ29tbWl0EgwKBGhhc2gYASABKAkSDgoGYXV0aG9yGAIgASgJEg0KBWVtYWlsGAQgASgJEgwKBHdoZW4YBSABKAkSDwoHbWVzc2FnZRgGIAEoCSIUCgRGaWxlEgwKBG5hbWUYASABKAkiBwoFRW1wdHkyzgIKCkdpdFNlcnZpY2USNAoLTGlzdENvbW1pdHMSDC5naXQudjEuRmlsZRoSLmdpdC52MS5Db21taXRMaXN0IgOQAgESLgoGQ29tbWl0EhMuZ2l0LnYxLkNvbW1pdFF1ZXJ5Gg0uZ2l0LnYxLkVtcHR5IgASMAoIU3luY0ZpbGUSEy5naXQudjEuRmlsZVJlcXVlc3QaDS5naXQudjEuRW1wdHkiABJYChJMaXN0RmlsZUZyb21CcmFuY2gSHS5naXQudjEuQnJhbmNoTGlzdEZpbGVSZXF1ZXN0Gh4uZ2l0LnYxLkJyYW5jaExpc3RGaWxlUmVzcG9uc2UiA5ACARJOCgxMaXN0QnJhbmNoZXMSGy5naXQudjEuTGlzdEJyYW5jaGVzUmVxdWVzdBocLmdpdC52MS5MaXN0QnJhbmNoZXNSZXNwb25zZSIDkAIBQnoKCmNvbS5naXQudjFCCEdpdFByb3RvUAFaKWdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvZ2l0L3YxogIDR1hYqgIGR2l0LlYxygIGR2l0XFYx4gISR2l0XFYxXEdQQk1ldGFkYXRh6gIHR2l0OjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message git.v1.ListBranchesRequest
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file info/v1/info.proto.
  */
 export const file_info_v1_info: GenFile = /*@__PURE__*/
-  fileDesc("ChJpbmZvL3YxL2luZm8ucHJvdG8SB2luZm8udjEiJQoSUmVhZFZlcnNpb25SZXF1ZXN0Eg8KB3ZlcnNpb24YASABKAkiXgoHQXBwSW5mbxIPCgd2ZXJzaW9uGAEgASgJEg8KB2ZsYXZvdXIYAiABKAkSDgoGY29tbWl0GAMgASgJEhEKCWJ1aWxkRGF0ZRgEIAEoCRIOCgZicmFuY2gYBSABKAkiPAoJQ2hhbmdlbG9nEg8KB3ZlcnNpb24YASABKAkSCwoDdXJsGAIgASgJEhEKCWNoYW5nZWxvZxgDIAEoCSIHCgVFbXB0eTKtAQoLSW5mb1NlcnZpY2USMgoMR2V0Q2hhbmdlbG9nEg4uaW5mby52MS5FbXB0eRoSLmluZm8udjEuQ2hhbmdlbG9nEi4KCkdldEFwcEluZm8SDi5pbmZvLnYxLkVtcHR5GhAuaW5mby52MS5BcHBJbmZvEjoKC1JlYWRWZXJzaW9uEhsuaW5mby52MS5SZWFkVmVyc2lvblJlcXVlc3QaDi5pbmZvLnYxLkVtcHR5QoEBCgtjb20uaW5mby52MUIJSW5mb1Byb3RvUAFaKmdpdGh1Yi5jb20vUkEzNDEvZG9ja21hbi9nZW5lcmF0ZWQvaW5mby92MaICA0lYWKoCB0luZm8uVjHKAgdJbmZvXFYx4gITSW5mb1xWMVxHUEJNZXRhZGF0YeoCCEluZm86OlYxYgZwcm90bzM");
+  fileDesc("ChJpbmZvL3YxL2luZm8ucHJvdG8SB2luZm8udjEiJQoSUmVhZFZlcnNpb25SZXF1ZXN0Eg8KB3ZlcnNpb24YASABKAkiXgoHQXBwSW5mbxIPCgd2ZXJzaW9uGAEgASgJEg8KB2ZsYXZvdXIYAiABKAkSDgoGY29tbWl0GAMgASgJEhEKCWJ1aWxkRGF0ZRgEIAEoCRIOCgZicmFuY2gYBSABKAkiPAoJQ2hhbmdlbG9nEg8KB3ZlcnNpb24YASABKAkSCwoDdXJsGAIgASgJEhEKCWNoYW5nZWxvZxgDIAEoCSIHCgVFbXB0eTK3AQoLSW5mb1NlcnZpY2USNwoMR2V0Q2hhbmdlbG9nEg4uaW5mby52MS5FbXB0eRoSLmluZm8udjEuQ2hhbmdlbG9nIgOQAgESMwoKR2V0QXBwSW5mbxIOLmluZm8udjEuRW1wdHkaEC5pbmZvLnYxLkFwcEluZm8iA5ACARI6CgtSZWFkVmVyc2lvbhIbLmluZm8udjEuUmVhZFZlcnNpb25SZXF1ZXN0Gg4uaW5mby52MS5FbXB0eUKBAQoLY29tLmluZm8udjFCCUluZm9Qcm90b1ABWipnaXRodWIuY29tL1JBMzQxL2RvY2ttYW4vZ2VuZXJhdGVkL2luZm8vdjGiAgNJWFiqAgdJbmZvLlYxygIHSW5mb1xWMeICE0luZm9cVjFcR1BCTWV0YWRhdGHqAghJbmZvOjpWMWIGcHJvdG8z");
 
 /**
  * @generated from message info.v1.ReadVersionRequest
@@ -0,0 +1,157 @@
+// @generated by protoc-gen-es v2.7.0 with parameter "target=ts"
+// @generated from file notifications/v1/notifications.proto (package notifications.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file notifications/v1/notifications.proto.
+ */
+export const file_notifications_v1_notifications: GenFile = /*@__PURE__*/
+  fileDesc("CiRub3RpZmljYXRpb25zL3YxL25vdGlmaWNhdGlvbnMucHJvdG8SEG5vdGlmaWNhdGlvbnMudjEiUgoZTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRI1Cg1ub3RpZmljYXRpb25zGAEgAygLMh4ubm90aWZpY2F0aW9ucy52MS5Ob3RpZmljYXRpb24iogEKDE5vdGlmaWNhdGlvbhIKCgJpZBgBIAEoBBIMCgRuYW1lGAIgASgJEg0KBWxldmVsGAMgASgJEjoKBmNvbmZpZxgEIAMoCzIqLm5vdGlmaWNhdGlvbnMudjEuTm90aWZpY2F0aW9uLkNvbmZpZ0VudHJ5Gi0KC0NvbmZpZ0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiJwoZRGVsZXRlTm90aWZpY2F0aW9uUmVxdWVzdBIKCgJpZBgBIAEoBCIlChdUZXN0Tm90aWZpY2F0aW9uUmVxdWVzdBIKCgJpZBgBIAEoBCIHCgVFbXB0eTLLAgoTTm90aWZpY2F0aW9uU2VydmljZRJRCgRMaXN0Ehcubm90aWZpY2F0aW9ucy52MS5FbXB0eRorLm5vdGlmaWNhdGlvbnMudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZSIDkAIBEkEKBFNhdmUSHi5ub3RpZmljYXRpb25zLnYxLk5vdGlmaWNhdGlvbhoXLm5vdGlmaWNhdGlvbnMudjEuRW1wdHkiABJQCgZEZWxldGUSKy5ub3RpZmljYXRpb25zLnYxLkRlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QaFy5ub3RpZmljYXRpb25zLnYxLkVtcHR5IgASTAoEVGVzdBIpLm5vdGlmaWNhdGlvbnMudjEuVGVzdE5vdGlmaWNhdGlvblJlcXVlc3QaFy5ub3RpZmljYXRpb25zLnYxLkVtcHR5IgBCwAEKFGNvbS5ub3RpZmljYXRpb25zLnYxQhJOb3RpZmljYXRpb25zUHJvdG9QAVozZ2l0aHViLmNvbS9SQTM0MS9kb2NrbWFuL2dlbmVyYXRlZC9ub3RpZmljYXRpb25zL3YxogIDTlhYqgIQTm90aWZpY2F0aW9ucy5WMcoCEE5vdGlmaWNhdGlvbnNcVjHiAhxOb3RpZmljYXRpb25zXFYxXEdQQk1ldGFkYXRh6gIRTm90aWZpY2F0aW9uczo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message notifications.v1.ListNotificationsResponse
+ */
+export type ListNotificationsResponse = Message<"notifications.v1.ListNotificationsResponse"> & {
+  /**
+   * @generated from field: repeated notifications.v1.Notification notifications = 1;
+   */
+  notifications: Notification[];
+};
+
+/**
+ * Describes the message notifications.v1.ListNotificationsResponse.
+ * Use `create(ListNotificationsResponseSchema)` to create a new message.
+ */
+export const ListNotificationsResponseSchema: GenMessage<ListNotificationsResponse> = /*@__PURE__*/
+  messageDesc(file_notifications_v1_notifications, 0);
+
+/**
+ * @generated from message notifications.v1.Notification
+ */
+export type Notification = Message<"notifications.v1.Notification"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * update|backup|alert|drift|cleanup
+   *
+   * @generated from field: string level = 3;
+   */
+  level: string;
+
+  /**
+   * provider settings, "type" selects the provider
+   * telegram|discord|slack|email|webhook
+   * lists such as receivers are comma seperated
+   *
+   * @generated from field: map<string, string> config = 4;
+   */
+  config: { [key: string]: string };
+};
+
+/**
+ * Describes the message notifications.v1.Notification.
+ * Use `create(NotificationSchema)` to create a new message.
+ */
+export const NotificationSchema: GenMessage<Notification> = /*@__PURE__*/
+  messageDesc(file_notifications_v1_notifications, 1);
+
+/**
+ * @generated from message notifications.v1.DeleteNotificationRequest
+ */
+export type DeleteNotificationRequest = Message<"notifications.v1.DeleteNotificationRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message notifications.v1.DeleteNotificationRequest.
+ * Use `create(DeleteNotificationRequestSchema)` to create a new message.
+ */
+export const DeleteNotificationRequestSchema: GenMessage<DeleteNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_notifications_v1_notifications, 2);
+
+/**
+ * @generated from message notifications.v1.TestNotificationRequest
+ */
+export type TestNotificationRequest = Message<"notifications.v1.TestNotificationRequest"> & {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message notifications.v1.TestNotificationRequest.
+ * Use `create(TestNotificationRequestSchema)` to create a new message.
+ */
+export const TestNotificationRequestSchema: GenMessage<TestNotificationRequest> = /*@__PURE__*/
+  messageDesc(file_notifications_v1_notifications, 3);
+
+/**
+ * @generated from message notifications.v1.Empty
+ */
+export type Empty = Message<"notifications.v1.Empty"> & {
+};
+
+/**
+ * Describes the message notifications.v1.Empty.
+ * Use `create(EmptySchema)` to create a new message.
+ */
+export const EmptySchema: GenMessage<Empty> = /*@__PURE__*/
+  messageDesc(file_notifications_v1_notifications, 4);
+
+/**
+ * @generated from service notifications.v1.NotificationService
+ */
+export const NotificationService: GenService<{
+  /**
+   * @generated from rpc notifications.v1.NotificationService.List
+   */
+  list: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListNotificationsResponseSchema;
+  },
+  /**
+   * @generated from rpc notifications.v1.NotificationService.Save
+   */
+  save: {
+    methodKind: "unary";
+    input: typeof NotificationSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc notifications.v1.NotificationService.Delete
+   */
+  delete: {
+    methodKind: "unary";
+    input: typeof DeleteNotificationRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * sends a test message using a saved notification config
+   *
+   * @generated from rpc notifications.v1.NotificationService.Test
+   */
+  test: {
+    methodKind: "unary";
+    input: typeof TestNotificationRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_notifications_v1_notifications, 0);
+